@@ -34,5 +34,6 @@ func NewBrokerCommand(p *commands.KnParams) *cobra.Command {
 	brokerCmd.AddCommand(NewBrokerDeleteCommand(p))
 	brokerCmd.AddCommand(NewBrokerListCommand(p))
 	brokerCmd.AddCommand(NewBrokerUpdateCommand(p))
+	brokerCmd.AddCommand(NewBrokerWaitCommand(p))
 	return brokerCmd
 }
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/pkg/apis"
+
+	"knative.dev/client/pkg/commands"
+	clienteventingv1 "knative.dev/client/pkg/eventing/v1"
+)
+
+var waitExample = `
+  # Wait for a broker 'mybroker' to be ready
+  kn broker wait mybroker
+
+  # Wait for a broker 'mybroker' to get an address
+  kn broker wait mybroker --for url
+
+  # Wait for a broker 'mybroker' to be deleted
+  kn broker wait mybroker --for delete --wait-timeout 60`
+
+// NewBrokerWaitCommand represents command to wait for a broker
+func NewBrokerWaitCommand(p *commands.KnParams) *cobra.Command {
+	return commands.NewWaitCommand(p, commands.WaitCommandConfig{
+		Group:   "broker",
+		Kind:    "Broker",
+		Example: waitExample,
+		NewResource: func(cmd *cobra.Command, namespace string) (*commands.WaitableResource, error) {
+			client, err := p.NewEventingClient(namespace)
+			if err != nil {
+				return nil, err
+			}
+			return &commands.WaitableResource{
+				Get: func(ctx context.Context, name string) (runtime.Object, error) {
+					return client.GetBroker(ctx, name)
+				},
+				List: func(ctx context.Context) (runtime.Object, error) {
+					return client.ListBrokers(ctx)
+				},
+				Watch:      client.WatchBroker,
+				Conditions: clienteventingv1.BrokerConditionExtractor,
+				URL: func(obj runtime.Object) *apis.URL {
+					address := obj.(*eventingv1.Broker).Status.Address
+					if address == nil {
+						return nil
+					}
+					return address.URL
+				},
+			}, nil
+		},
+	})
+}
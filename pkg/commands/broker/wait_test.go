@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/watch"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/pkg/apis"
+
+	clientv1 "knative.dev/client/pkg/eventing/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+	"knative.dev/client/pkg/wait"
+)
+
+func TestBrokerWait(t *testing.T) {
+	client := clientv1.NewMockKnEventingClient(t)
+	recorder := client.Recorder()
+
+	t.Run("already ready", func(t *testing.T) {
+		recorder.GetBroker("foo", getBrokerForWait(true), nil)
+
+		out, err := executeBrokerCommand(client, "wait", "foo")
+		assert.NilError(t, err)
+		assert.Assert(t, util.ContainsAll(out, "Waiting for Broker 'foo'", "Broker 'foo' in namespace 'default' is ready."))
+	})
+
+	t.Run("becoming ready", func(t *testing.T) {
+		recorder.GetBroker("foo", getBrokerForWait(false), nil)
+		watcher := wait.NewFakeWatch([]watch.Event{{Type: watch.Modified, Object: getBrokerForWait(true)}})
+		watcher.Start()
+		recorder.WatchBroker("foo", "42", watcher, nil)
+
+		out, err := executeBrokerCommand(client, "wait", "foo", "--wait-timeout", "5")
+		assert.NilError(t, err)
+		assert.Assert(t, util.ContainsAll(out, "is ready."))
+	})
+
+	t.Run("url", func(t *testing.T) {
+		notAddressable := getBrokerForWait(false)
+		notAddressable.Status.Address = nil
+		recorder.GetBroker("foo", notAddressable, nil)
+		watcher := wait.NewFakeWatch([]watch.Event{{Type: watch.Modified, Object: getBrokerForWait(false)}})
+		watcher.Start()
+		recorder.WatchBroker("foo", mock.Any(), watcher, nil)
+		recorder.GetBroker("foo", getBrokerForWait(false), nil)
+
+		out, err := executeBrokerCommand(client, "wait", "foo", "--for", "url", "--wait-timeout", "5")
+		assert.NilError(t, err)
+		assert.Assert(t, util.ContainsAll(out, "Broker 'foo' in namespace 'default' is available at URL:", "http://foo-broker.test"))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		recorder.GetBroker("foo", nil, errors.NewNotFound(eventingv1.Resource("broker"), "foo"))
+
+		out, err := executeBrokerCommand(client, "wait", "foo", "--for", "delete")
+		assert.NilError(t, err)
+		assert.Assert(t, util.ContainsAll(out, "Broker 'foo' in namespace 'default' is deleted."))
+	})
+
+	t.Run("delete timeout", func(t *testing.T) {
+		recorder.GetBroker("foo", getBrokerForWait(true), nil)
+		watcher := wait.NewFakeWatch([]watch.Event{})
+		watcher.Start()
+		recorder.WatchBroker("foo", mock.Any(), watcher, nil)
+
+		_, err := executeBrokerCommand(client, "wait", "foo", "--for", "delete", "--wait-timeout", "1")
+		assert.ErrorContains(t, err, "broker 'foo' not deleted after 1 seconds")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		recorder.GetBroker("foo", nil, errors.NewNotFound(eventingv1.Resource("broker"), "foo"))
+		watcher := wait.NewFakeWatch([]watch.Event{})
+		recorder.WatchBroker("foo", "", watcher, nil)
+
+		_, err := executeBrokerCommand(client, "wait", "foo", "--wait-timeout", "1")
+		assert.ErrorContains(t, err, "not ready")
+	})
+
+	recorder.Validate()
+}
+
+func TestBrokerWaitMultiple(t *testing.T) {
+	client := clientv1.NewMockKnEventingClient(t)
+	recorder := client.Recorder()
+
+	t.Run("names", func(t *testing.T) {
+		recorder.GetBroker(mock.Any(), getBrokerForWait(true), nil)
+		recorder.GetBroker(mock.Any(), nil, errors.NewNotFound(eventingv1.Resource("broker"), "bar"))
+		recorder.WatchBroker(mock.Any(), "", wait.NewFakeWatch(nil), nil)
+
+		out, err := executeBrokerCommand(client, "wait", "foo", "bar", "--wait-timeout", "1", "--wait-concurrency", "1")
+		assert.ErrorContains(t, err, "waiting failed for 1 of 2 brokers")
+		assert.Assert(t, util.ContainsAll(out, "Waiting for 2 brokers in namespace 'default':", "done.", "failed:",
+			"NAME", "RESULT", "ELAPSED", "MESSAGE", "Succeeded", "Failed", "not ready"))
+	})
+
+	t.Run("selector", func(t *testing.T) {
+		other := getBrokerForWait(true)
+		other.Name = "other"
+		selected := getBrokerForWait(true)
+		selected.Labels = map[string]string{"team": "a"}
+		recorder.ListBrokers(&eventingv1.BrokerList{Items: []eventingv1.Broker{*other, *selected}}, nil)
+		recorder.GetBroker("foo", selected, nil)
+
+		out, err := executeBrokerCommand(client, "wait", "--selector", "team in (a,b)")
+		assert.NilError(t, err)
+		assert.Assert(t, util.ContainsAll(out, "Waiting for 1 broker in namespace 'default':", "Broker 'foo' done.", "Succeeded"))
+		assert.Assert(t, util.ContainsNone(out, "other"))
+
+		recorder.ListBrokers(&eventingv1.BrokerList{}, nil)
+		_, err = executeBrokerCommand(client, "wait", "-l", "team=a")
+		assert.ErrorContains(t, err, "no brokers found matching selector 'team=a' in namespace 'default'")
+
+		_, err = executeBrokerCommand(client, "wait", "-l", "team=(")
+		assert.ErrorContains(t, err, "invalid label selector 'team=('")
+	})
+
+	recorder.Validate()
+}
+
+func TestBrokerWaitErrors(t *testing.T) {
+	client := clientv1.NewMockKnEventingClient(t)
+
+	_, err := executeBrokerCommand(client, "wait")
+	assert.ErrorContains(t, err, "'broker wait' requires the broker name given as argument or a --selector")
+
+	_, err = executeBrokerCommand(client, "wait", "foo", "--selector", "team=a")
+	assert.ErrorContains(t, err, "doesn't support broker names together with --selector")
+
+	_, err = executeBrokerCommand(client, "wait", "foo", "--for", "ready")
+	assert.ErrorContains(t, err, "invalid value 'ready' for --for")
+}
+
+func getBrokerForWait(ready bool) *eventingv1.Broker {
+	broker := getBroker()
+	broker.Generation = 1
+	broker.Status.ObservedGeneration = 1
+	broker.ResourceVersion = "42"
+	if !ready {
+		broker.Status.Conditions[0].Status = "Unknown"
+	}
+	broker.Status.Conditions = append(broker.Status.Conditions, apis.Condition{Type: "Addressable", Status: "True"})
+	return broker
+}
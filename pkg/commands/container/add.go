@@ -78,6 +78,9 @@ func NewContainerAddCommand(p *commands.KnParams) *cobra.Command {
 	podSpecFlags.AddUpdateFlags(cmd.Flags())
 	// Volume is not part of ContainerSpec
 	cmd.Flag("volume").Hidden = true
+	// Init containers are read only from --init-container-file by the service commands
+	cmd.Flag("init-container").Hidden = true
+	cmd.Flag("init-container-file").Hidden = true
 
 	return cmd
 }
@@ -27,5 +27,7 @@ func NewContainerCommand(p *commands.KnParams) *cobra.Command {
 		Aliases: []string{"containers"},
 	}
 	containerCmd.AddCommand(NewContainerAddCommand(p))
+	containerCmd.AddCommand(NewContainerListCommand(p))
+	containerCmd.AddCommand(NewContainerRemoveCommand(p))
 	return containerCmd
 }
@@ -20,6 +20,7 @@ import (
 
 	"gotest.tools/v3/assert"
 	"knative.dev/client/pkg/commands"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
 )
 
 func TestContainerCommand(t *testing.T) {
@@ -31,7 +32,7 @@ func TestContainerCommand(t *testing.T) {
 	for _, cmd := range containerCmd.Commands() {
 		subCommands = append(subCommands, cmd.Name())
 	}
-	expectedSubCommands := []string{"add"}
+	expectedSubCommands := []string{"add", "list", "remove"}
 	assert.DeepEqual(t, subCommands, expectedSubCommands)
 }
 
@@ -47,3 +48,17 @@ func executeContainerCommand(args ...string) (string, error) {
 	err := cmd.Execute()
 	return output.String(), err
 }
+
+func executeContainerCommandWithClient(client clientservingv1.KnServingClient, args ...string) (string, error) {
+	knParams := &commands.KnParams{}
+	knParams.NewServingClient = func(namespace string) (clientservingv1.KnServingClient, error) {
+		return client, nil
+	}
+
+	output := new(bytes.Buffer)
+	cmd := NewContainerCommand(knParams)
+	cmd.SetArgs(args)
+	cmd.SetOut(output)
+	err := cmd.Execute()
+	return output.String(), err
+}
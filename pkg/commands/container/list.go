@@ -0,0 +1,141 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/printers"
+	servinglib "knative.dev/client/pkg/serving"
+)
+
+// NewContainerListCommand represents 'kn container list' command
+func NewContainerListCommand(p *commands.KnParams) *cobra.Command {
+	machineReadablePrintFlags := genericclioptions.NewPrintFlags("")
+	cmd := &cobra.Command{
+		Use:     "list SERVICE",
+		Aliases: []string{"ls"},
+		Short:   "List the containers of a service",
+		Example: `
+  # List the containers of service 'svc', the main container which receives the requests is marked with '*'
+  kn container list svc
+
+  # Print the containers of service 'svc' as YAML
+  kn container list svc -o yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'container list' requires the service name given as single argument")
+			}
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+			service, err := client.GetService(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			spec := &service.Spec.Template.Spec
+			if machineReadablePrintFlags.OutputFlagSpecified() {
+				printer, err := machineReadablePrintFlags.ToPrinter()
+				if err != nil {
+					return err
+				}
+				list := &containerList{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "List"}, Items: spec.Containers}
+				return printer.PrintObj(list, cmd.OutOrStdout())
+			}
+			return printContainers(cmd.OutOrStdout(), spec.Containers, servinglib.ContainerIndexOfRevisionSpec(spec))
+		},
+	}
+	commands.AddNamespaceFlags(cmd.Flags(), false)
+	machineReadablePrintFlags.AddFlags(cmd)
+	return cmd
+}
+
+// containerList wraps containers in a generic list, so that they can be printed with the
+// generic printers
+type containerList struct {
+	metav1.TypeMeta `json:",inline"`
+	Items           []corev1.Container `json:"items"`
+}
+
+// DeepCopyObject implements runtime.Object
+func (l *containerList) DeepCopyObject() runtime.Object {
+	out := &containerList{TypeMeta: l.TypeMeta, Items: make([]corev1.Container, len(l.Items))}
+	for i := range l.Items {
+		l.Items[i].DeepCopyInto(&out.Items[i])
+	}
+	return out
+}
+
+func printContainers(out io.Writer, containers []corev1.Container, mainIndex int) error {
+	dw := printers.NewPrefixWriter(out)
+	dw.WriteColsLn("NAME", "IMAGE", "PORTS", "MOUNTS", "PROBES")
+	for i, container := range containers {
+		name := container.Name
+		if i == mainIndex {
+			name += " *"
+		}
+		dw.WriteColsLn(name, container.Image, formatPorts(container.Ports), formatMounts(container.VolumeMounts), formatProbes(&container))
+	}
+	return dw.Flush()
+}
+
+func formatPorts(ports []corev1.ContainerPort) string {
+	var formatted []string
+	for _, port := range ports {
+		value := strconv.Itoa(int(port.ContainerPort))
+		if port.Name != "" {
+			value = port.Name + ":" + value
+		}
+		formatted = append(formatted, value)
+	}
+	return strings.Join(formatted, ",")
+}
+
+func formatMounts(mounts []corev1.VolumeMount) string {
+	var formatted []string
+	for _, mount := range mounts {
+		formatted = append(formatted, mount.MountPath+"="+mount.Name)
+	}
+	return strings.Join(formatted, ",")
+}
+
+func formatProbes(container *corev1.Container) string {
+	var probes []string
+	if container.ReadinessProbe != nil {
+		probes = append(probes, "readiness")
+	}
+	if container.LivenessProbe != nil {
+		probes = append(probes, "liveness")
+	}
+	if container.StartupProbe != nil {
+		probes = append(probes, "startup")
+	}
+	return strings.Join(probes, ",")
+}
@@ -0,0 +1,78 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestContainerListMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	service := createServiceWithSidecar("foo")
+	r.GetService("foo", service, nil)
+
+	output, err := executeContainerCommandWithClient(client, "list", "foo")
+	assert.NilError(t, err)
+	lines := strings.Split(output, "\n")
+	assert.Assert(t, util.ContainsAll(lines[0], "NAME", "IMAGE", "PORTS", "MOUNTS", "PROBES"))
+	assert.Assert(t, util.ContainsAll(lines[1], "sidecar", "gcr.io/foo/sidecar:v1", "/data=data", "readiness"))
+	assert.Assert(t, util.ContainsAll(lines[2], "app *", "gcr.io/foo/app:v1", "http1:8080"))
+
+	r.GetService("foo", service, nil)
+	output, err = executeContainerCommandWithClient(client, "list", "foo", "-o", "yaml")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "kind: List", "- image: gcr.io/foo/sidecar:v1", "name: sidecar", "- containerPort: 8080"))
+
+	r.GetService("foo", service, nil)
+	output, err = executeContainerCommandWithClient(client, "list", "foo", "-o", "jsonpath={.items[*].name}")
+	assert.NilError(t, err)
+	assert.Equal(t, output, "sidecar app")
+
+	r.GetService("foo", service, nil)
+	_, err = executeContainerCommandWithClient(client, "list", "foo", "-o", "wide")
+	assert.ErrorContains(t, err, "unable to match a printer")
+	_, err = executeContainerCommandWithClient(client, "list")
+	assert.ErrorContains(t, err, "requires the service name")
+
+	r.Validate()
+}
+
+func createServiceWithSidecar(name string) *servingv1.Service {
+	service := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	service.Spec.Template.Spec.Containers = []corev1.Container{
+		{
+			Name:           "sidecar",
+			Image:          "gcr.io/foo/sidecar:v1",
+			VolumeMounts:   []corev1.VolumeMount{{Name: "data", MountPath: "/data"}},
+			ReadinessProbe: &corev1.Probe{},
+		},
+		{
+			Name:  "app",
+			Image: "gcr.io/foo/app:v1",
+			Ports: []corev1.ContainerPort{{Name: "http1", ContainerPort: 8080}},
+		},
+	}
+	return service
+}
@@ -0,0 +1,105 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/config"
+	servinglib "knative.dev/client/pkg/serving"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/wait"
+)
+
+// NewContainerRemoveCommand represents 'kn container remove' command
+func NewContainerRemoveCommand(p *commands.KnParams) *cobra.Command {
+	var waitFlags commands.WaitFlags
+	cmd := &cobra.Command{
+		Use:     "remove SERVICE NAME",
+		Aliases: []string{"rm"},
+		Short:   "Remove a container from a service",
+		Long: `Remove a container from a service
+
+A new revision without the container is created. The main container, which receives the
+requests, can't be removed.`,
+		Example: `
+  # Remove the container 'sidecar' from service 'svc'
+  kn container remove svc sidecar`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("'container remove' requires the service name and the container name as arguments")
+			}
+			serviceName, containerName := args[0], args[1]
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
+				return service, removeContainer(&service.Spec.Template.Spec, serviceName, containerName)
+			}
+			if _, err := client.UpdateServiceWithRetry(cmd.Context(), serviceName, updateFunc, config.DefaultRetry.Steps); err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if !waitFlags.Wait {
+				fmt.Fprintf(out, "Container '%s' removed from service '%s' in namespace '%s'.\n", containerName, serviceName, namespace)
+				return nil
+			}
+			fmt.Fprintf(out, "Removing container '%s' from service '%s' in namespace '%s':\n\n", containerName, serviceName, namespace)
+			wconfig := clientservingv1.WaitConfig{
+				Timeout:     time.Duration(waitFlags.TimeoutInSeconds) * time.Second,
+				ErrorWindow: time.Duration(waitFlags.ErrorWindowInSeconds) * time.Second,
+			}
+			err, duration := client.WaitForService(cmd.Context(), serviceName, wconfig, wait.SimpleMessageCallback(out))
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "%7.3fs Ready to serve.\n\n", float64(duration.Round(time.Millisecond))/float64(time.Second))
+			fmt.Fprintf(out, "Container '%s' removed from service '%s' in namespace '%s'.\n", containerName, serviceName, namespace)
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(cmd.Flags(), false)
+	waitFlags.AddConditionWaitFlags(cmd, commands.WaitDefaultTimeout, "remove", "container", "ready")
+	return cmd
+}
+
+// removeContainer removes the container with the given name, unless it's the main container
+func removeContainer(spec *servingv1.RevisionSpec, serviceName, containerName string) error {
+	mainIndex := servinglib.ContainerIndexOfRevisionSpec(spec)
+	for i := range spec.Containers {
+		if spec.Containers[i].Name != containerName {
+			continue
+		}
+		if i == mainIndex {
+			return fmt.Errorf("cannot remove container '%s' as it is the main container of service '%s'", containerName, serviceName)
+		}
+		spec.Containers = append(spec.Containers[:i], spec.Containers[i+1:]...)
+		return nil
+	}
+	return fmt.Errorf("service '%s' doesn't have a container '%s'", serviceName, containerName)
+}
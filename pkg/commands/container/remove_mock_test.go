@@ -0,0 +1,56 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func TestContainerRemoveMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.GetService("foo", createServiceWithSidecar("foo"), nil)
+	r.UpdateService(func(t *testing.T, service *servingv1.Service) {
+		containers := service.Spec.Template.Spec.Containers
+		assert.Equal(t, len(containers), 1)
+		assert.Equal(t, containers[0].Name, "app")
+	}, true, nil)
+	r.WaitForService("foo", mock.Any(), mock.Any(), nil, time.Second)
+	output, err := executeContainerCommandWithClient(client, "remove", "foo", "sidecar")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Removing container 'sidecar' from service 'foo'", "Ready to serve", "Container 'sidecar' removed from service 'foo' in namespace 'default'."))
+
+	r.GetService("foo", createServiceWithSidecar("foo"), nil)
+	_, err = executeContainerCommandWithClient(client, "remove", "foo", "app", "--no-wait")
+	assert.ErrorContains(t, err, "cannot remove container 'app' as it is the main container of service 'foo'")
+
+	r.GetService("foo", createServiceWithSidecar("foo"), nil)
+	_, err = executeContainerCommandWithClient(client, "rm", "foo", "proxy", "--no-wait")
+	assert.ErrorContains(t, err, "service 'foo' doesn't have a container 'proxy'")
+
+	_, err = executeContainerCommandWithClient(client, "remove", "foo")
+	assert.ErrorContains(t, err, "requires the service name and the container name")
+
+	r.Validate()
+}
@@ -39,6 +39,7 @@ func NewDomainCommand(p *commands.KnParams) *cobra.Command {
 	domainCmd.AddCommand(NewDomainMappingUpdateCommand(p))
 	domainCmd.AddCommand(NewDomainMappingDeleteCommand(p))
 	domainCmd.AddCommand(NewDomainMappingListCommand(p))
+	domainCmd.AddCommand(NewDomainMappingWaitCommand(p))
 	return domainCmd
 }
 
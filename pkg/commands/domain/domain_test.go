@@ -71,7 +71,7 @@ func TestDomainCommand(t *testing.T) {
 	for _, cmd := range domainCmd.Commands() {
 		subCommands = append(subCommands, cmd.Name())
 	}
-	expectedSubCommands := []string{"create", "delete", "describe", "list", "update"}
+	expectedSubCommands := []string{"create", "delete", "describe", "list", "update", "wait"}
 	assert.DeepEqual(t, subCommands, expectedSubCommands)
 }
 
@@ -0,0 +1,60 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+	servingv1beta1 "knative.dev/serving/pkg/apis/serving/v1beta1"
+
+	"knative.dev/client/pkg/commands"
+	clientv1beta1 "knative.dev/client/pkg/serving/v1beta1"
+)
+
+// NewDomainMappingWaitCommand to wait for a domain mapping
+func NewDomainMappingWaitCommand(p *commands.KnParams) *cobra.Command {
+	return commands.NewWaitCommand(p, commands.WaitCommandConfig{
+		Group: "domain",
+		Kind:  "Domain mapping",
+		Example: `
+  # Wait for domain mapping 'hello.example.com' to be ready
+  kn domain wait hello.example.com
+
+  # Wait for the certificate of domain mapping 'hello.example.com' to be provisioned
+  kn domain wait hello.example.com --for condition=CertificateProvisioned`,
+		NewResource: func(cmd *cobra.Command, namespace string) (*commands.WaitableResource, error) {
+			client, err := p.NewServingV1beta1Client(namespace)
+			if err != nil {
+				return nil, err
+			}
+			return &commands.WaitableResource{
+				Get: func(ctx context.Context, name string) (runtime.Object, error) {
+					return client.GetDomainMapping(ctx, name)
+				},
+				List: func(ctx context.Context) (runtime.Object, error) {
+					return client.ListDomainMappings(ctx)
+				},
+				Watch:      client.WatchDomainMapping,
+				Conditions: clientv1beta1.DomainMappingConditionExtractor,
+				URL: func(obj runtime.Object) *apis.URL {
+					return obj.(*servingv1beta1.DomainMapping).Status.URL
+				},
+			}, nil
+		},
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/watch"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	clientservingv1beta1 "knative.dev/client/pkg/serving/v1beta1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/wait"
+)
+
+func TestDomainMappingWait(t *testing.T) {
+	client := clientservingv1beta1.NewMockKnServiceClient(t)
+	recorder := client.Recorder()
+
+	pending := createDomainMapping("foo.bar", createServiceRef("foo", "default"), "")
+	pending.Generation = 1
+	pending.Status.ObservedGeneration = 1
+	pending.Status.Conditions = duckv1.Conditions{{Type: apis.ConditionReady, Status: "Unknown"}}
+	ready := pending.DeepCopy()
+	ready.Status.Conditions = duckv1.Conditions{{Type: apis.ConditionReady, Status: "True"}}
+	ready.Status.URL = apis.HTTP("foo.bar")
+
+	recorder.GetDomainMapping("foo.bar", pending, nil)
+	watcher := wait.NewFakeWatch([]watch.Event{{Type: watch.Modified, Object: ready}})
+	watcher.Start()
+	recorder.WatchDomainMapping("foo.bar", "", watcher, nil)
+
+	out, err := executeDomainCommand(client, nil, "wait", "foo.bar", "--wait-timeout", "5")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Waiting for Domain mapping 'foo.bar'", "Domain mapping 'foo.bar' in namespace 'default' is ready."))
+
+	recorder.Validate()
+}
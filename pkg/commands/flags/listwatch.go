@@ -0,0 +1,199 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"knative.dev/client/pkg/output/term"
+)
+
+// ListWatch is a list of resources together with a watch on their changes
+type ListWatch struct {
+	// List is the initial list of resources
+	List runtime.Object
+	// Watch delivers the changes of the listed resources, starting at the
+	// resource version of List
+	Watch watch.Interface
+	// Prepare sorts and enriches a list before it is printed in human readable
+	// format. It is optional.
+	Prepare func(list runtime.Object) error
+}
+
+// ValidateWatch checks whether the requested output format can be used together
+// with --watch, which supports the human readable format and JSON only
+func (f *ListPrintFlags) ValidateWatch() error {
+	if f.GenericPrintFlags.OutputFlagSpecified() && *f.GenericPrintFlags.OutputFormat != "json" {
+		return fmt.Errorf("--watch supports only the default output format and '-o json', not '-o %s'", *f.GenericPrintFlags.OutputFormat)
+	}
+	return nil
+}
+
+// PrintWatch prints the list and keeps it up to date until the watch ends or the
+// context is cancelled. On a terminal the table is redrawn in place for every change,
+// otherwise a row is appended for every changed resource. With '-o json' every change
+// is printed as a watch event in a single line, starting with an ADDED event for every
+// resource of the initial list.
+func (f *ListPrintFlags) PrintWatch(ctx context.Context, lw ListWatch, out io.Writer) error {
+	if err := f.ValidateWatch(); err != nil {
+		return err
+	}
+	items, err := meta.ExtractList(lw.List)
+	if err != nil {
+		return err
+	}
+	if f.GenericPrintFlags.OutputFlagSpecified() {
+		for _, item := range items {
+			if err := printWatchEvent(watch.Added, item, out); err != nil {
+				return err
+			}
+		}
+		return forEachWatchEvent(ctx, lw.Watch, func(event watch.Event) error {
+			return printWatchEvent(event.Type, event.Object, out)
+		})
+	}
+
+	inPlace := term.IsWriterTerminal(out)
+	table, err := f.renderTable(lw, items, false)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(out, table)
+	return forEachWatchEvent(ctx, lw.Watch, func(event watch.Event) error {
+		items, err = updateItems(items, event)
+		if err != nil {
+			return err
+		}
+		if !inPlace {
+			row, err := f.renderTable(lw, []runtime.Object{event.Object}, true)
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(out, row)
+			return nil
+		}
+		lines := strings.Count(table, "\n")
+		if table, err = f.renderTable(lw, items, false); err != nil {
+			return err
+		}
+		// Move the cursor to the start of the previous table and clear it
+		if lines > 0 {
+			fmt.Fprintf(out, "\x1b[%dA\x1b[J", lines)
+		}
+		fmt.Fprint(out, table)
+		return nil
+	})
+}
+
+// renderTable prints the given items with the human readable printer
+func (f *ListPrintFlags) renderTable(lw ListWatch, items []runtime.Object, noHeaders bool) (string, error) {
+	// Prepare may modify the items
+	copies := make([]runtime.Object, len(items))
+	for i, item := range items {
+		copies[i] = item.DeepCopyObject()
+	}
+	list := lw.List.DeepCopyObject()
+	if err := meta.SetList(list, copies); err != nil {
+		return "", err
+	}
+	if lw.Prepare != nil {
+		if err := lw.Prepare(list); err != nil {
+			return "", err
+		}
+	}
+	humanFlags := *f.HumanReadableFlags
+	humanFlags.NoHeaders = humanFlags.NoHeaders || noHeaders
+	printer, err := humanFlags.ToPrinter(f.PrinterHandler)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := printer.PrintObj(list, &buf); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// updateItems applies a watch event to the list items, which are identified by
+// namespace and name
+func updateItems(items []runtime.Object, event watch.Event) ([]runtime.Object, error) {
+	changed, err := meta.Accessor(event.Object)
+	if err != nil {
+		return nil, err
+	}
+	var updated []runtime.Object
+	for _, item := range items {
+		accessor, err := meta.Accessor(item)
+		if err != nil {
+			return nil, err
+		}
+		if accessor.GetNamespace() != changed.GetNamespace() || accessor.GetName() != changed.GetName() {
+			updated = append(updated, item)
+		}
+	}
+	if event.Type != watch.Deleted {
+		updated = append(updated, event.Object)
+	}
+	return updated, nil
+}
+
+// forEachWatchEvent calls handle for every Added, Modified and Deleted event until the
+// watch ends or the context is cancelled
+func forEachWatchEvent(ctx context.Context, watcher watch.Interface, handle func(event watch.Event) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+			switch event.Type {
+			case watch.Error:
+				return fmt.Errorf("watching failed: %w", apierrors.FromObject(event.Object))
+			case watch.Added, watch.Modified, watch.Deleted:
+				if err := handle(event); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// printWatchEvent prints a watch event as JSON in a single line, in the format used
+// by the API server
+func printWatchEvent(eventType watch.EventType, obj runtime.Object, out io.Writer) error {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	line, err := json.Marshal(metav1.WatchEvent{Type: string(eventType), Object: runtime.RawExtension{Raw: raw}})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, string(line))
+	return nil
+}
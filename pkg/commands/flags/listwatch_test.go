@@ -0,0 +1,149 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/printers"
+	hprinters "knative.dev/client/pkg/printers"
+	"knative.dev/client/pkg/wait"
+)
+
+func newWatchTestFlags(args ...string) *ListPrintFlags {
+	flags := NewListPrintFlags(func(h hprinters.PrintHandler) {
+		h.TableHandler(columnDefs, validPrintFunc)
+		h.TableHandler(columnDefs, func(list *servingv1.ServiceList, opts printers.PrintOptions) ([]metav1.TableRow, error) {
+			var rows []metav1.TableRow
+			for i := range list.Items {
+				row, err := validPrintFunc(&list.Items[i], opts)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, row...)
+			}
+			return rows, nil
+		})
+	})
+	cmd := &cobra.Command{}
+	flags.AddFlags(cmd)
+	cmd.ParseFlags(args)
+	return flags
+}
+
+func newWatchTestService(name, resourceVersion string) *servingv1.Service {
+	return &servingv1.Service{
+		TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "serving.knative.dev/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", ResourceVersion: resourceVersion},
+	}
+}
+
+func newWatchTestList() ListWatch {
+	list := &servingv1.ServiceList{Items: []servingv1.Service{*newWatchTestService("foo", "1")}}
+	fakeWatch := wait.NewFakeWatch([]watch.Event{
+		{Type: watch.Added, Object: newWatchTestService("bar", "2")},
+		{Type: watch.Modified, Object: newWatchTestService("foo", "3")},
+		{Type: watch.Bookmark, Object: newWatchTestService("", "4")},
+		{Type: watch.Deleted, Object: newWatchTestService("bar", "5")},
+	})
+	fakeWatch.StartAndClose()
+	return ListWatch{List: list, Watch: fakeWatch}
+}
+
+func TestPrintWatch(t *testing.T) {
+	flags := newWatchTestFlags()
+	lw := newWatchTestList()
+	var sorted []string
+	lw.Prepare = func(list runtime.Object) error {
+		var names []string
+		for _, item := range list.(*servingv1.ServiceList).Items {
+			names = append(names, item.Name)
+		}
+		sorted = append(sorted, strings.Join(names, ","))
+		return nil
+	}
+	var out bytes.Buffer
+	assert.NilError(t, flags.PrintWatch(context.Background(), lw, &out))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(t, len(lines), 5)
+	assert.Assert(t, strings.HasPrefix(lines[0], "NAME"))
+	for i, name := range []string{"foo", "bar", "foo", "bar"} {
+		assert.Assert(t, strings.HasPrefix(lines[i+1], name), "line %d: %s", i+1, lines[i+1])
+	}
+	// Every changed row is prepared on its own, when not writing to a terminal
+	assert.DeepEqual(t, sorted, []string{"foo", "bar", "foo", "bar"})
+}
+
+func TestUpdateItems(t *testing.T) {
+	items := []runtime.Object{newWatchTestService("foo", "1"), newWatchTestService("bar", "2")}
+	items, err := updateItems(items, watch.Event{Type: watch.Modified, Object: newWatchTestService("foo", "3")})
+	assert.NilError(t, err)
+	items, err = updateItems(items, watch.Event{Type: watch.Added, Object: newWatchTestService("baz", "4")})
+	assert.NilError(t, err)
+	items, err = updateItems(items, watch.Event{Type: watch.Deleted, Object: newWatchTestService("bar", "5")})
+	assert.NilError(t, err)
+
+	var versions []string
+	for _, item := range items {
+		service := item.(*servingv1.Service)
+		versions = append(versions, service.Name+"@"+service.ResourceVersion)
+	}
+	assert.DeepEqual(t, versions, []string{"foo@3", "baz@4"})
+}
+
+func TestPrintWatchJSON(t *testing.T) {
+	flags := newWatchTestFlags("-o", "json")
+	var out bytes.Buffer
+	assert.NilError(t, flags.PrintWatch(context.Background(), newWatchTestList(), &out))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	var events []string
+	for _, line := range lines {
+		var event struct {
+			Type   string            `json:"type"`
+			Object servingv1.Service `json:"object"`
+		}
+		assert.NilError(t, json.Unmarshal([]byte(line), &event))
+		assert.Equal(t, event.Object.Kind, "Service")
+		events = append(events, fmt.Sprintf("%s %s", event.Type, event.Object.Name))
+	}
+	assert.DeepEqual(t, events, []string{"ADDED foo", "ADDED bar", "MODIFIED foo", "DELETED bar"})
+}
+
+func TestPrintWatchErrors(t *testing.T) {
+	flags := newWatchTestFlags("-o", "yaml")
+	assert.ErrorContains(t, flags.ValidateWatch(), "--watch supports only the default output format and '-o json', not '-o yaml'")
+
+	flags = newWatchTestFlags()
+	status := &metav1.Status{Status: metav1.StatusFailure, Message: "giving up watching 'services' after 10 reconnects"}
+	fakeWatch := wait.NewFakeWatch([]watch.Event{{Type: watch.Error, Object: status}})
+	fakeWatch.Start()
+	var out bytes.Buffer
+	err := flags.PrintWatch(context.Background(), ListWatch{List: &servingv1.ServiceList{}, Watch: fakeWatch}, &out)
+	assert.ErrorContains(t, err, "watching failed: giving up watching 'services'")
+}
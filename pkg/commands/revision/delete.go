@@ -15,7 +15,6 @@
 package revision
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -24,7 +23,6 @@ import (
 	"github.com/spf13/cobra"
 	"knative.dev/client/pkg/commands"
 	v1 "knative.dev/client/pkg/serving/v1"
-	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 )
 
 // NewRevisionDeleteCommand represent 'revision delete' command
@@ -33,6 +31,7 @@ func NewRevisionDeleteCommand(p *commands.KnParams) *cobra.Command {
 	// prune filter, used with "-p"
 	var pruneFilter string
 	var pruneAll bool
+	var retention retentionFlags
 	RevisionDeleteCommand := &cobra.Command{
 		Use:   "delete NAME [NAME ...]",
 		Short: "Delete revisions",
@@ -44,7 +43,20 @@ func NewRevisionDeleteCommand(p *commands.KnParams) *cobra.Command {
   kn revision delete --prune-all
 
   # Delete all unreferenced revisions for a given service 'mysvc'
-  kn revision delete --prune mysvc`,
+  kn revision delete --prune mysvc
+
+  # Delete unreferenced revisions of 'mysvc' older than 30 days, but keep the last 5 revisions
+  # and those with a traffic tag
+  kn revision delete --prune mysvc --keep-last 5 --older-than 720h --keep-tagged
+
+  # Also delete revisions which only have a traffic tag but don't get any traffic
+  kn revision delete --prune mysvc --keep-last 5 --no-keep-tagged
+
+  # Show which revisions would be deleted, without deleting them
+  kn revision delete --prune-all --keep-last 3 --dry-run
+
+  # Configure the retention policy of service 'mysvc', which is used when pruning without options
+  kn service update mysvc --annotation-service client.knative.dev/revision-retention=keep-last=5,older-than=720h`,
 		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			prune := cmd.Flags().Changed("prune")
@@ -55,6 +67,9 @@ func NewRevisionDeleteCommand(p *commands.KnParams) *cobra.Command {
 			if argsLen > 0 && pruneAll {
 				return errors.New("'kn revision delete' with --prune-all flag requires no arguments")
 			}
+			if err := retention.validate(cmd, prune || pruneAll); err != nil {
+				return err
+			}
 
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
@@ -70,10 +85,19 @@ func NewRevisionDeleteCommand(p *commands.KnParams) *cobra.Command {
 				params = append(params, v1.WithService(pruneFilter))
 			}
 			if prune || pruneAll {
-				args, err = getUnreferencedRevisionNames(cmd.Context(), params, client)
+				plan, err := planPruning(cmd.Context(), cmd, client, params, &retention)
 				if err != nil {
 					return err
 				}
+				if retention.dryRun {
+					return printPruningPlan(cmd.OutOrStdout(), plan)
+				}
+				args = nil
+				for _, action := range plan {
+					if action.delete {
+						args = append(args, action.revision.Name)
+					}
+				}
 				if len(args) == 0 {
 					fmt.Fprintf(cmd.OutOrStdout(), "No unreferenced revisions found.\n")
 					return nil
@@ -102,24 +126,8 @@ func NewRevisionDeleteCommand(p *commands.KnParams) *cobra.Command {
 	flags := RevisionDeleteCommand.Flags()
 	flags.StringVar(&pruneFilter, "prune", "", "Remove unreferenced revisions for a given service in a namespace.")
 	flags.BoolVar(&pruneAll, "prune-all", false, "Remove all unreferenced revisions in a namespace.")
+	retention.addFlags(RevisionDeleteCommand)
 	commands.AddNamespaceFlags(RevisionDeleteCommand.Flags(), false)
 	waitFlags.AddConditionWaitFlags(RevisionDeleteCommand, commands.WaitDefaultTimeout, "delete", "revision", "deleted")
 	return RevisionDeleteCommand
 }
-
-// Return unreferenced revision names
-func getUnreferencedRevisionNames(ctx context.Context, lConfig []v1.ListConfig, client v1.KnServingClient) ([]string, error) {
-	revisionList, err := client.ListRevisions(ctx, lConfig...)
-	if err != nil {
-		return []string{}, err
-	}
-	// Sort revisions by namespace, service, generation (in this order)
-	sortRevisions(revisionList)
-	revisionNames := []string{}
-	for _, revision := range revisionList.Items {
-		if revision.GetRoutingState() != servingv1.RoutingStateActive {
-			revisionNames = append(revisionNames, revision.Name)
-		}
-	}
-	return revisionNames, nil
-}
@@ -18,12 +18,18 @@ package revision
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"testing"
+	"time"
 
 	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
 	"knative.dev/client/pkg/util"
 	"knative.dev/client/pkg/util/mock"
+	"knative.dev/pkg/ptr"
 	"knative.dev/serving/pkg/apis/serving"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 )
@@ -48,6 +54,10 @@ func TestRevisionDeletePruneAllMock(t *testing.T) {
 	revision3.Labels[serving.RoutingStateLabelKey] = string(servingv1.RoutingStateReserve)
 	revisionList := &servingv1.RevisionList{Items: []servingv1.Revision{*revision1, *revision2, *revision3}}
 	r.ListRevisions(mock.Any(), revisionList, nil)
+	// Services are looked up for their retention policy
+	r.GetService("svc1", createMockService("svc1"), nil)
+	r.GetService("svc2", createMockService("svc2"), nil)
+	r.GetService("svc3", nil, apierrors.NewNotFound(servingv1.Resource("service"), "svc3"))
 
 	output, err := executeRevisionCommand(client, "delete", "--prune-all")
 	fmt.Println(output)
@@ -93,6 +103,7 @@ func TestRevisionDeletePruneWithArgMock(t *testing.T) {
 	revision3.Labels[serving.RoutingStateLabelKey] = string(servingv1.RoutingStateActive)
 	revisionList := &servingv1.RevisionList{Items: []servingv1.Revision{*revision1, *revision2, *revision3}}
 	r.ListRevisions(mock.Any(), revisionList, nil)
+	r.GetService("svc1", createMockService("svc1"), nil)
 
 	output, err := executeRevisionCommand(client, "delete", "--prune", "svc1")
 	assert.NilError(t, err)
@@ -170,3 +181,111 @@ func TestRevisionDeletePruneAllErrorFromArgMock(t *testing.T) {
 	_, err := executeRevisionCommand(client, "delete", "--prune-all", "mysvc")
 	assert.Error(t, err, "'kn revision delete' with --prune-all flag requires no arguments")
 }
+
+func TestRevisionDeletePruneRetentionMock(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		args    []string
+		deleted []string
+	}{
+		{"keep last and older than", []string{"--keep-last", "1", "--older-than", "720h"}, []string{"svc1-00001"}},
+		{"keep tagged", []string{"--older-than", "720h", "--keep-tagged"}, []string{"svc1-00001"}},
+		{"no keep tagged", []string{"--older-than", "720h", "--no-keep-tagged"}, []string{"svc1-00002", "svc1-00001"}},
+		{"keep last only", []string{"--keep-last", "4"}, []string{"svc1-00001"}},
+		{"delete all but protected", []string{"--keep-last", "0"}, []string{"svc1-00004", "svc1-00001"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			client := clientservingv1.NewMockKnServiceClient(t)
+			r := client.Recorder()
+			r.ListRevisions(mock.Any(), createRetentionRevisions(), nil)
+			r.GetService("svc1", createRetentionService(), nil)
+			for _, name := range tc.deleted {
+				r.DeleteRevision(name, mock.Any(), nil)
+			}
+
+			output, err := executeRevisionCommand(client, append([]string{"delete", "--prune", "svc1"}, tc.args...)...)
+			assert.NilError(t, err)
+			for _, name := range tc.deleted {
+				assert.Assert(t, util.ContainsAll(output, "Revision '"+name+"' deleted"))
+			}
+			assert.Assert(t, util.ContainsNone(output, "svc1-00005", "svc1-00003"))
+			r.Validate()
+		})
+	}
+}
+
+func TestRevisionDeletePruneRetentionAnnotationDryRunMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.ListRevisions(mock.Any(), createRetentionRevisions(), nil)
+	service := createRetentionService()
+	service.Annotations = map[string]string{"client.knative.dev/revision-retention": "keep-last=2"}
+	r.GetService("svc1", service, nil)
+
+	output, err := executeRevisionCommand(client, "delete", "--prune-all", "--dry-run")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "SERVICE", "REVISION", "AGE", "ACTION", "REASON"))
+	assert.Assert(t, cmp.Regexp("svc1-00001\\s+\\S+\\s+delete\\s+not retained", output))
+	assert.Assert(t, cmp.Regexp("svc1-00002\\s+\\S+\\s+keep\\s+tagged", output))
+	assert.Assert(t, cmp.Regexp("svc1-00003\\s+\\S+\\s+keep\\s+receives traffic", output))
+	assert.Assert(t, cmp.Regexp("svc1-00004\\s+\\S+\\s+keep\\s+one of the last 2 revisions", output))
+	assert.Assert(t, cmp.Regexp("svc1-00005\\s+\\S+\\s+keep\\s+latest created revision", output))
+	assert.Assert(t, util.ContainsAll(output, "1 of 5 revision(s) would be deleted."))
+	assert.Assert(t, util.ContainsNone(output, "Revision 'svc1-00001' deleted"))
+
+	// Flags override the policy of the annotation
+	r.ListRevisions(mock.Any(), createRetentionRevisions(), nil)
+	r.GetService("svc1", service, nil)
+	output, err = executeRevisionCommand(client, "delete", "--prune-all", "--dry-run", "--keep-last", "5")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "0 of 5 revision(s) would be deleted."))
+	r.Validate()
+}
+
+func TestRevisionDeletePruneRetentionErrorsMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	_, err := executeRevisionCommand(client, "delete", "foo", "--keep-last", "2")
+	assert.Error(t, err, "--keep-last requires --prune or --prune-all")
+	_, err = executeRevisionCommand(client, "delete", "foo", "--dry-run")
+	assert.Error(t, err, "--dry-run requires --prune or --prune-all")
+	_, err = executeRevisionCommand(client, "delete", "--prune-all", "--keep-last", "-1")
+	assert.Error(t, err, "--keep-last must not be negative, but is -1")
+
+	r := client.Recorder()
+	r.ListRevisions(mock.Any(), createRetentionRevisions(), nil)
+	service := createRetentionService()
+	service.Annotations = map[string]string{"client.knative.dev/revision-retention": "keep=2"}
+	r.GetService("svc1", service, nil)
+	_, err = executeRevisionCommand(client, "delete", "--prune", "svc1")
+	assert.ErrorContains(t, err, "invalid annotation 'client.knative.dev/revision-retention' of service 'svc1': unknown option 'keep'")
+	r.Validate()
+}
+
+func createMockService(name string) *servingv1.Service {
+	return &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+}
+
+// createRetentionService creates a service whose latest revision is 'svc1-00005', with traffic split
+// between 'svc1-00003' and the latest revision and a tag on 'svc1-00002'
+func createRetentionService() *servingv1.Service {
+	service := createMockService("svc1")
+	service.Spec.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: "svc1-00002", Percent: ptr.Int64(0), Tag: "old"},
+		{RevisionName: "svc1-00003", Percent: ptr.Int64(50)},
+		{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(50)},
+	}
+	service.Status.LatestCreatedRevisionName = "svc1-00005"
+	service.Status.LatestReadyRevisionName = "svc1-00005"
+	return service
+}
+
+// createRetentionRevisions creates five revisions of 'svc1', created 100, 60, 40, 10 and 1 days ago
+func createRetentionRevisions() *servingv1.RevisionList {
+	list := &servingv1.RevisionList{}
+	for i, days := range []int{100, 60, 40, 10, 1} {
+		revision := createMockRevisionWithParams(fmt.Sprintf("svc1-%05d", i+1), "svc1", strconv.Itoa(i+1), "", "")
+		revision.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Duration(days) * 24 * time.Hour))
+		list.Items = append(list.Items, *revision)
+	}
+	return list
+}
@@ -97,6 +97,7 @@ func describe(w io.Writer, revision *servingv1.Revision, service *servingv1.Serv
 	dw := printers.NewPrefixWriter(w)
 	commands.WriteMetadata(dw, &revision.ObjectMeta, printDetails)
 	WriteImage(dw, revision)
+	WriteInitContainers(dw, revision)
 	WriteReplicas(dw, revision)
 	WritePort(dw, revision)
 	WriteEnv(dw, revision, printDetails)
@@ -188,6 +189,18 @@ func WriteImage(dw printers.PrefixWriter, revision *servingv1.Revision) {
 	dw.WriteAttribute("Image", image)
 }
 
+// WriteInitContainers writes the name and image of each init container of the revision, if any
+func WriteInitContainers(dw printers.PrefixWriter, revision *servingv1.Revision) {
+	initContainers := revision.Spec.InitContainers
+	if len(initContainers) == 0 {
+		return
+	}
+	section := dw.WriteAttribute("Init Containers", "")
+	for _, container := range initContainers {
+		section.WriteAttribute(container.Name, container.Image)
+	}
+}
+
 func WritePort(dw printers.PrefixWriter, revision *servingv1.Revision) {
 	port := clientserving.Port(&revision.Spec)
 	if port != nil {
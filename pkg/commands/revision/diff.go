@@ -0,0 +1,159 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/printers"
+	clientserving "knative.dev/client/pkg/serving"
+)
+
+// revisionDiff holds all differences between two revisions
+type revisionDiff struct {
+	From    string                         `json:"from"`
+	To      string                         `json:"to"`
+	Changes []clientserving.RevisionChange `json:"changes"`
+}
+
+// NewRevisionDiffCommand represents 'kn revision diff' command
+func NewRevisionDiffCommand(p *commands.KnParams) *cobra.Command {
+	machineReadablePrintFlags := genericclioptions.NewPrintFlags("")
+	command := &cobra.Command{
+		Use:   "diff REVISION [REVISION]",
+		Short: "Show the differences between two revisions",
+		Long: `Show the differences between two revisions
+
+The containers and init containers with their images, environment, mounts, resources and probes,
+the volumes, the annotations and the autoscaling settings of the revisions are compared. If only a
+single revision is given, it is compared with the current revision template of its service.`,
+		Example: `
+  # Show what changed between revisions 'svc1-00001' and 'svc1-00002'
+  kn revision diff svc1-00001 svc1-00002
+
+  # Show what changed since revision 'svc1-00001' in the current template of its service
+  kn revision diff svc1-00001
+
+  # Print the differences as JSON
+  kn revision diff svc1-00001 svc1-00002 -o json`,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 || len(args) > 2 {
+				return errors.New("'kn revision diff' requires the names of one or two revisions as arguments")
+			}
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			fromRevision, err := client.GetRevision(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			from := clientserving.RevisionTemplateOf(fromRevision)
+			var to *servingv1.RevisionTemplateSpec
+			var toName string
+			if len(args) == 2 {
+				toRevision, err := client.GetRevision(cmd.Context(), args[1])
+				if err != nil {
+					return err
+				}
+				to = clientserving.RevisionTemplateOf(toRevision)
+				toName = toRevision.Name
+			} else {
+				serviceName := fromRevision.Labels[serving.ServiceLabelKey]
+				if serviceName == "" {
+					return fmt.Errorf("revision '%s' doesn't belong to a service, please provide a second revision to compare with", fromRevision.Name)
+				}
+				service, err := client.GetService(cmd.Context(), serviceName)
+				if err != nil {
+					return err
+				}
+				to = &service.Spec.Template
+				toName = "service/" + service.Name
+			}
+
+			diff := &revisionDiff{
+				From:    fromRevision.Name,
+				To:      toName,
+				Changes: clientserving.DiffRevisionTemplates(from, to),
+			}
+			if machineReadablePrintFlags.OutputFlagSpecified() {
+				printer, err := machineReadablePrintFlags.ToPrinter()
+				if err != nil {
+					return err
+				}
+				if diff.Changes == nil {
+					diff.Changes = []clientserving.RevisionChange{}
+				}
+				obj, err := printers.RawObject(diff)
+				if err != nil {
+					return err
+				}
+				return printer.PrintObj(obj, cmd.OutOrStdout())
+			}
+			return printRevisionDiff(cmd.OutOrStdout(), diff)
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	machineReadablePrintFlags.AddFlags(command)
+	return command
+}
+
+// printRevisionDiff prints the changes grouped by their sections
+func printRevisionDiff(out io.Writer, diff *revisionDiff) error {
+	if len(diff.Changes) == 0 {
+		_, err := fmt.Fprintf(out, "No differences found between '%s' and '%s'.\n", diff.From, diff.To)
+		return err
+	}
+	dw := printers.NewPrefixWriter(out)
+	dw.WriteAttribute("Comparing", fmt.Sprintf("%s → %s", diff.From, diff.To))
+	dw.WriteLine()
+	WriteRevisionChanges(dw, diff.Changes)
+	return dw.Flush()
+}
+
+// WriteRevisionChanges writes the changes between two revisions grouped by their sections.
+// Added fields are marked with '+', removed ones with '-' and changed ones with '~'.
+func WriteRevisionChanges(dw printers.PrefixWriter, changes []clientserving.RevisionChange) {
+	section := ""
+	var sectionWriter printers.PrefixWriter
+	for _, change := range changes {
+		if change.Section != section {
+			section = change.Section
+			sectionWriter = dw.WriteAttribute(section, "")
+		}
+		switch change.Change {
+		case clientserving.RevisionChangeAdded:
+			sectionWriter.WriteColsLn("+", change.Field, change.To)
+		case clientserving.RevisionChangeRemoved:
+			sectionWriter.WriteColsLn("-", change.Field, change.From)
+		default:
+			sectionWriter.WriteColsLn("~", change.Field, fmt.Sprintf("%s → %s", change.From, change.To))
+		}
+	}
+}
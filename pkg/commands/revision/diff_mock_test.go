@@ -0,0 +1,210 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientserving "knative.dev/client/pkg/serving"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestRevisionDiffMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	from, to := createDiffRevisions()
+	r.GetRevision("svc1-00001", from, nil)
+	r.GetRevision("svc1-00002", to, nil)
+
+	output, err := executeRevisionCommand(client, "diff", "svc1-00001", "svc1-00002")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "svc1-00001 → svc1-00002"))
+	assert.Assert(t, util.ContainsAll(output, "Container", "image", "gcr.io/foo/bar:v1 → gcr.io/foo/bar:v2"))
+	assert.Assert(t, util.ContainsAll(output, "~", "env LEVEL", "info → debug"))
+	assert.Assert(t, util.ContainsAll(output, "+", "env DB", "secret:db:url"))
+	assert.Assert(t, util.ContainsAll(output, "-", "env OLD", "obsolete"))
+	assert.Assert(t, util.ContainsAll(output, "mount /config", "config"))
+	assert.Assert(t, util.ContainsAll(output, "limits.memory", "256Mi → 512Mi"))
+	assert.Assert(t, util.ContainsAll(output, "Volumes", "config", "config-map:app-config"))
+	assert.Assert(t, util.ContainsAll(output, "Autoscaling", "autoscaling.knative.dev/max-scale", "5 → 10", "containerConcurrency", "10"))
+	assert.Assert(t, util.ContainsAll(output, "Annotations", "team", "blue"))
+	// System annotations and unchanged fields are not shown
+	assert.Assert(t, util.ContainsNone(output, serving.CreatorAnnotation, "imagePullPolicy", "serviceAccountName"))
+	r.Validate()
+}
+
+func TestRevisionDiffJSONMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	from, to := createDiffRevisions()
+	r.GetRevision("svc1-00001", from, nil)
+	r.GetRevision("svc1-00002", to, nil)
+
+	output, err := executeRevisionCommand(client, "diff", "svc1-00001", "svc1-00002", "-o", "json")
+	assert.NilError(t, err)
+	diff := revisionDiff{}
+	assert.NilError(t, json.Unmarshal([]byte(output), &diff))
+	assert.Equal(t, diff.From, "svc1-00001")
+	assert.Equal(t, diff.To, "svc1-00002")
+	assert.DeepEqual(t, diff.Changes[0], clientserving.RevisionChange{
+		Section: "Container",
+		Field:   "image",
+		Change:  clientserving.RevisionChangeChanged,
+		From:    "gcr.io/foo/bar:v1",
+		To:      "gcr.io/foo/bar:v2",
+	})
+	assert.Assert(t, containsChange(diff.Changes, clientserving.RevisionChange{Section: "Container", Field: "env DB", Change: clientserving.RevisionChangeAdded, To: "secret:db:url"}))
+	assert.Assert(t, containsChange(diff.Changes, clientserving.RevisionChange{Section: "Container", Field: "env OLD", Change: clientserving.RevisionChangeRemoved, From: "obsolete"}))
+
+	r.GetRevision("svc1-00001", from, nil)
+	r.GetRevision("svc1-00002", to, nil)
+	output, err = executeRevisionCommand(client, "diff", "svc1-00001", "svc1-00002", "-o", "yaml")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "from: svc1-00001", "to: svc1-00002", "field: image"))
+	r.Validate()
+}
+
+func TestRevisionDiffWithServiceMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	from, to := createDiffRevisions()
+	service := createMockService("svc1")
+	service.Spec.Template.ObjectMeta = to.ObjectMeta
+	service.Spec.Template.Spec = to.Spec
+	r.GetRevision("svc1-00001", from, nil)
+	r.GetService("svc1", service, nil)
+
+	output, err := executeRevisionCommand(client, "diff", "svc1-00001")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "svc1-00001 → service/svc1", "gcr.io/foo/bar:v1 → gcr.io/foo/bar:v2"))
+	r.Validate()
+}
+
+func TestRevisionDiffNoChangesMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	from, _ := createDiffRevisions()
+	to := from.DeepCopy()
+	to.Name = "svc1-00002"
+	to.Annotations[serving.CreatorAnnotation] = "someone-else"
+	r.GetRevision("svc1-00001", from, nil)
+	r.GetRevision("svc1-00002", to, nil)
+
+	output, err := executeRevisionCommand(client, "diff", "svc1-00001", "svc1-00002")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "No differences found", "svc1-00001", "svc1-00002"))
+
+	r.GetRevision("svc1-00001", from, nil)
+	r.GetRevision("svc1-00002", to, nil)
+	output, err = executeRevisionCommand(client, "diff", "svc1-00001", "svc1-00002", "-o", "json")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, `"changes": []`))
+	r.Validate()
+}
+
+func TestRevisionDiffErrorsMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	_, err := executeRevisionCommand(client, "diff")
+	assert.ErrorContains(t, err, "requires the names of one or two revisions")
+
+	_, err = executeRevisionCommand(client, "diff", "a", "b", "c")
+	assert.ErrorContains(t, err, "requires the names of one or two revisions")
+
+	from, to := createDiffRevisions()
+	r.GetRevision("a", from, nil)
+	r.GetRevision("b", to, nil)
+	_, err = executeRevisionCommand(client, "diff", "a", "b", "-o", "table")
+	assert.ErrorContains(t, err, "unable to match a printer")
+
+	r.GetRevision("a", nil, errors.New("revisions.serving.knative.dev \"a\" not found"))
+	_, err = executeRevisionCommand(client, "diff", "a", "b")
+	assert.ErrorContains(t, err, "not found")
+
+	orphan, _ := createDiffRevisions()
+	delete(orphan.Labels, serving.ServiceLabelKey)
+	r.GetRevision("svc1-00001", orphan, nil)
+	_, err = executeRevisionCommand(client, "diff", "svc1-00001")
+	assert.ErrorContains(t, err, "doesn't belong to a service")
+	r.Validate()
+}
+
+func containsChange(changes []clientserving.RevisionChange, change clientserving.RevisionChange) bool {
+	for _, c := range changes {
+		if c == change {
+			return true
+		}
+	}
+	return false
+}
+
+// createDiffRevisions creates two revisions of service 'svc1' which differ in various fields
+func createDiffRevisions() (*servingv1.Revision, *servingv1.Revision) {
+	from := createMockRevisionWithParams("svc1-00001", "svc1", "1", "", "")
+	from.Annotations[serving.CreatorAnnotation] = "someone"
+	from.Annotations["autoscaling.knative.dev/max-scale"] = "5"
+	from.Spec.ServiceAccountName = "app"
+	from.Spec.Containers = []corev1.Container{{
+		Image:           "gcr.io/foo/bar:v1",
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Env: []corev1.EnvVar{
+			{Name: "LEVEL", Value: "info"},
+			{Name: "OLD", Value: "obsolete"},
+		},
+		Resources: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+		},
+	}}
+
+	to := from.DeepCopy()
+	to.Name = "svc1-00002"
+	to.Labels[serving.ConfigurationGenerationLabelKey] = "2"
+	to.Annotations["autoscaling.knative.dev/max-scale"] = "10"
+	to.Annotations["team"] = "blue"
+	to.Spec.ContainerConcurrency = ptr.Int64(10)
+	container := &to.Spec.Containers[0]
+	container.Image = "gcr.io/foo/bar:v2"
+	container.Env = []corev1.EnvVar{
+		{Name: "LEVEL", Value: "debug"},
+		{Name: "DB", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "db"},
+			Key:                  "url",
+		}}},
+	}
+	container.VolumeMounts = []corev1.VolumeMount{{Name: "config", MountPath: "/config", ReadOnly: true}}
+	container.Resources.Limits[corev1.ResourceMemory] = resource.MustParse("512Mi")
+	to.Spec.Volumes = []corev1.Volume{{
+		Name: "config",
+		VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "app-config"},
+		}},
+	}}
+	return from, to
+}
@@ -22,11 +22,12 @@ import (
 
 	"knative.dev/client/pkg/commands"
 	hprinters "knative.dev/client/pkg/printers"
+	clientserving "knative.dev/client/pkg/serving"
 )
 
 const (
-	RevisionTrafficAnnotation = "client.knative.dev/traffic"
-	RevisionTagsAnnotation    = "client.knative.dev/tags"
+	RevisionTrafficAnnotation = clientserving.RevisionTrafficAnnotationKey
+	RevisionTagsAnnotation    = clientserving.RevisionTagsAnnotationKey
 )
 
 // Max column size
@@ -24,6 +24,7 @@ import (
 	"knative.dev/serving/pkg/apis/serving"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"knative.dev/client/pkg/commands"
@@ -37,6 +38,7 @@ var serviceNameFilter string
 // NewRevisionListCommand represents 'kn revision list' command
 func NewRevisionListCommand(p *commands.KnParams) *cobra.Command {
 	revisionListFlags := flags.NewListPrintFlags(RevisionListHandlers)
+	var watchList bool
 
 	revisionListCommand := &cobra.Command{
 		Use:     "list",
@@ -54,8 +56,16 @@ func NewRevisionListCommand(p *commands.KnParams) *cobra.Command {
   kn revision list -o json
 
   # List revision 'web'
-  kn revision list web`,
+  kn revision list web
+
+  # List the revisions of service 'svc1' and keep the list updated when they change
+  kn revision list -s svc1 --watch`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if watchList {
+				if err := revisionListFlags.ValidateWatch(); err != nil {
+					return err
+				}
+			}
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
 				return err
@@ -83,7 +93,7 @@ func NewRevisionListCommand(p *commands.KnParams) *cobra.Command {
 			}
 
 			// Stop if nothing found
-			if !revisionListFlags.GenericPrintFlags.OutputFlagSpecified() && len(revisionList.Items) == 0 {
+			if !watchList && !revisionListFlags.GenericPrintFlags.OutputFlagSpecified() && len(revisionList.Items) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "No revisions found.\n")
 				return nil
 			}
@@ -93,9 +103,10 @@ func NewRevisionListCommand(p *commands.KnParams) *cobra.Command {
 				revisionListFlags.EnsureWithNamespace()
 			}
 
-			// Only add temporary annotations if human readable output is requested
-			if !revisionListFlags.GenericPrintFlags.OutputFlagSpecified() {
-				err = enrichRevisionAnnotationsWithServiceData(cmd.Context(), p.NewServingClient, revisionList)
+			// Only add temporary annotations if human readable output is requested. When watching,
+			// they are added for every update of the list.
+			if !watchList && !revisionListFlags.GenericPrintFlags.OutputFlagSpecified() {
+				err = enrichRevisionAnnotationsWithServiceData(revisionList, newServiceCache(cmd.Context(), p.NewServingClient).get)
 				if err != nil {
 					return err
 				}
@@ -104,6 +115,28 @@ func NewRevisionListCommand(p *commands.KnParams) *cobra.Command {
 			// Sort revisions by namespace, service, generation (in this order)
 			sortRevisions(revisionList)
 
+			if watchList {
+				watcher, err := client.WatchRevisions(cmd.Context(), revisionList.ResourceVersion, params...)
+				if err != nil {
+					return err
+				}
+				defer watcher.Stop()
+				services := newServiceCache(cmd.Context(), p.NewServingClient)
+				return revisionListFlags.PrintWatch(cmd.Context(), flags.ListWatch{
+					List:  revisionList,
+					Watch: watcher,
+					Prepare: func(list runtime.Object) error {
+						revisionList := list.(*servingv1.RevisionList)
+						services.refresh(revisionList)
+						if err := enrichRevisionAnnotationsWithServiceData(revisionList, services.get); err != nil {
+							return err
+						}
+						sortRevisions(revisionList)
+						return nil
+					},
+				}, cmd.OutOrStdout())
+			}
+
 			// Print out infos via printer framework
 			return revisionListFlags.Print(revisionList, cmd.OutOrStdout())
 		},
@@ -111,6 +144,9 @@ func NewRevisionListCommand(p *commands.KnParams) *cobra.Command {
 	commands.AddNamespaceFlags(revisionListCommand.Flags(), true)
 	revisionListFlags.AddFlags(revisionListCommand)
 	revisionListCommand.Flags().StringVarP(&serviceNameFilter, "service", "s", "", "Service name")
+	revisionListCommand.Flags().BoolVarP(&watchList, "watch", "w", false,
+		"Keep the list open and update it when revisions change, e.g. when they become ready or receive traffic, until interrupted. "+
+			"Together with '-o json' every change is printed as a watch event in a single line.")
 
 	return revisionListCommand
 }
@@ -198,9 +234,7 @@ type serviceFactoryFunc func(namespace string) (clientservingv1.KnServingClient,
 type serviceGetFunc func(namespace, serviceName string) (*servingv1.Service, error)
 
 // Create revision info with traffic and tag information (if present)
-func enrichRevisionAnnotationsWithServiceData(ctx context.Context, serviceFactory serviceFactoryFunc, revisionList *servingv1.RevisionList) error {
-	serviceLookup := serviceLookup(ctx, serviceFactory)
-
+func enrichRevisionAnnotationsWithServiceData(revisionList *servingv1.RevisionList, serviceLookup serviceGetFunc) error {
 	for _, revision := range revisionList.Items {
 		serviceName := revision.Labels[serving.ServiceLabelKey]
 		if serviceName == "" {
@@ -223,33 +257,81 @@ func enrichRevisionAnnotationsWithServiceData(ctx context.Context, serviceFactor
 
 }
 
-// Create a function for being able to lookup a service for an arbitrary namespace
-func serviceLookup(ctx context.Context, serviceFactory serviceFactoryFunc) serviceGetFunc {
+// serviceCache looks up services for arbitrary namespaces and fetches every service only once
+type serviceCache struct {
+	ctx            context.Context
+	serviceFactory serviceFactoryFunc
 
 	// Two caches: For service & clients (clients might not be necessary though)
-	serviceCache := make(map[string]*servingv1.Service)
-	clientCache := make(map[string]clientservingv1.KnServingClient)
+	services map[string]*servingv1.Service
+	clients  map[string]clientservingv1.KnServingClient
 
-	return func(namespace, serviceName string) (*servingv1.Service, error) {
-		if service, exists := serviceCache[serviceName]; exists {
-			return service, nil
-		}
+	// The revisions seen by the last refresh, by namespace and name
+	revisions map[string]cachedRevision
+}
 
-		client := clientCache[namespace]
-		if client == nil {
-			var err error
-			client, err = serviceFactory(namespace)
-			if err != nil {
-				return nil, err
-			}
-			clientCache[namespace] = client
-		}
+// cachedRevision is the state of a revision at the last refresh of a service cache
+type cachedRevision struct {
+	resourceVersion string
+	service         string
+}
+
+func newServiceCache(ctx context.Context, serviceFactory serviceFactoryFunc) *serviceCache {
+	return &serviceCache{
+		ctx:            ctx,
+		serviceFactory: serviceFactory,
+		services:       make(map[string]*servingv1.Service),
+		clients:        make(map[string]clientservingv1.KnServingClient),
+		revisions:      make(map[string]cachedRevision),
+	}
+}
+
+// get returns the service with the given name, which is fetched only if it's not cached
+func (c *serviceCache) get(namespace, serviceName string) (*servingv1.Service, error) {
+	key := namespace + "/" + serviceName
+	if service, exists := c.services[key]; exists {
+		return service, nil
+	}
 
-		service, err := client.GetService(ctx, serviceName)
+	client := c.clients[namespace]
+	if client == nil {
+		var err error
+		client, err = c.serviceFactory(namespace)
 		if err != nil {
 			return nil, err
 		}
-		serviceCache[serviceName] = service
-		return service, nil
+		c.clients[namespace] = client
+	}
+
+	service, err := client.GetService(c.ctx, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	c.services[key] = service
+	return service, nil
+}
+
+// refresh drops the services of all revisions which have been added, changed or deleted
+// since the last refresh. Traffic changes update the routing state of the revisions, so
+// the services of unchanged revisions don't have to be fetched again.
+func (c *serviceCache) refresh(revisionList *servingv1.RevisionList) {
+	revisions := make(map[string]cachedRevision, len(revisionList.Items))
+	for _, revision := range revisionList.Items {
+		key := revision.Namespace + "/" + revision.Name
+		current := cachedRevision{
+			resourceVersion: revision.ResourceVersion,
+			service:         revision.Labels[serving.ServiceLabelKey],
+		}
+		if previous, exists := c.revisions[key]; !exists || previous != current {
+			delete(c.services, revision.Namespace+"/"+current.service)
+		}
+		delete(c.revisions, key)
+		revisions[key] = current
+	}
+	// The remaining revisions have been deleted
+	for key, deleted := range c.revisions {
+		namespace := strings.SplitN(key, "/", 2)[0]
+		delete(c.services, namespace+"/"+deleted.service)
 	}
+	c.revisions = revisions
 }
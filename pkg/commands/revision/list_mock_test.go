@@ -0,0 +1,101 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/watch"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+	"knative.dev/client/pkg/wait"
+)
+
+func TestRevisionListWatchMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := &servingv1.Service{}
+	service.Name = "svc1"
+	service.Status.Traffic = []servingv1.TrafficTarget{{RevisionName: "svc1-00002", Percent: ptr.Int64(100)}}
+	r.GetService("svc1", service, nil)
+
+	revisionList := &servingv1.RevisionList{Items: []servingv1.Revision{*createMockRevisionWithParams("svc1-00001", "svc1", "1", "", "")}}
+	revisionList.ResourceVersion = "7"
+	r.ListRevisions(mock.Any(), revisionList, nil)
+	fakeWatch := wait.NewFakeWatch([]watch.Event{
+		{Type: watch.Added, Object: createMockRevisionWithParams("svc1-00002", "svc1", "2", "", "")},
+	})
+	fakeWatch.StartAndClose()
+	r.WatchRevisions("7", mock.Any(), fakeWatch, nil)
+	// The service is fetched for the initial list and again because one of its revisions has been added
+	r.GetService("svc1", service, nil)
+	r.GetService("svc1", service, nil)
+
+	output, err := executeRevisionCommand(client, "list", "-s", "svc1", "--watch")
+	assert.NilError(t, err)
+	outputLines := strings.Split(output, "\n")
+	assert.Check(t, util.ContainsAll(outputLines[0], revisionListHeader...))
+	assert.Check(t, util.ContainsAll(outputLines[1], "svc1-00001", "svc1", "1"))
+	assert.Check(t, util.ContainsAll(outputLines[2], "svc1-00002", "svc1", "100%", "2"))
+
+	_, err = executeRevisionCommand(client, "list", "--watch", "-o", "name")
+	assert.ErrorContains(t, err, "--watch supports only the default output format and '-o json'")
+
+	r.Validate()
+}
+
+func TestRevisionListWatchServiceCacheMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	svc1 := &servingv1.Service{}
+	svc1.Name = "svc1"
+	svc1.Status.Traffic = []servingv1.TrafficTarget{{RevisionName: "svc1-00001", Percent: ptr.Int64(100)}}
+	svc2 := &servingv1.Service{}
+	svc2.Name = "svc2"
+	svc2.Status.Traffic = []servingv1.TrafficTarget{{RevisionName: "svc2-00001", Percent: ptr.Int64(100)}}
+
+	svc1Revision := createMockRevisionWithParams("svc1-00001", "svc1", "1", "", "")
+	svc1Revision.ResourceVersion = "1"
+	svc2Revision := createMockRevisionWithParams("svc2-00001", "svc2", "1", "", "")
+	svc2Revision.ResourceVersion = "2"
+	revisionList := &servingv1.RevisionList{Items: []servingv1.Revision{*svc1Revision, *svc2Revision}}
+	revisionList.ResourceVersion = "2"
+	r.ListRevisions(mock.Any(), revisionList, nil)
+
+	updatedRevision := svc2Revision.DeepCopy()
+	updatedRevision.ResourceVersion = "3"
+	fakeWatch := wait.NewFakeWatch([]watch.Event{{Type: watch.Modified, Object: updatedRevision}})
+	fakeWatch.StartAndClose()
+	r.WatchRevisions("2", mock.Any(), fakeWatch, nil)
+	// Both services are fetched for the initial list, but only 'svc2' is fetched again
+	// when its revision changes
+	r.GetService("svc1", svc1, nil)
+	r.GetService("svc2", svc2, nil)
+	r.GetService("svc2", svc2, nil)
+
+	output, err := executeRevisionCommand(client, "list", "--watch")
+	assert.NilError(t, err)
+	assert.Check(t, util.ContainsAll(output, "svc1-00001", "svc2-00001", "100%"))
+
+	r.Validate()
+}
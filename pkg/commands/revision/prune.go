@@ -0,0 +1,273 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	knflags "knative.dev/client/pkg/flags"
+	"knative.dev/client/pkg/printers"
+	clientserving "knative.dev/client/pkg/serving"
+	v1 "knative.dev/client/pkg/serving/v1"
+)
+
+// retentionPolicy describes which revisions of a service are kept when pruning
+type retentionPolicy struct {
+	// Number of most recent revisions to keep
+	keepLast int
+	// Only revisions older than this are deleted
+	olderThan time.Duration
+	// Keep revisions which are referenced by a traffic tag without getting any traffic
+	keepTagged bool
+}
+
+// defaultRetentionPolicy keeps tagged revisions unless configured otherwise
+var defaultRetentionPolicy = retentionPolicy{keepTagged: true}
+
+// parseRetentionPolicy parses a retention policy as given in the revision retention annotation
+// of a service, e.g. 'keep-last=5,older-than=720h,keep-tagged=false'
+func parseRetentionPolicy(value string) (retentionPolicy, error) {
+	policy := defaultRetentionPolicy
+	for _, option := range strings.Split(value, ",") {
+		key, val, _ := strings.Cut(strings.TrimSpace(option), "=")
+		var err error
+		switch key {
+		case "keep-last":
+			policy.keepLast, err = strconv.Atoi(val)
+			if err == nil && policy.keepLast < 0 {
+				err = fmt.Errorf("must not be negative")
+			}
+		case "older-than":
+			policy.olderThan, err = time.ParseDuration(val)
+			if err == nil && policy.olderThan < 0 {
+				err = fmt.Errorf("must not be negative")
+			}
+		case "keep-tagged":
+			policy.keepTagged = true
+			if val != "" {
+				policy.keepTagged, err = strconv.ParseBool(val)
+			}
+		default:
+			return retentionPolicy{}, fmt.Errorf("unknown option '%s', supported options are keep-last, older-than and keep-tagged", key)
+		}
+		if err != nil {
+			return retentionPolicy{}, fmt.Errorf("invalid value '%s' for option '%s': %w", val, key, err)
+		}
+	}
+	return policy, nil
+}
+
+// retentionFlags are the flags for pruning revisions with a retention policy
+type retentionFlags struct {
+	retentionPolicy
+	dryRun bool
+}
+
+var retentionFlagNames = []string{"keep-last", "older-than", "keep-tagged", "no-keep-tagged"}
+
+func (f *retentionFlags) addFlags(command *cobra.Command) {
+	flags := command.Flags()
+	flags.IntVar(&f.keepLast, "keep-last", 0,
+		"Keep the given number of most recent revisions of each service when pruning. Revisions which "+
+			"receive traffic are always kept.")
+	flags.DurationVar(&f.olderThan, "older-than", 0,
+		"Only prune revisions which are older than the given duration, e.g. '720h'.")
+	knflags.AddBothBoolFlagsUnhidden(flags, &f.keepTagged, "keep-tagged", "", true,
+		"Keep revisions which are referenced by a traffic tag without getting any traffic when pruning. "+
+			"(--no-keep-tagged prunes them, their tags have to be removed from the service for its route to stay ready)")
+	flags.BoolVar(&f.dryRun, "dry-run", false,
+		"Only print which revisions would be deleted or kept when pruning, without deleting them.")
+}
+
+// validate checks that the retention flags are only used for pruning
+func (f *retentionFlags) validate(cmd *cobra.Command, prune bool) error {
+	for _, name := range append(retentionFlagNames, "dry-run") {
+		if cmd.Flags().Changed(name) && !prune {
+			return fmt.Errorf("--%s requires --prune or --prune-all", name)
+		}
+	}
+	if f.keepLast < 0 {
+		return fmt.Errorf("--keep-last must not be negative, but is %d", f.keepLast)
+	}
+	if f.olderThan < 0 {
+		return fmt.Errorf("--older-than must not be negative, but is %s", f.olderThan)
+	}
+	return nil
+}
+
+// policyFor returns the retention policy for the given service, as configured in the retention
+// annotation of the service and overridden by the flags given on the command line. It returns
+// false if no retention policy is configured at all.
+func (f *retentionFlags) policyFor(cmd *cobra.Command, service *servingv1.Service) (retentionPolicy, bool, error) {
+	policy := defaultRetentionPolicy
+	configured := false
+	if service != nil {
+		if value, ok := service.Annotations[clientserving.RevisionRetentionAnnotationKey]; ok {
+			var err error
+			policy, err = parseRetentionPolicy(value)
+			if err != nil {
+				return policy, false, fmt.Errorf("invalid annotation '%s' of service '%s': %w", clientserving.RevisionRetentionAnnotationKey, service.Name, err)
+			}
+			configured = true
+		}
+	}
+	if cmd.Flags().Changed("keep-last") {
+		policy.keepLast = f.keepLast
+		configured = true
+	}
+	if cmd.Flags().Changed("older-than") {
+		policy.olderThan = f.olderThan
+		configured = true
+	}
+	if cmd.Flags().Changed("keep-tagged") || cmd.Flags().Changed("no-keep-tagged") {
+		policy.keepTagged = f.keepTagged
+		configured = true
+	}
+	return policy, configured, nil
+}
+
+// pruneAction is the decision whether to delete or keep a revision
+type pruneAction struct {
+	revision servingv1.Revision
+	service  string
+	delete   bool
+	reason   string
+}
+
+// planPruning decides for each of the given revisions whether it gets deleted. The revisions are grouped
+// by their services, for which the retention policy is applied. Revisions of services without a retention
+// policy are deleted when they are not referenced by any route.
+func planPruning(ctx context.Context, cmd *cobra.Command, client v1.KnServingClient, lConfig []v1.ListConfig, flags *retentionFlags) ([]pruneAction, error) {
+	revisionList, err := client.ListRevisions(ctx, lConfig...)
+	if err != nil {
+		return nil, err
+	}
+	// Sort revisions by namespace, service and generation, with the most recent revision first
+	sortRevisions(revisionList)
+
+	var plan []pruneAction
+	revisions := revisionList.Items
+	for len(revisions) > 0 {
+		serviceName := revisions[0].Labels[serving.ServiceLabelKey]
+		end := 1
+		for end < len(revisions) && revisions[end].Labels[serving.ServiceLabelKey] == serviceName {
+			end++
+		}
+		var service *servingv1.Service
+		if serviceName != "" {
+			service, err = client.GetService(ctx, serviceName)
+			if apierrors.IsNotFound(err) {
+				service = nil
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		policy, configured, err := flags.policyFor(cmd, service)
+		if err != nil {
+			return nil, err
+		}
+		if configured {
+			plan = append(plan, planRetention(serviceName, service, revisions[:end], policy, time.Now())...)
+		} else {
+			plan = append(plan, planUnreferenced(serviceName, revisions[:end])...)
+		}
+		revisions = revisions[end:]
+	}
+	return plan, nil
+}
+
+// planUnreferenced deletes all revisions which are not referenced by a route
+func planUnreferenced(serviceName string, revisions []servingv1.Revision) []pruneAction {
+	plan := make([]pruneAction, 0, len(revisions))
+	for _, revision := range revisions {
+		action := pruneAction{revision: revision, service: serviceName, delete: true, reason: "unreferenced"}
+		if revision.GetRoutingState() == servingv1.RoutingStateActive {
+			action.delete = false
+			action.reason = "referenced by a route"
+		}
+		plan = append(plan, action)
+	}
+	return plan
+}
+
+// planRetention applies the retention policy to the revisions of a service, which are sorted by generation
+// with the most recent revision first.
+// The latest created and ready revisions, revisions which receive traffic and revisions which are active
+// in a route are never deleted. Revisions which are only referenced by a tag are kept if the policy says so.
+// The service is nil if it doesn't exist anymore.
+func planRetention(serviceName string, service *servingv1.Service, revisions []servingv1.Revision, policy retentionPolicy, now time.Time) []pruneAction {
+	var routed, tagged map[string]bool
+	if service != nil {
+		routed = clientserving.RoutedRevisions(service, false)
+		tagged = clientserving.RoutedRevisions(service, true)
+	}
+	plan := make([]pruneAction, 0, len(revisions))
+	for i, revision := range revisions {
+		action := pruneAction{revision: revision, service: serviceName}
+		name := revision.Name
+		switch {
+		case service != nil && name == service.Status.LatestCreatedRevisionName:
+			action.reason = "latest created revision"
+		case service != nil && name == service.Status.LatestReadyRevisionName:
+			action.reason = "latest ready revision"
+		case routed[name]:
+			action.reason = "receives traffic"
+		case tagged[name] && policy.keepTagged:
+			action.reason = "tagged"
+		case !tagged[name] && revision.GetRoutingState() == servingv1.RoutingStateActive:
+			action.reason = "referenced by a route"
+		case i < policy.keepLast:
+			action.reason = fmt.Sprintf("one of the last %d revisions", policy.keepLast)
+		case policy.olderThan > 0 && now.Sub(revision.CreationTimestamp.Time) < policy.olderThan:
+			action.reason = fmt.Sprintf("younger than %s", policy.olderThan)
+		default:
+			action.delete = true
+			action.reason = "not retained"
+		}
+		plan = append(plan, action)
+	}
+	return plan
+}
+
+// printPruningPlan prints the decisions of the plan as a table, followed by a summary
+func printPruningPlan(out io.Writer, plan []pruneAction) error {
+	dw := printers.NewPrefixWriter(out)
+	dw.WriteColsLn("SERVICE", "REVISION", "AGE", "ACTION", "REASON")
+	deletions := 0
+	for _, action := range plan {
+		verb := "keep"
+		if action.delete {
+			verb = "delete"
+			deletions++
+		}
+		dw.WriteColsLn(action.service, action.revision.Name, commands.TranslateTimestampSince(action.revision.CreationTimestamp), verb, action.reason)
+	}
+	if err := dw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(out, "\n%d of %d revision(s) would be deleted.\n", deletions, len(plan))
+	return err
+}
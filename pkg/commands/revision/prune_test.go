@@ -0,0 +1,106 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestParseRetentionPolicy(t *testing.T) {
+	policy, err := parseRetentionPolicy("keep-last=5, older-than=720h")
+	assert.NilError(t, err)
+	assert.Equal(t, policy, retentionPolicy{keepLast: 5, olderThan: 720 * time.Hour, keepTagged: true})
+
+	policy, err = parseRetentionPolicy("keep-tagged=false")
+	assert.NilError(t, err)
+	assert.Equal(t, policy, retentionPolicy{})
+
+	for _, tc := range []struct {
+		value         string
+		expectedError string
+	}{
+		{"keep-last=five", "invalid value 'five' for option 'keep-last'"},
+		{"keep-last=-1", "invalid value '-1' for option 'keep-last': must not be negative"},
+		{"older-than=30d", "invalid value '30d' for option 'older-than'"},
+		{"keep-tagged=maybe", "invalid value 'maybe' for option 'keep-tagged'"},
+		{"", "unknown option ''"},
+	} {
+		_, err := parseRetentionPolicy(tc.value)
+		assert.ErrorContains(t, err, tc.expectedError)
+	}
+}
+
+func TestPlanRetentionWithoutService(t *testing.T) {
+	now := time.Now()
+	revisions := createRetentionRevisions().Items
+	sortRevisions(&servingv1.RevisionList{Items: revisions})
+	revisions[1].Labels[serving.RoutingStateLabelKey] = string(servingv1.RoutingStateActive)
+
+	plan := planRetention("svc1", nil, revisions, retentionPolicy{keepLast: 1, olderThan: 720 * time.Hour}, now)
+	var reasons []string
+	for _, action := range plan {
+		reasons = append(reasons, action.revision.Name+": "+action.reason)
+	}
+	assert.DeepEqual(t, reasons, []string{
+		"svc1-00005: one of the last 1 revisions",
+		"svc1-00004: referenced by a route",
+		"svc1-00003: not retained",
+		"svc1-00002: not retained",
+		"svc1-00001: not retained",
+	})
+}
+
+func TestPlanRetentionKeepsTaggedRevisions(t *testing.T) {
+	revisions := createRetentionRevisions().Items
+	sortRevisions(&servingv1.RevisionList{Items: revisions})
+	service := createRetentionService()
+	service.Status.LatestCreatedRevisionName = "svc1-00004"
+	service.Status.LatestReadyRevisionName = "svc1-00004"
+	revisions[0].Labels[serving.RoutingStateLabelKey] = string(servingv1.RoutingStateActive)
+
+	// The tagged revision without traffic is active in the route as well
+	revisions[3].Labels[serving.RoutingStateLabelKey] = string(servingv1.RoutingStateActive)
+
+	plan := planRetention("svc1", service, revisions, defaultRetentionPolicy, time.Now())
+	assert.DeepEqual(t, planReasons(plan), []string{
+		"svc1-00005: referenced by a route",
+		"svc1-00004: latest created revision",
+		"svc1-00003: receives traffic",
+		"svc1-00002: tagged",
+		"svc1-00001: not retained",
+	})
+
+	plan = planRetention("svc1", service, revisions, retentionPolicy{}, time.Now())
+	assert.DeepEqual(t, planReasons(plan), []string{
+		"svc1-00005: referenced by a route",
+		"svc1-00004: latest created revision",
+		"svc1-00003: receives traffic",
+		"svc1-00002: not retained",
+		"svc1-00001: not retained",
+	})
+}
+
+func planReasons(plan []pruneAction) []string {
+	var reasons []string
+	for _, action := range plan {
+		reasons = append(reasons, action.revision.Name+": "+action.reason)
+	}
+	return reasons
+}
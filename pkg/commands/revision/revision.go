@@ -30,6 +30,9 @@ func NewRevisionCommand(p *commands.KnParams) *cobra.Command {
 	revisionCmd.AddCommand(NewRevisionListCommand(p))
 	revisionCmd.AddCommand(NewRevisionDescribeCommand(p))
 	revisionCmd.AddCommand(NewRevisionDeleteCommand(p))
+	revisionCmd.AddCommand(NewRevisionDiffCommand(p))
+	revisionCmd.AddCommand(NewRevisionTagCommand(p))
+	revisionCmd.AddCommand(NewRevisionUntagCommand(p))
 	return revisionCmd
 }
 
@@ -0,0 +1,153 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/config"
+	"knative.dev/client/pkg/printers"
+	v1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/traffic"
+	"knative.dev/client/pkg/wait"
+)
+
+// NewRevisionTagCommand represents 'kn revision tag' command
+func NewRevisionTagCommand(p *commands.KnParams) *cobra.Command {
+	var waitFlags commands.WaitFlags
+	command := &cobra.Command{
+		Use:   "tag REVISION TAG [TAG ...]",
+		Short: "Add traffic tags to a revision",
+		Long: `Add traffic tags to a revision
+
+The tags are added to the traffic of the service the revision belongs to, so that the revision
+is reachable with a dedicated URL. The traffic split of the service is not changed.`,
+		Example: `
+  # Tag revision 'svc1-00002' with 'candidate'
+  kn revision tag svc1-00002 candidate
+
+  # Tag revision 'svc1-00002' with 'candidate' and 'v2'
+  kn revision tag svc1-00002 candidate v2`,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 2 {
+				return errors.New("'kn revision tag' requires the revision name and one or more tags as arguments")
+			}
+			name, tags := args[0], args[1:]
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+			revision, err := client.GetRevision(cmd.Context(), name)
+			if err != nil {
+				return err
+			}
+			serviceName, err := serviceOfRevision(revision)
+			if err != nil {
+				return err
+			}
+
+			revisionsTags := make([]string, 0, len(tags))
+			for _, tag := range tags {
+				revisionsTags = append(revisionsTags, name+"="+tag)
+			}
+			changed, err := updateTags(cmd.Context(), client, serviceName, revisionsTags, nil)
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			if !changed {
+				fmt.Fprintf(out, "Revision '%s' in namespace '%s' is already tagged with '%s'.\n", name, namespace, strings.Join(tags, "', '"))
+				return nil
+			}
+			fmt.Fprintf(out, "Revision '%s' of service '%s' tagged with '%s' in namespace '%s'.\n", name, serviceName, strings.Join(tags, "', '"), namespace)
+			if !waitFlags.Wait {
+				return nil
+			}
+			return waitForTags(cmd.Context(), client, serviceName, tags, out, waitFlags)
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "tag", "service", "ready")
+	return command
+}
+
+// serviceOfRevision returns the name of the service which the revision belongs to
+func serviceOfRevision(revision *servingv1.Revision) (string, error) {
+	serviceName := revision.Labels[serving.ServiceLabelKey]
+	if serviceName == "" {
+		return "", fmt.Errorf("revision '%s' doesn't belong to a service, only revisions of services can be tagged", revision.Name)
+	}
+	return serviceName, nil
+}
+
+// updateTags adds and removes traffic tags of a service
+func updateTags(ctx context.Context, client v1.KnServingClient, serviceName string, revisionsTags []string, untagRevisions []string) (bool, error) {
+	updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
+		targets, err := traffic.ComputeTags(service, revisionsTags, untagRevisions)
+		if err != nil {
+			return nil, err
+		}
+		service.Spec.Traffic = targets
+		return service, nil
+	}
+	return client.UpdateServiceWithRetry(ctx, serviceName, updateFunc, config.DefaultRetry.Steps)
+}
+
+// waitForTags waits until the service is ready and prints the URLs of the given tags
+func waitForTags(ctx context.Context, client v1.KnServingClient, serviceName string, tags []string, out io.Writer, waitFlags commands.WaitFlags) error {
+	fmt.Fprintln(out, "")
+	wconfig := v1.WaitConfig{
+		Timeout:     time.Duration(waitFlags.TimeoutInSeconds) * time.Second,
+		ErrorWindow: time.Duration(waitFlags.ErrorWindowInSeconds) * time.Second,
+	}
+	err, duration := client.WaitForService(ctx, serviceName, wconfig, wait.SimpleMessageCallback(out))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%7.3fs Ready to serve.\n", float64(duration.Round(time.Millisecond))/float64(time.Second))
+	if len(tags) == 0 {
+		return nil
+	}
+
+	service, err := client.GetService(ctx, serviceName)
+	if err != nil {
+		return fmt.Errorf("cannot fetch service '%s' in namespace '%s' for extracting the URLs: %w", serviceName, client.Namespace(), err)
+	}
+	fmt.Fprintln(out, "")
+	dw := printers.NewPrefixWriter(out)
+	for _, tag := range tags {
+		for _, target := range service.Status.Traffic {
+			if target.Tag == tag && target.URL != nil {
+				dw.WriteColsLn(tag, target.URL.String())
+			}
+		}
+	}
+	return dw.Flush()
+}
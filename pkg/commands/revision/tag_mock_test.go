@@ -0,0 +1,147 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+	"knative.dev/client/pkg/wait"
+)
+
+func TestRevisionTagMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.GetRevision("svc1-00002", createMockRevisionWithParams("svc1-00002", "svc1", "2", "", ""), nil)
+	r.GetService("svc1", createTagService(), nil)
+	r.UpdateService(func(t *testing.T, svc *servingv1.Service) {
+		assert.DeepEqual(t, svc.Spec.Traffic, []servingv1.TrafficTarget{
+			{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)},
+			{Tag: "old", RevisionName: "svc1-00001", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(0)},
+			{Tag: "candidate", RevisionName: "svc1-00002", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(0)},
+			{Tag: "v2", RevisionName: "svc1-00002", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(0)},
+		})
+	}, true, nil)
+
+	output, err := executeRevisionCommand(client, "tag", "svc1-00002", "candidate", "v2", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Revision 'svc1-00002' of service 'svc1' tagged with 'candidate', 'v2'", "default"))
+	r.Validate()
+}
+
+func TestRevisionTagWithWaitMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := createTagService()
+	service.Status.Traffic = append(service.Status.Traffic, servingv1.TrafficTarget{
+		Tag:          "candidate",
+		RevisionName: "svc1-00002",
+		URL:          &apis.URL{Scheme: "http", Host: "candidate-svc1.default.example.com"},
+	})
+	r.GetRevision("svc1-00002", createMockRevisionWithParams("svc1-00002", "svc1", "2", "", ""), nil)
+	r.GetService("svc1", createTagService(), nil)
+	r.UpdateService(mock.Any(), true, nil)
+	r.WaitForService("svc1", mock.Any(), wait.NoopMessageCallback(), nil, time.Second)
+	r.GetService("svc1", service, nil)
+
+	output, err := executeRevisionCommand(client, "tag", "svc1-00002", "candidate")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "tagged with 'candidate'", "Ready to serve", "candidate", "http://candidate-svc1.default.example.com"))
+	r.Validate()
+}
+
+func TestRevisionTagErrorsMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	_, err := executeRevisionCommand(client, "tag", "svc1-00002")
+	assert.ErrorContains(t, err, "requires the revision name and one or more tags")
+
+	r.GetRevision("svc1-00002", createMockRevisionWithParams("svc1-00002", "svc1", "2", "", ""), nil)
+	r.GetService("svc1", createTagService(), nil)
+	_, err = executeRevisionCommand(client, "tag", "svc1-00002", "old", "--no-wait")
+	assert.ErrorContains(t, err, "refusing to overwrite existing tag")
+
+	orphan := createMockRevisionWithParams("svc1-00002", "svc1", "2", "", "")
+	delete(orphan.Labels, serving.ServiceLabelKey)
+	r.GetRevision("svc1-00002", orphan, nil)
+	_, err = executeRevisionCommand(client, "tag", "svc1-00002", "candidate")
+	assert.ErrorContains(t, err, "doesn't belong to a service")
+	r.Validate()
+}
+
+func TestRevisionUntagMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.GetRevision("svc1-00001", createMockRevisionWithParams("svc1-00001", "svc1", "1", "", ""), nil)
+	r.GetService("svc1", createTagService(), nil)
+	r.GetService("svc1", createTagService(), nil)
+	r.UpdateService(func(t *testing.T, svc *servingv1.Service) {
+		assert.DeepEqual(t, svc.Spec.Traffic, []servingv1.TrafficTarget{
+			{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)},
+		})
+	}, true, nil)
+
+	output, err := executeRevisionCommand(client, "untag", "svc1-00001", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Tag(s) 'old' removed from revision 'svc1-00001' of service 'svc1'"))
+	r.Validate()
+}
+
+func TestRevisionUntagErrorsMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	_, err := executeRevisionCommand(client, "untag")
+	assert.ErrorContains(t, err, "requires the revision name")
+
+	r.GetRevision("svc1-00001", createMockRevisionWithParams("svc1-00001", "svc1", "1", "", ""), nil)
+	r.GetService("svc1", createTagService(), nil)
+	_, err = executeRevisionCommand(client, "untag", "svc1-00001", "candidate")
+	assert.ErrorContains(t, err, "tag 'candidate' is not assigned to revision 'svc1-00001'")
+
+	r.GetRevision("svc1-00002", createMockRevisionWithParams("svc1-00002", "svc1", "2", "", ""), nil)
+	r.GetService("svc1", createTagService(), nil)
+	_, err = executeRevisionCommand(client, "untag", "svc1-00002")
+	assert.ErrorContains(t, err, "revision 'svc1-00002' has no tags")
+	r.Validate()
+}
+
+// createTagService creates service 'svc1' whose latest revision 'svc1-00002' receives all traffic
+// and whose revision 'svc1-00001' is tagged with 'old'
+func createTagService() *servingv1.Service {
+	service := createMockService("svc1")
+	service.Spec.Traffic = []servingv1.TrafficTarget{
+		{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)},
+		{Tag: "old", RevisionName: "svc1-00001", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(0)},
+	}
+	service.Status.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: "svc1-00002", LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)},
+		{Tag: "old", RevisionName: "svc1-00001", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(0)},
+	}
+	return service
+}
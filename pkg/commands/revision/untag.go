@@ -0,0 +1,106 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/commands"
+)
+
+// NewRevisionUntagCommand represents 'kn revision untag' command
+func NewRevisionUntagCommand(p *commands.KnParams) *cobra.Command {
+	var waitFlags commands.WaitFlags
+	command := &cobra.Command{
+		Use:   "untag REVISION [TAG ...]",
+		Short: "Remove traffic tags from a revision",
+		Long: `Remove traffic tags from a revision
+
+If no tags are given, all tags of the revision are removed. The traffic split of the service
+the revision belongs to is not changed.`,
+		Example: `
+  # Remove tag 'candidate' from revision 'svc1-00002'
+  kn revision untag svc1-00002 candidate
+
+  # Remove all tags from revision 'svc1-00002'
+  kn revision untag svc1-00002`,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 {
+				return errors.New("'kn revision untag' requires the revision name and optionally the tags to remove as arguments")
+			}
+			name, tags := args[0], args[1:]
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+			revision, err := client.GetRevision(cmd.Context(), name)
+			if err != nil {
+				return err
+			}
+			serviceName, err := serviceOfRevision(revision)
+			if err != nil {
+				return err
+			}
+			service, err := client.GetService(cmd.Context(), serviceName)
+			if err != nil {
+				return err
+			}
+
+			_, revisionTags := trafficAndTagsForRevision(name, service)
+			if len(tags) == 0 {
+				if len(revisionTags) == 0 {
+					return fmt.Errorf("revision '%s' has no tags", name)
+				}
+				tags = revisionTags
+			}
+			for _, tag := range tags {
+				if !containsTag(revisionTags, tag) {
+					return fmt.Errorf("tag '%s' is not assigned to revision '%s' of service '%s'", tag, name, serviceName)
+				}
+			}
+
+			if _, err := updateTags(cmd.Context(), client, serviceName, nil, tags); err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Tag(s) '%s' removed from revision '%s' of service '%s' in namespace '%s'.\n", strings.Join(tags, "', '"), name, serviceName, namespace)
+			if !waitFlags.Wait {
+				return nil
+			}
+			return waitForTags(cmd.Context(), client, serviceName, nil, out, waitFlags)
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "untag", "service", "ready")
+	return command
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
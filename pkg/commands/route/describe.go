@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
@@ -31,9 +32,22 @@ import (
 func NewRouteDescribeCommand(p *commands.KnParams) *cobra.Command {
 	// For machine readable output
 	machineReadablePrintFlags := genericclioptions.NewPrintFlags("")
+	var graph bool
 	command := &cobra.Command{
-		Use:               "describe NAME",
-		Short:             "Show details of a route",
+		Use:   "describe NAME",
+		Short: "Show details of a route",
+		Long: `Show details of a route
+
+With --graph, the route is shown as a tree of its traffic targets and the revisions they refer to,
+together with the state of the revisions and the URLs of the tags. The graph can also be printed in
+the Graphviz DOT or the Mermaid format with '-o dot' or '-o mermaid'. Misconfigurations of the traffic
+like tags pointing to revisions which are not ready are reported as warnings.`,
+		Example: `
+  # Show the traffic of route 'mysvc' as tree
+  kn route describe mysvc --graph
+
+  # Render the traffic of route 'mysvc' as image with Graphviz
+  kn route describe mysvc -o dot | dot -Tpng > mysvc.png`,
 		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
@@ -54,6 +68,18 @@ func NewRouteDescribeCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
+			output := *machineReadablePrintFlags.OutputFormat
+			isGraphFormat := output == graphFormatDot || output == graphFormatMermaid
+			if graph && output != "" && !isGraphFormat {
+				return fmt.Errorf("--graph can't be combined with output format '%s', use '%s' or '%s' for graph formats", output, graphFormatDot, graphFormatMermaid)
+			}
+			if graph || isGraphFormat {
+				g, err := buildRouteGraph(cmd.Context(), client, route)
+				if err != nil {
+					return err
+				}
+				return g.print(cmd.OutOrStdout(), output)
+			}
 			if machineReadablePrintFlags.OutputFlagSpecified() {
 				printer, err := machineReadablePrintFlags.ToPrinter()
 				if err != nil {
@@ -71,7 +97,10 @@ func NewRouteDescribeCommand(p *commands.KnParams) *cobra.Command {
 	flags := command.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	machineReadablePrintFlags.AddFlags(command)
+	formats := append(machineReadablePrintFlags.AllowedFormats(), graphFormatDot, graphFormatMermaid)
+	flags.Lookup("output").Usage = fmt.Sprintf("Output format. One of: (%s).", strings.Join(formats, ", "))
 	flags.BoolP("verbose", "v", false, "More output.")
+	flags.BoolVar(&graph, "graph", false, "Show the traffic targets of the route and their revisions as tree.")
 	return command
 }
 
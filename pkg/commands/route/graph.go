@@ -0,0 +1,317 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+// Output formats of the route graph
+const (
+	graphFormatDot     = "dot"
+	graphFormatMermaid = "mermaid"
+)
+
+// routeGraph connects a route with its traffic targets and the revisions they refer to
+type routeGraph struct {
+	route   *servingv1.Route
+	targets []graphTarget
+	// names of the revisions in the order they are referenced first
+	revisionNames []string
+	// revisions by name, nil if a revision doesn't exist
+	revisions map[string]*servingv1.Revision
+	// misconfigurations of the traffic
+	warnings []string
+}
+
+// graphTarget is a traffic target of a route
+type graphTarget struct {
+	servingv1.TrafficTarget
+	warning bool
+}
+
+// buildRouteGraph fetches the revisions of all traffic targets of the route and checks the traffic for
+// misconfigurations: targets without tag and traffic, and targets referring to missing or non-ready revisions
+func buildRouteGraph(ctx context.Context, client clientservingv1.KnServingClient, route *servingv1.Route) (*routeGraph, error) {
+	g := &routeGraph{route: route, revisions: make(map[string]*servingv1.Revision)}
+	for _, target := range route.Spec.Traffic {
+		if target.Tag == "" && target.Percent != nil && *target.Percent == 0 {
+			g.warnings = append(g.warnings, fmt.Sprintf("target %s has neither traffic nor a tag and can be removed", describeTarget(target)))
+		}
+		// Targets which are part of the status are checked below. The status isn't updated as long as
+		// the spec refers to a revision which doesn't exist or isn't ready.
+		if target.RevisionName == "" || hasStatusTarget(route, target) {
+			continue
+		}
+		revision, err := g.fetchRevision(ctx, client, target.RevisionName)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case revision == nil:
+			g.warnings = append(g.warnings, fmt.Sprintf("target %s in the spec refers to revision '%s' which doesn't exist", describeTarget(target), target.RevisionName))
+		case !revision.IsReady():
+			g.warnings = append(g.warnings, fmt.Sprintf("target %s in the spec refers to revision '%s' which is not ready", describeTarget(target), target.RevisionName))
+		}
+	}
+
+	nodes := make(map[string]bool)
+	for _, target := range route.Status.Traffic {
+		gt := graphTarget{TrafficTarget: target}
+		name := target.RevisionName
+		if name == "" {
+			gt.warning = true
+			g.warnings = append(g.warnings, fmt.Sprintf("target %s isn't resolved to a revision", describeTarget(target)))
+			g.targets = append(g.targets, gt)
+			continue
+		}
+		revision, err := g.fetchRevision(ctx, client, name)
+		if err != nil {
+			return nil, err
+		}
+		if !nodes[name] {
+			nodes[name] = true
+			g.revisionNames = append(g.revisionNames, name)
+		}
+
+		percent := int64(0)
+		if target.Percent != nil {
+			percent = *target.Percent
+		}
+		switch {
+		case revision == nil:
+			gt.warning = true
+			g.warnings = append(g.warnings, fmt.Sprintf("target %s refers to revision '%s' which doesn't exist", describeTarget(target), name))
+		case !revision.IsReady() && target.Tag != "":
+			gt.warning = true
+			g.warnings = append(g.warnings, fmt.Sprintf("tag '%s' points to revision '%s' which is not ready", target.Tag, name))
+		case !revision.IsReady() && percent > 0:
+			gt.warning = true
+			g.warnings = append(g.warnings, fmt.Sprintf("revision '%s' receives %d%% of the traffic but is not ready", name, percent))
+		}
+		g.targets = append(g.targets, gt)
+	}
+	return g, nil
+}
+
+// fetchRevision returns the revision with the given name, or nil if it doesn't exist. Revisions are
+// fetched only once.
+func (g *routeGraph) fetchRevision(ctx context.Context, client clientservingv1.KnServingClient, name string) (*servingv1.Revision, error) {
+	if revision, ok := g.revisions[name]; ok {
+		return revision, nil
+	}
+	revision, err := client.GetRevision(ctx, name)
+	if apierrors.IsNotFound(err) {
+		revision = nil
+	} else if err != nil {
+		return nil, err
+	}
+	g.revisions[name] = revision
+	return revision, nil
+}
+
+// hasStatusTarget checks whether the status of the route contains the given target of its spec
+func hasStatusTarget(route *servingv1.Route, target servingv1.TrafficTarget) bool {
+	for _, statusTarget := range route.Status.Traffic {
+		if statusTarget.RevisionName == target.RevisionName && statusTarget.Tag == target.Tag {
+			return true
+		}
+	}
+	return false
+}
+
+// print prints the graph as tree or in the given format
+func (g *routeGraph) print(out io.Writer, format string) error {
+	var lines []string
+	switch format {
+	case graphFormatDot:
+		lines = g.dot()
+	case graphFormatMermaid:
+		lines = g.mermaid()
+	default:
+		lines = g.tree()
+	}
+	_, err := fmt.Fprintln(out, strings.Join(lines, "\n"))
+	return err
+}
+
+func (g *routeGraph) tree() []string {
+	lines := []string{fmt.Sprintf("Route %s (%s)", g.route.Name, g.route.Status.URL.String())}
+	for i, target := range g.targets {
+		branch, indent := "├── ", "│   "
+		if i == len(g.targets)-1 {
+			branch, indent = "└── ", "    "
+		}
+		line := branch + targetLabel(target.TrafficTarget)
+		if target.Tag != "" && target.URL != nil {
+			line += "  " + target.URL.String()
+		}
+		if target.warning {
+			line += "  (!)"
+		}
+		lines = append(lines, line)
+		if target.RevisionName == "" {
+			lines = append(lines, indent+"└── no revision")
+			continue
+		}
+		lines = append(lines, indent+"└── "+strings.Join(g.revisionLabel(target.RevisionName), "  "))
+	}
+	if len(g.warnings) > 0 {
+		lines = append(lines, "", "Warnings:")
+		for _, warning := range g.warnings {
+			lines = append(lines, "  ! "+warning)
+		}
+	}
+	return lines
+}
+
+func (g *routeGraph) dot() []string {
+	routeID := "route/" + g.route.Name
+	lines := []string{
+		fmt.Sprintf("digraph %s {", dotQuote(routeID)),
+		"  rankdir=LR;",
+		"  node [shape=box];",
+	}
+	for _, warning := range g.warnings {
+		lines = append(lines, "  // Warning: "+warning)
+	}
+	lines = append(lines, fmt.Sprintf("  %s [label=%s];", dotQuote(routeID), dotQuote("Route "+g.route.Name+"\n"+g.route.Status.URL.String())))
+	for _, name := range g.revisionNames {
+		attributes := "label=" + dotQuote(strings.Join(g.revisionLabel(name), "\n"))
+		if revision := g.revisions[name]; revision == nil || !revision.IsReady() {
+			attributes += ", color=red"
+		}
+		lines = append(lines, fmt.Sprintf("  %s [%s];", dotQuote("revision/"+name), attributes))
+	}
+	for i, target := range g.targets {
+		targetID := fmt.Sprintf("target/%d", i)
+		attributes := "shape=ellipse, label=" + dotQuote(strings.Join(targetLines(target.TrafficTarget), "\n"))
+		if target.warning {
+			attributes += ", color=red"
+		}
+		lines = append(lines,
+			fmt.Sprintf("  %s [%s];", dotQuote(targetID), attributes),
+			fmt.Sprintf("  %s -> %s;", dotQuote(routeID), dotQuote(targetID)))
+		// Targets without a revision have no edge to a revision
+		if target.RevisionName != "" {
+			lines = append(lines, fmt.Sprintf("  %s -> %s;", dotQuote(targetID), dotQuote("revision/"+target.RevisionName)))
+		}
+	}
+	return append(lines, "}")
+}
+
+func (g *routeGraph) mermaid() []string {
+	lines := []string{"graph LR"}
+	for _, warning := range g.warnings {
+		lines = append(lines, "  %% Warning: "+warning)
+	}
+	lines = append(lines, fmt.Sprintf("  route[%s]", mermaidQuote("Route "+g.route.Name, g.route.Status.URL.String())))
+	revisionIDs := make(map[string]string, len(g.revisionNames))
+	var warningIDs []string
+	for i, name := range g.revisionNames {
+		id := fmt.Sprintf("revision%d", i)
+		revisionIDs[name] = id
+		lines = append(lines, fmt.Sprintf("  %s[%s]", id, mermaidQuote(g.revisionLabel(name)...)))
+		if revision := g.revisions[name]; revision == nil || !revision.IsReady() {
+			warningIDs = append(warningIDs, id)
+		}
+	}
+	for i, target := range g.targets {
+		id := fmt.Sprintf("target%d", i)
+		lines = append(lines,
+			fmt.Sprintf("  %s([%s])", id, mermaidQuote(targetLines(target.TrafficTarget)...)),
+			fmt.Sprintf("  route --> %s", id))
+		if revisionID, ok := revisionIDs[target.RevisionName]; ok {
+			lines = append(lines, fmt.Sprintf("  %s --> %s", id, revisionID))
+		}
+		if target.warning {
+			warningIDs = append(warningIDs, id)
+		}
+	}
+	if len(warningIDs) > 0 {
+		lines = append(lines,
+			"  classDef warning stroke:#d00,stroke-width:2px",
+			"  class "+strings.Join(warningIDs, ",")+" warning")
+	}
+	return lines
+}
+
+// revisionLabel describes the state of a revision
+func (g *routeGraph) revisionLabel(name string) []string {
+	revision := g.revisions[name]
+	if revision == nil {
+		return []string{"Revision " + name, "not found"}
+	}
+	label := []string{"Revision " + name, "Not ready"}
+	if revision.IsReady() {
+		label[1] = "Ready"
+	}
+	if revision.Status.ActualReplicas != nil && revision.Status.DesiredReplicas != nil {
+		label = append(label, fmt.Sprintf("%d/%d replicas", *revision.Status.ActualReplicas, *revision.Status.DesiredReplicas))
+	}
+	return label
+}
+
+// targetLabel describes a traffic target in a single line
+func targetLabel(target servingv1.TrafficTarget) string {
+	percent := int64(0)
+	if target.Percent != nil {
+		percent = *target.Percent
+	}
+	return fmt.Sprintf("%3d%% %s", percent, formatTarget(target))
+}
+
+// targetLines describes a traffic target for graph nodes
+func targetLines(target servingv1.TrafficTarget) []string {
+	lines := []string{strings.TrimSpace(targetLabel(target))}
+	if target.Tag != "" && target.URL != nil {
+		lines = append(lines, target.URL.String())
+	}
+	return lines
+}
+
+// describeTarget names a traffic target in warnings
+func describeTarget(target servingv1.TrafficTarget) string {
+	switch {
+	case target.Tag != "":
+		return fmt.Sprintf("with tag '%s'", target.Tag)
+	case target.LatestRevision != nil && *target.LatestRevision:
+		return "@latest"
+	default:
+		return fmt.Sprintf("'%s'", target.RevisionName)
+	}
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + strings.ReplaceAll(s, "\n", `\n`) + `"`
+}
+
+func mermaidQuote(lines ...string) string {
+	quoted := make([]string, 0, len(lines))
+	for _, line := range lines {
+		quoted = append(quoted, strings.ReplaceAll(line, `"`, "#quot;"))
+	}
+	return `"` + strings.Join(quoted, "<br/>") + `"`
+}
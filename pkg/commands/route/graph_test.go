@@ -0,0 +1,192 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/util"
+)
+
+func fakeRouteGraph(args []string, route *servingv1.Route, revisions ...*servingv1.Revision) (string, error) {
+	knParams := &commands.KnParams{}
+	cmd, fakeServing, buf := commands.CreateTestKnCommand(NewRouteCommand(knParams), knParams)
+	fakeServing.AddReactor("get", "routes",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			return true, route, nil
+		})
+	fakeServing.AddReactor("get", "revisions",
+		func(a clienttesting.Action) (bool, runtime.Object, error) {
+			name := a.(clienttesting.GetAction).GetName()
+			for _, revision := range revisions {
+				if revision.Name == name {
+					return true, revision, nil
+				}
+			}
+			return true, nil, apierrors.NewNotFound(servingv1.Resource("revision"), name)
+		})
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return buf.String(), err
+}
+
+func TestRouteDescribeGraph(t *testing.T) {
+	route := createGraphRoute()
+	output, err := fakeRouteGraph([]string{"route", "describe", "foo", "--graph"}, route,
+		createGraphRevision("foo-00002", true, 2), createGraphRevision("foo-00001", false, 0))
+	assert.NilError(t, err)
+	lines := strings.Split(output, "\n")
+	assert.Equal(t, lines[0], "Route foo (http://foo.default.example.com)")
+	assert.Assert(t, util.ContainsAll(lines[1], "├──", "80%", "@latest (foo-00002)"))
+	assert.Assert(t, util.ContainsAll(lines[2], "└──", "Revision foo-00002", "Ready", "2/2 replicas"))
+	assert.Assert(t, util.ContainsAll(lines[3], "└──", "20%", "foo-00001 #stable", "http://stable-foo.default.example.com", "(!)"))
+	assert.Assert(t, util.ContainsAll(lines[4], "Revision foo-00001", "Not ready", "0/0 replicas"))
+	assert.Assert(t, util.ContainsAll(output, "Warnings:",
+		"tag 'stable' points to revision 'foo-00001' which is not ready",
+		"target 'foo-00000' has neither traffic nor a tag"))
+}
+
+func TestRouteDescribeGraphMissingRevision(t *testing.T) {
+	route := createGraphRoute()
+	route.Spec.Traffic = nil
+	output, err := fakeRouteGraph([]string{"route", "describe", "foo", "--graph"}, route, createGraphRevision("foo-00002", true, 1))
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Revision foo-00001", "not found",
+		"target with tag 'stable' refers to revision 'foo-00001' which doesn't exist"))
+	assert.Assert(t, util.ContainsNone(output, "neither traffic nor a tag"))
+}
+
+func TestRouteDescribeGraphSpecTargets(t *testing.T) {
+	route := createGraphRoute()
+	route.Spec.Traffic = append(route.Spec.Traffic,
+		servingv1.TrafficTarget{RevisionName: "foo-00003", Tag: "canary", Percent: ptr.Int64(0)},
+		servingv1.TrafficTarget{RevisionName: "foo-00004", Tag: "next", Percent: ptr.Int64(0)})
+	output, err := fakeRouteGraph([]string{"route", "describe", "foo", "--graph"}, route,
+		createGraphRevision("foo-00002", true, 2), createGraphRevision("foo-00001", true, 1),
+		createGraphRevision("foo-00000", true, 0), createGraphRevision("foo-00004", false, 0))
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output,
+		"target with tag 'canary' in the spec refers to revision 'foo-00003' which doesn't exist",
+		"target with tag 'next' in the spec refers to revision 'foo-00004' which is not ready"))
+	// Revisions which are only referenced in the spec aren't part of the graph
+	assert.Assert(t, util.ContainsNone(output, "Revision foo-00000", "Revision foo-00003", "Revision foo-00004"))
+}
+
+func TestRouteDescribeGraphUnresolvedTarget(t *testing.T) {
+	route := createGraphRoute()
+	route.Spec.Traffic = nil
+	route.Status.Traffic[0].RevisionName = ""
+	revisions := []*servingv1.Revision{createGraphRevision("foo-00001", true, 1)}
+
+	output, err := fakeRouteGraph([]string{"route", "describe", "foo", "--graph"}, route, revisions...)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "└── no revision", "target @latest isn't resolved to a revision"))
+
+	output, err = fakeRouteGraph([]string{"route", "describe", "foo", "-o", "dot"}, route, revisions...)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, `"route/foo" -> "target/0";`, `"target/1" -> "revision/foo-00001";`))
+	assert.Assert(t, util.ContainsNone(output, `"target/0" ->`, `"revision/"`))
+
+	output, err = fakeRouteGraph([]string{"route", "describe", "foo", "-o", "mermaid"}, route, revisions...)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "route --> target0", "target1 --> revision0", "class target0 warning"))
+	assert.Assert(t, util.ContainsNone(output, "target0 -->"))
+}
+
+func TestRouteDescribeGraphDot(t *testing.T) {
+	output, err := fakeRouteGraph([]string{"route", "describe", "foo", "-o", "dot"}, createGraphRoute(),
+		createGraphRevision("foo-00002", true, 2), createGraphRevision("foo-00001", false, 0))
+	assert.NilError(t, err)
+	assert.Assert(t, strings.HasPrefix(output, `digraph "route/foo" {`))
+	assert.Assert(t, util.ContainsAll(output,
+		`"route/foo" [label="Route foo\nhttp://foo.default.example.com"];`,
+		`"revision/foo-00002" [label="Revision foo-00002\nReady\n2/2 replicas"];`,
+		`"revision/foo-00001" [label="Revision foo-00001\nNot ready\n0/0 replicas", color=red];`,
+		`"target/1" [shape=ellipse, label="20% foo-00001 #stable\nhttp://stable-foo.default.example.com", color=red];`,
+		`"route/foo" -> "target/0";`,
+		`"target/0" -> "revision/foo-00002";`,
+		"// Warning: tag 'stable' points to revision 'foo-00001' which is not ready"))
+	assert.Assert(t, strings.HasSuffix(output, "}\n"))
+}
+
+func TestRouteDescribeGraphMermaid(t *testing.T) {
+	output, err := fakeRouteGraph([]string{"route", "describe", "foo", "-o", "mermaid"}, createGraphRoute(),
+		createGraphRevision("foo-00002", true, 2), createGraphRevision("foo-00001", false, 0))
+	assert.NilError(t, err)
+	assert.Assert(t, strings.HasPrefix(output, "graph LR\n"))
+	assert.Assert(t, util.ContainsAll(output,
+		`route["Route foo<br/>http://foo.default.example.com"]`,
+		`revision0["Revision foo-00002<br/>Ready<br/>2/2 replicas"]`,
+		`target0(["80% @latest (foo-00002)"])`,
+		"route --> target0",
+		"target0 --> revision0",
+		"target1 --> revision1",
+		"%% Warning: tag 'stable' points to revision 'foo-00001' which is not ready",
+		"class revision1,target1 warning"))
+}
+
+func TestRouteDescribeGraphInvalidOutput(t *testing.T) {
+	_, err := fakeRouteGraph([]string{"route", "describe", "foo", "--graph", "-o", "yaml"}, createGraphRoute())
+	assert.ErrorContains(t, err, "--graph can't be combined with output format 'yaml'")
+}
+
+// createGraphRoute creates route 'foo' routing 80% to its latest revision 'foo-00002' and 20% to revision
+// 'foo-00001' with tag 'stable'. The spec of the route contains a target without traffic and tag.
+func createGraphRoute() *servingv1.Route {
+	route := &servingv1.Route{
+		TypeMeta:   metav1.TypeMeta{Kind: "Route", APIVersion: "serving.knative.dev/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+	}
+	route.Spec.Traffic = []servingv1.TrafficTarget{
+		{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(80)},
+		{RevisionName: "foo-00001", Tag: "stable", Percent: ptr.Int64(20)},
+		{RevisionName: "foo-00000", Percent: ptr.Int64(0)},
+	}
+	route.Status.URL = &apis.URL{Scheme: "http", Host: "foo.default.example.com"}
+	route.Status.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: "foo-00002", LatestRevision: ptr.Bool(true), Percent: ptr.Int64(80)},
+		{RevisionName: "foo-00001", Tag: "stable", Percent: ptr.Int64(20),
+			URL: &apis.URL{Scheme: "http", Host: "stable-foo.default.example.com"}},
+	}
+	return route
+}
+
+func createGraphRevision(name string, ready bool, replicas int32) *servingv1.Revision {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	revision := &servingv1.Revision{
+		TypeMeta:   metav1.TypeMeta{Kind: "Revision", APIVersion: "serving.knative.dev/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+	}
+	revision.Status.Conditions = duckv1.Conditions{{Type: apis.ConditionReady, Status: status}}
+	revision.Status.ActualReplicas = ptr.Int32(replicas)
+	revision.Status.DesiredReplicas = ptr.Int32(replicas)
+	return revision
+}
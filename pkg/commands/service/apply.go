@@ -41,11 +41,15 @@ kn service apply s0 --image knativesamples/helloworld --env foo=bar
 
 # Read the service declaration from a file
 kn service apply s0 --filename my-svc.yml
+
+# Show the changes which would be applied, without applying them
+kn service apply s0 --filename my-svc.yml --dry-run
 `
 
 func NewServiceApplyCommand(p *commands.KnParams) *cobra.Command {
 	var applyFlags ConfigurationEditFlags
 	var waitFlags commands.WaitFlags
+	var dryRun bool
 
 	serviceApplyCommand := &cobra.Command{
 		Use:     "apply NAME",
@@ -55,32 +59,36 @@ func NewServiceApplyCommand(p *commands.KnParams) *cobra.Command {
 			if len(args) != 1 && applyFlags.Filename == "" {
 				return errors.New("'service apply' requires the service name given as single argument")
 			}
-			name := ""
-			if len(args) == 1 {
-				name = args[0]
-			}
 
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
 				return err
 			}
 
-			var service *servingv1.Service
-			applyFlags.RevisionName = ""
-			if applyFlags.Filename == "" {
-				service, err = constructService(cmd, applyFlags, name, namespace)
-			} else {
-				service, err = constructServiceFromFile(cmd, applyFlags, name, namespace)
-			}
+			service, err := constructServiceForApply(cmd, applyFlags, args, namespace)
 			if err != nil {
 				return err
 			}
+			if err := resolveImageDigests(cmd, p, applyFlags, namespace, &service.Spec.Template); err != nil {
+				return err
+			}
 
 			client, err := p.NewServingClient(namespace)
 			if err != nil {
 				return err
 			}
 
+			if dryRun {
+				hasDiff, err := printServiceDiff(cmd.Context(), client, service, cmd.OutOrStdout())
+				if err != nil {
+					return err
+				}
+				if !hasDiff {
+					fmt.Fprintf(cmd.OutOrStdout(), "No changes to apply to service '%s'.\n", service.Name)
+				}
+				return nil
+			}
+
 			waitDoing, waitVerb, err := examineServiceForApply(cmd, client, service.Name)
 			if err != nil {
 				return err
@@ -101,9 +109,25 @@ func NewServiceApplyCommand(p *commands.KnParams) *cobra.Command {
 	commands.AddNamespaceFlags(serviceApplyCommand.Flags(), false)
 	applyFlags.AddCreateFlags(serviceApplyCommand)
 	waitFlags.AddConditionWaitFlags(serviceApplyCommand, commands.WaitDefaultTimeout, "apply", "service", "ready")
+	serviceApplyCommand.Flags().BoolVar(&dryRun, "dry-run", false, "Only print the changes which would be applied to the service, without applying them.")
 	return serviceApplyCommand
 }
 
+// constructServiceForApply creates the service declaration to apply, either from the command line options
+// or from the file given with --filename
+func constructServiceForApply(cmd *cobra.Command, applyFlags ConfigurationEditFlags, args []string, namespace string) (*servingv1.Service, error) {
+	name := ""
+	if len(args) == 1 {
+		name = args[0]
+	}
+
+	applyFlags.RevisionName = ""
+	if applyFlags.Filename == "" {
+		return constructService(cmd, applyFlags, name, namespace)
+	}
+	return constructServiceFromFile(cmd, applyFlags, name, namespace)
+}
+
 func examineServiceForApply(cmd *cobra.Command, client clientservingv1.KnServingClient, serviceName string) (string, string, error) {
 	currentService, err := client.GetService(cmd.Context(), serviceName)
 	if err != nil {
@@ -0,0 +1,647 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	"knative.dev/serving/pkg/apis/config"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// composeFile is the subset of the compose specification which can be translated
+// into Knative Services
+type composeFile struct {
+	Services map[string]json.RawMessage `json:"services"`
+}
+
+type composeService struct {
+	Image       string          `json:"image"`
+	Build       json.RawMessage `json:"build"`
+	Entrypoint  composeCommand  `json:"entrypoint"`
+	Command     composeCommand  `json:"command"`
+	Environment composeMapping  `json:"environment"`
+	EnvFile     composeStrings  `json:"env_file"`
+	Ports       []composePort   `json:"ports"`
+	Expose      []json.Number   `json:"expose"`
+	Volumes     []composeVolume `json:"volumes"`
+	Tmpfs       composeStrings  `json:"tmpfs"`
+	Healthcheck *composeHealth  `json:"healthcheck"`
+	Deploy      *composeDeploy  `json:"deploy"`
+	WorkingDir  string          `json:"working_dir"`
+	User        string          `json:"user"`
+	Labels      composeMapping  `json:"labels"`
+	MemLimit    string          `json:"mem_limit"`
+	Cpus        json.Number     `json:"cpus"`
+}
+
+type composeHealth struct {
+	Test        composeCommand `json:"test"`
+	Interval    string         `json:"interval"`
+	Timeout     string         `json:"timeout"`
+	Retries     *int32         `json:"retries"`
+	StartPeriod string         `json:"start_period"`
+	Disable     bool           `json:"disable"`
+}
+
+type composeDeploy struct {
+	Replicas  *int32 `json:"replicas"`
+	Resources struct {
+		Limits       composeResources `json:"limits"`
+		Reservations composeResources `json:"reservations"`
+	} `json:"resources"`
+}
+
+type composeResources struct {
+	Cpus   json.Number `json:"cpus"`
+	Memory string      `json:"memory"`
+}
+
+// composeCommand is a command given either as string or as list of strings
+type composeCommand struct {
+	value []string
+	shell bool
+}
+
+func (c *composeCommand) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		c.value, c.shell = []string{s}, true
+		return nil
+	}
+	return json.Unmarshal(data, &c.value)
+}
+
+// composeStrings is a single string or a list of strings
+type composeStrings []string
+
+func (c *composeStrings) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*c = []string{s}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(c))
+}
+
+// composeMapping is given either as map or as list of KEY=VALUE strings. A nil value
+// means that the value is not set.
+type composeMapping map[string]*string
+
+func (c *composeMapping) UnmarshalJSON(data []byte) error {
+	var list []string
+	if err := json.Unmarshal(data, &list); err == nil {
+		*c = make(composeMapping)
+		for _, entry := range list {
+			key, value, found := strings.Cut(entry, "=")
+			if found {
+				(*c)[key] = ptr.String(value)
+			} else {
+				(*c)[key] = nil
+			}
+		}
+		return nil
+	}
+	var m map[string]any
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	*c = make(composeMapping)
+	for key, value := range m {
+		if value == nil {
+			(*c)[key] = nil
+		} else {
+			(*c)[key] = ptr.String(fmt.Sprint(value))
+		}
+	}
+	return nil
+}
+
+// composePort is given as number, as string '[[ip:]published:]target[/protocol]' or in
+// the long syntax
+type composePort struct {
+	Target      string `json:"target"`
+	Published   string `json:"published"`
+	Protocol    string `json:"protocol"`
+	AppProtocol string `json:"app_protocol"`
+}
+
+func (c *composePort) UnmarshalJSON(data []byte) error {
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err == nil {
+		c.Target = number.String()
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		s, c.Protocol, _ = strings.Cut(s, "/")
+		parts := strings.Split(s, ":")
+		c.Target = parts[len(parts)-1]
+		if len(parts) > 1 {
+			c.Published = parts[len(parts)-2]
+		}
+		return nil
+	}
+	var long struct {
+		Target      json.Number `json:"target"`
+		Published   json.Number `json:"published"`
+		Protocol    string      `json:"protocol"`
+		AppProtocol string      `json:"app_protocol"`
+	}
+	if err := json.Unmarshal(data, &long); err != nil {
+		return err
+	}
+	c.Target, c.Published, c.Protocol, c.AppProtocol = long.Target.String(), long.Published.String(), long.Protocol, long.AppProtocol
+	return nil
+}
+
+// composeVolume is given as string 'source:target[:mode]' or in the long syntax
+type composeVolume struct {
+	Type     string `json:"type"`
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+	ReadOnly bool   `json:"read_only"`
+}
+
+func (c *composeVolume) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		parts := strings.Split(s, ":")
+		switch len(parts) {
+		case 1:
+			c.Target = parts[0]
+		default:
+			c.Source, c.Target = parts[0], parts[1]
+			if len(parts) > 2 {
+				c.ReadOnly = strings.Contains(parts[2], "ro")
+			}
+		}
+		c.Type = "volume"
+		if strings.HasPrefix(c.Source, ".") || strings.HasPrefix(c.Source, "/") || strings.HasPrefix(c.Source, "~") {
+			c.Type = "bind"
+		}
+		return nil
+	}
+	type plain composeVolume
+	return json.Unmarshal(data, (*plain)(c))
+}
+
+// Keys of a compose service which are translated or which can safely be ignored
+var supportedComposeKeys = []string{
+	"image", "build", "entrypoint", "command", "environment", "env_file", "ports", "expose", "volumes", "tmpfs",
+	"healthcheck", "deploy", "working_dir", "user", "labels", "mem_limit", "cpus", "restart", "container_name",
+}
+
+// servicesFromCompose reads a compose file and translates all its services into Knative
+// Services. Warnings about features which cannot be translated are returned per service.
+func servicesFromCompose(filename, namespace string) ([]*servingv1.Service, map[string][]string, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	data = []byte(interpolateCompose(string(data)))
+
+	var compose composeFile
+	if err := yaml.Unmarshal(data, &compose); err != nil {
+		return nil, nil, fmt.Errorf("cannot parse compose file '%s': %w", filename, err)
+	}
+	if len(compose.Services) == 0 {
+		return nil, nil, fmt.Errorf("compose file '%s' does not contain any services", filename)
+	}
+
+	names := make([]string, 0, len(compose.Services))
+	for name := range compose.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var services []*servingv1.Service
+	allWarnings := make(map[string][]string)
+	for _, name := range names {
+		var warnings conversionWarnings
+		raw := compose.Services[name]
+		var keys map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &keys); err != nil {
+			return nil, nil, fmt.Errorf("cannot parse service '%s' of compose file '%s': %w", name, filename, err)
+		}
+		var composeSvc composeService
+		if err := json.Unmarshal(raw, &composeSvc); err != nil {
+			return nil, nil, fmt.Errorf("cannot parse service '%s' of compose file '%s': %w", name, filename, err)
+		}
+		for key := range keys {
+			if !contains(supportedComposeKeys, key) {
+				warnings.add("compose key '%s' is not supported and is ignored", key)
+			}
+		}
+		service, err := serviceFromCompose(name, namespace, composeSvc, filepath.Dir(filename), &warnings)
+		if err != nil {
+			return nil, nil, err
+		}
+		sort.Strings(warnings)
+		allWarnings[service.Name] = warnings
+		services = append(services, service)
+	}
+	return services, allWarnings, nil
+}
+
+// serviceFromCompose translates a single compose service
+func serviceFromCompose(name, namespace string, composeSvc composeService, dir string, warnings *conversionWarnings) (*servingv1.Service, error) {
+	if composeSvc.Image == "" {
+		return nil, fmt.Errorf("compose service '%s' has no image, services which are only built are not supported", name)
+	}
+	if len(composeSvc.Build) > 0 {
+		warnings.add("build configuration is ignored, image '%s' is used", composeSvc.Image)
+	}
+
+	serviceName := strings.ToLower(strings.ReplaceAll(name, "_", "-"))
+	if errs := validation.IsDNS1035Label(serviceName); len(errs) > 0 {
+		return nil, fmt.Errorf("compose service name '%s' cannot be used as service name: %s", name, strings.Join(errs, ", "))
+	}
+	service := newConvertedService(serviceName, namespace)
+	container := corev1.Container{
+		Image:      composeSvc.Image,
+		Command:    composeSvc.Entrypoint.args(),
+		Args:       composeSvc.Command.args(),
+		WorkingDir: composeSvc.WorkingDir,
+	}
+
+	env, err := composeEnv(composeSvc, dir, warnings)
+	if err != nil {
+		return nil, err
+	}
+	container.Env = env
+
+	if port := composeServingPort(composeSvc, warnings); port != nil {
+		container.Ports = []corev1.ContainerPort{*port}
+	}
+
+	volumes, mounts := composeVolumes(composeSvc, warnings)
+	service.Spec.Template.Spec.Volumes = volumes
+	container.VolumeMounts = mounts
+
+	probe, err := composeProbe(composeSvc.Healthcheck)
+	if err != nil {
+		return nil, fmt.Errorf("invalid healthcheck of compose service '%s': %w", name, err)
+	}
+	container.ReadinessProbe = probe
+
+	resources, err := composeResourceRequirements(composeSvc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resources of compose service '%s': %w", name, err)
+	}
+	container.Resources = resources
+
+	if composeSvc.User != "" {
+		if uid, err := strconv.ParseInt(strings.Split(composeSvc.User, ":")[0], 10, 64); err == nil {
+			container.SecurityContext = &corev1.SecurityContext{RunAsUser: ptr.Int64(uid)}
+		} else {
+			warnings.add("user '%s' is not numeric and is ignored", composeSvc.User)
+		}
+	}
+
+	for key, value := range composeSvc.Labels {
+		if value != nil {
+			setTemplateAnnotation(&service.Spec.Template, key, *value)
+		}
+	}
+	if composeSvc.Deploy != nil && composeSvc.Deploy.Replicas != nil && *composeSvc.Deploy.Replicas > 0 {
+		setTemplateAnnotation(&service.Spec.Template, autoscaling.MinScaleAnnotationKey, strconv.Itoa(int(*composeSvc.Deploy.Replicas)))
+		warnings.add("%d replicas are kept as min-scale, the service does not scale to zero unless the annotation '%s' is removed",
+			*composeSvc.Deploy.Replicas, autoscaling.MinScaleAnnotationKey)
+	}
+
+	service.Spec.Template.Spec.Containers = []corev1.Container{container}
+	return service, nil
+}
+
+// args returns the command as argument list. Commands given as string are split
+// like a shell would do.
+func (c composeCommand) args() []string {
+	if c.shell && len(c.value) == 1 {
+		return splitShellWords(c.value[0])
+	}
+	return c.value
+}
+
+// composeEnv merges the env files and the environment of a compose service
+func composeEnv(composeSvc composeService, dir string, warnings *conversionWarnings) ([]corev1.EnvVar, error) {
+	values := make(map[string]string)
+	for _, envFile := range composeSvc.EnvFile {
+		if !filepath.IsAbs(envFile) {
+			envFile = filepath.Join(dir, envFile)
+		}
+		fileValues, err := readEnvFile(envFile)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range fileValues {
+			values[key] = value
+		}
+	}
+	for key, value := range composeSvc.Environment {
+		if value != nil {
+			values[key] = *value
+			continue
+		}
+		if local, ok := os.LookupEnv(key); ok {
+			values[key] = local
+		} else {
+			warnings.add("environment variable '%s' has no value and is not set locally, it is dropped", key)
+		}
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var env []corev1.EnvVar
+	for _, key := range keys {
+		if isReservedEnvVar(key) {
+			warnings.add("environment variable '%s' is set by Knative and is dropped", key)
+			continue
+		}
+		env = append(env, corev1.EnvVar{Name: key, Value: values[key]})
+	}
+	return env, nil
+}
+
+// readEnvFile reads KEY=VALUE lines, ignoring comments and empty lines
+func readEnvFile(filename string) (map[string]string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	values := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, _ := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		values[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"'`)
+	}
+	return values, scanner.Err()
+}
+
+// composeServingPort returns the container port which receives the requests
+func composeServingPort(composeSvc composeService, warnings *conversionWarnings) *corev1.ContainerPort {
+	var targets []string
+	var appProtocol string
+	for _, port := range composeSvc.Ports {
+		if port.Protocol != "" && port.Protocol != "tcp" {
+			warnings.add("port %s uses protocol '%s' which is not supported and is dropped", port.Target, port.Protocol)
+			continue
+		}
+		if len(targets) == 0 {
+			appProtocol = port.AppProtocol
+		}
+		targets = append(targets, port.Target)
+	}
+	if len(targets) == 0 {
+		for _, expose := range composeSvc.Expose {
+			targets = append(targets, expose.String())
+		}
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+	for _, target := range targets[1:] {
+		if target != targets[0] {
+			warnings.add("a Knative Service exposes a single port only, port %s is dropped", target)
+		}
+	}
+	number, err := strconv.ParseInt(targets[0], 10, 32)
+	if err != nil {
+		warnings.add("port '%s' is not a single port number and is dropped", targets[0])
+		return nil
+	}
+	port := &corev1.ContainerPort{ContainerPort: int32(number)}
+	if appProtocol == "h2c" || appProtocol == "grpc" {
+		port.Name = "h2c"
+	}
+	return port
+}
+
+var invalidVolumeNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// composeVolumes translates the volumes of a compose service. Named volumes are mapped
+// to persistent volume claims, anonymous volumes and tmpfs mounts to empty dirs.
+func composeVolumes(composeSvc composeService, warnings *conversionWarnings) ([]corev1.Volume, []corev1.VolumeMount) {
+	var volumes []corev1.Volume
+	var mounts []corev1.VolumeMount
+	addEmptyDir := func(target string, medium corev1.StorageMedium) {
+		name := fmt.Sprintf("volume-%d", len(volumes)+1)
+		volumes = append(volumes, corev1.Volume{Name: name, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{Medium: medium}}})
+		mounts = append(mounts, corev1.VolumeMount{Name: name, MountPath: target})
+	}
+
+	for _, volume := range composeSvc.Volumes {
+		switch {
+		case volume.Type == "tmpfs":
+			addEmptyDir(volume.Target, corev1.StorageMediumMemory)
+		case volume.Type == "volume" && volume.Source == "":
+			addEmptyDir(volume.Target, corev1.StorageMediumDefault)
+		case volume.Type == "volume":
+			name := strings.Trim(invalidVolumeNameChars.ReplaceAllString(strings.ToLower(volume.Source), "-"), "-")
+			warnings.add("volume '%s' is mapped to the persistent volume claim '%s' which must exist and requires the feature '%s' to be enabled in Knative Serving",
+				volume.Source, name, config.FeaturePodSpecPVClaim)
+			volumes = append(volumes, corev1.Volume{Name: name, VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: name, ReadOnly: volume.ReadOnly},
+			}})
+			mounts = append(mounts, corev1.VolumeMount{Name: name, MountPath: volume.Target, ReadOnly: volume.ReadOnly})
+		default:
+			warnings.add("%s mount of '%s' to '%s' is not supported and is dropped", volume.Type, volume.Source, volume.Target)
+		}
+	}
+	for _, tmpfs := range composeSvc.Tmpfs {
+		addEmptyDir(strings.Split(tmpfs, ":")[0], corev1.StorageMediumMemory)
+	}
+	return volumes, mounts
+}
+
+// composeProbe translates a healthcheck into an exec readiness probe
+func composeProbe(health *composeHealth) (*corev1.Probe, error) {
+	if health == nil || health.Disable || len(health.Test.value) == 0 {
+		return nil, nil
+	}
+	var command []string
+	test := health.Test.value
+	switch {
+	case health.Test.shell:
+		command = []string{"/bin/sh", "-c", test[0]}
+	case test[0] == "NONE":
+		return nil, nil
+	case test[0] == "CMD":
+		command = test[1:]
+	case test[0] == "CMD-SHELL":
+		command = []string{"/bin/sh", "-c", strings.Join(test[1:], " ")}
+	default:
+		return nil, fmt.Errorf("test must start with 'CMD', 'CMD-SHELL' or 'NONE'")
+	}
+
+	probe := &corev1.Probe{ProbeHandler: corev1.ProbeHandler{Exec: &corev1.ExecAction{Command: command}}}
+	for _, d := range []struct {
+		value string
+		into  *int32
+	}{
+		{health.Interval, &probe.PeriodSeconds},
+		{health.Timeout, &probe.TimeoutSeconds},
+		{health.StartPeriod, &probe.InitialDelaySeconds},
+	} {
+		if d.value == "" {
+			continue
+		}
+		duration, err := time.ParseDuration(d.value)
+		if err != nil {
+			return nil, err
+		}
+		*d.into = int32(duration.Round(time.Second).Seconds())
+	}
+	if health.Retries != nil {
+		probe.FailureThreshold = *health.Retries
+	}
+	return probe, nil
+}
+
+// composeResourceRequirements translates the resource limits and reservations
+func composeResourceRequirements(composeSvc composeService) (corev1.ResourceRequirements, error) {
+	limits := composeResources{Cpus: composeSvc.Cpus, Memory: composeSvc.MemLimit}
+	var reservations composeResources
+	if composeSvc.Deploy != nil {
+		if composeSvc.Deploy.Resources.Limits.Cpus != "" {
+			limits.Cpus = composeSvc.Deploy.Resources.Limits.Cpus
+		}
+		if composeSvc.Deploy.Resources.Limits.Memory != "" {
+			limits.Memory = composeSvc.Deploy.Resources.Limits.Memory
+		}
+		reservations = composeSvc.Deploy.Resources.Reservations
+	}
+
+	var requirements corev1.ResourceRequirements
+	var err error
+	if requirements.Limits, err = limits.resourceList(); err != nil {
+		return requirements, err
+	}
+	requirements.Requests, err = reservations.resourceList()
+	return requirements, err
+}
+
+func (r composeResources) resourceList() (corev1.ResourceList, error) {
+	var list corev1.ResourceList
+	if r.Cpus != "" {
+		cpu, err := resource.ParseQuantity(r.Cpus.String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid cpus '%s': %w", r.Cpus, err)
+		}
+		list = corev1.ResourceList{corev1.ResourceCPU: cpu}
+	}
+	if r.Memory != "" {
+		memory, err := composeMemory(r.Memory)
+		if err != nil {
+			return nil, err
+		}
+		if list == nil {
+			list = corev1.ResourceList{}
+		}
+		list[corev1.ResourceMemory] = memory
+	}
+	return list, nil
+}
+
+var composeMemoryPattern = regexp.MustCompile(`^(\d+)\s*([bkmg]?)b?$`)
+
+// composeMemory converts a compose byte value like '512m' or '1gb' into a quantity
+func composeMemory(value string) (resource.Quantity, error) {
+	match := composeMemoryPattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(value)))
+	if match == nil {
+		return resource.Quantity{}, fmt.Errorf("invalid memory '%s'", value)
+	}
+	suffix := map[string]string{"": "", "b": "", "k": "Ki", "m": "Mi", "g": "Gi"}[match[2]]
+	return resource.ParseQuantity(match[1] + suffix)
+}
+
+// interpolateCompose replaces variables like ${VAR}, ${VAR:-default} and $VAR with the
+// values from the local environment, like compose does
+func interpolateCompose(content string) string {
+	return os.Expand(content, func(name string) string {
+		if name == "$" {
+			return "$"
+		}
+		if key, def, found := strings.Cut(name, ":-"); found {
+			if value := os.Getenv(key); value != "" {
+				return value
+			}
+			return def
+		}
+		if key, def, found := strings.Cut(name, "-"); found {
+			if value, ok := os.LookupEnv(key); ok {
+				return value
+			}
+			return def
+		}
+		return os.Getenv(name)
+	})
+}
+
+// splitShellWords splits a command line into words, honoring single and double quotes
+func splitShellWords(line string) []string {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	var quote rune
+	for _, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words
+}
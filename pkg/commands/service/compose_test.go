@@ -0,0 +1,263 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/yaml"
+
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+var composeYAML = `
+services:
+  web_app:
+    image: gcr.io/foo/web:${WEB_TAG:-latest}
+    build: .
+    command: serve --greeting "hello world"
+    environment:
+      TARGET: world
+      FROM_ENV:
+      DEBUG: true
+    env_file: web.env
+    ports:
+      - "8080:80"
+      - "9090:9090"
+    volumes:
+      - data:/data
+      - ./src:/src
+      - /cache
+    tmpfs: /tmp
+    healthcheck:
+      test: ["CMD", "curl", "-f", "http://localhost"]
+      interval: 30s
+      timeout: 5s
+      retries: 3
+    deploy:
+      replicas: 2
+      resources:
+        limits:
+          cpus: "0.5"
+          memory: 512M
+        reservations:
+          memory: 128m
+    depends_on:
+      - db
+  db:
+    image: postgres:16
+    user: "999"
+    expose:
+      - 5432
+    healthcheck:
+      test: pg_isready
+`
+
+func writeComposeFile(t *testing.T) string {
+	dir := t.TempDir()
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "web.env"), []byte("# comment\nLEVEL=info\nTARGET=overridden\n"), 0600))
+	file := filepath.Join(dir, "compose.yaml")
+	assert.NilError(t, os.WriteFile(file, []byte(composeYAML), 0600))
+	return file
+}
+
+func TestServicesFromCompose(t *testing.T) {
+	t.Setenv("WEB_TAG", "v2")
+	t.Setenv("FROM_ENV", "local")
+	services, warnings, err := servicesFromCompose(writeComposeFile(t), "ns")
+	assert.NilError(t, err)
+	assert.Equal(t, len(services), 2)
+
+	db := services[0]
+	assert.Equal(t, db.Name, "db")
+	dbContainer := db.Spec.Template.Spec.Containers[0]
+	assert.DeepEqual(t, dbContainer.Ports, []corev1.ContainerPort{{ContainerPort: 5432}})
+	assert.Equal(t, *dbContainer.SecurityContext.RunAsUser, int64(999))
+	assert.DeepEqual(t, dbContainer.ReadinessProbe.Exec.Command, []string{"/bin/sh", "-c", "pg_isready"})
+
+	web := services[1]
+	assert.Equal(t, web.Name, "web-app")
+	assert.Equal(t, web.Namespace, "ns")
+	assert.Equal(t, web.Spec.Template.Annotations[autoscaling.MinScaleAnnotationKey], "2")
+	container := web.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, container.Image, "gcr.io/foo/web:v2")
+	assert.DeepEqual(t, container.Args, []string{"serve", "--greeting", "hello world"})
+	assert.DeepEqual(t, container.Env, []corev1.EnvVar{
+		{Name: "DEBUG", Value: "true"},
+		{Name: "FROM_ENV", Value: "local"},
+		{Name: "LEVEL", Value: "info"},
+		{Name: "TARGET", Value: "world"},
+	})
+	assert.DeepEqual(t, container.Ports, []corev1.ContainerPort{{ContainerPort: 80}})
+	assert.DeepEqual(t, container.ReadinessProbe.Exec.Command, []string{"curl", "-f", "http://localhost"})
+	assert.Equal(t, container.ReadinessProbe.PeriodSeconds, int32(30))
+	assert.Equal(t, container.ReadinessProbe.TimeoutSeconds, int32(5))
+	assert.Equal(t, container.ReadinessProbe.FailureThreshold, int32(3))
+	assert.Assert(t, container.Resources.Limits.Cpu().Equal(resource.MustParse("500m")))
+	assert.Assert(t, container.Resources.Limits.Memory().Equal(resource.MustParse("512Mi")))
+	assert.Assert(t, container.Resources.Requests.Memory().Equal(resource.MustParse("128Mi")))
+
+	volumes := web.Spec.Template.Spec.Volumes
+	assert.Equal(t, len(volumes), 3)
+	assert.Equal(t, volumes[0].PersistentVolumeClaim.ClaimName, "data")
+	assert.Assert(t, volumes[1].EmptyDir != nil)
+	assert.Equal(t, volumes[2].EmptyDir.Medium, corev1.StorageMediumMemory)
+	assert.DeepEqual(t, container.VolumeMounts, []corev1.VolumeMount{
+		{Name: "data", MountPath: "/data"},
+		{Name: "volume-2", MountPath: "/cache"},
+		{Name: "volume-3", MountPath: "/tmp"},
+	})
+
+	assert.Assert(t, util.ContainsAll(joinWarnings(warnings["web-app"]),
+		"compose key 'depends_on' is not supported",
+		"build configuration is ignored",
+		"port 9090 is dropped",
+		"bind mount of './src' to '/src'",
+		"persistent volume claim 'data'",
+		"2 replicas are kept as min-scale, the service does not scale to zero"))
+	assert.Equal(t, len(warnings["db"]), 0)
+}
+
+func TestServicesFromComposeErrors(t *testing.T) {
+	dir := t.TempDir()
+	for _, tc := range []struct {
+		content  string
+		expected string
+	}{
+		{"services: {}", "does not contain any services"},
+		{"services:\n  web:\n    build: .", "has no image"},
+		{"services:\n  web:\n    image: foo\n    deploy:\n      resources:\n        limits:\n          memory: lots", "invalid memory 'lots'"},
+		{"services:\n  web:\n    image: foo\n    healthcheck:\n      test: [\"RUN\", \"true\"]", "test must start with"},
+		{"services:\n  Web.App:\n    image: foo", "cannot be used as service name"},
+	} {
+		file := filepath.Join(dir, "compose.yaml")
+		assert.NilError(t, os.WriteFile(file, []byte(tc.content), 0600))
+		_, _, err := servicesFromCompose(file, "default")
+		assert.ErrorContains(t, err, tc.expected)
+	}
+}
+
+func TestServiceCreateFromComposeDryRun(t *testing.T) {
+	file := writeComposeFile(t)
+	client := knclient.NewMockKnServiceClient(t)
+
+	out, err := executeServiceCommand(client, "create", "--from-compose", file, "--dry-run")
+	assert.NilError(t, err)
+	var list servingv1.ServiceList
+	assert.NilError(t, yaml.Unmarshal([]byte(out[indexOfYAML(out):]), &list))
+	assert.Equal(t, list.Kind, "ServiceList")
+	assert.Equal(t, len(list.Items), 2)
+
+	out, err = executeServiceCommand(client, "create", "db", "--from-compose", file, "--dry-run", "-o", "yaml", "--env", "EXTRA=1")
+	assert.NilError(t, err)
+	var service servingv1.Service
+	assert.NilError(t, yaml.Unmarshal([]byte(out), &service))
+	assert.Equal(t, service.Name, "db")
+	assert.DeepEqual(t, service.Spec.Template.Spec.Containers[0].Env, []corev1.EnvVar{{Name: "EXTRA", Value: "1"}})
+}
+
+func TestServiceCreateFromCompose(t *testing.T) {
+	file := writeComposeFile(t)
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("db", nil, errors.NewNotFound(servingv1.Resource("service"), "db"))
+	r.CreateService(mock.Any(), nil)
+	r.GetService("web-app", nil, errors.NewNotFound(servingv1.Resource("service"), "web-app"))
+	r.CreateService(mock.Any(), nil)
+
+	out, err := executeServiceCommand(client, "create", "--from-compose", file, "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Service 'db' created", "Service 'web-app' created"))
+
+	r.Validate()
+}
+
+func TestServiceCreateFromComposeWait(t *testing.T) {
+	file := writeComposeFile(t)
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("db", nil, errors.NewNotFound(servingv1.Resource("service"), "db"))
+	r.CreateService(mock.Any(), nil)
+	r.GetService("web-app", nil, errors.NewNotFound(servingv1.Resource("service"), "web-app"))
+	r.CreateService(mock.Any(), nil)
+	// Both services are waited for after they have been created
+	r.WaitForService(mock.Any(), mock.Any(), mock.Any(), nil, time.Second)
+	r.WaitForService(mock.Any(), mock.Any(), mock.Any(), nil, time.Second)
+
+	out, err := executeServiceCommand(client, "create", "--from-compose", file, "--wait-concurrency", "1")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Service 'db' created", "Service 'web-app' created",
+		"Waiting for 2 services in namespace 'default':", "Service 'db' done.", "Service 'web-app' done.", "RESULT"))
+	assert.Assert(t, strings.Index(out, "Service 'web-app' created") < strings.Index(out, "Waiting for 2 services"))
+
+	r.Validate()
+}
+
+func TestServiceCreateFromComposeErrors(t *testing.T) {
+	file := writeComposeFile(t)
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("db", &servingv1.Service{}, nil)
+
+	_, err := executeServiceCommand(client, "create", "unknown", "--from-compose", file)
+	assert.ErrorContains(t, err, "doesn't contain a service 'unknown'")
+
+	_, err = executeServiceCommand(client, "create", "--from-compose", file, "--filename", "svc.yaml")
+	assert.ErrorContains(t, err, "--from-compose together with --filename")
+
+	_, err = executeServiceCommand(client, "create", "db", "--from-compose", file, "-o", "yaml")
+	assert.ErrorContains(t, err, "--output only together with --dry-run")
+
+	_, err = executeServiceCommand(client, "create", "db", "--from-compose", file)
+	assert.ErrorContains(t, err, "already exists")
+
+	r.Validate()
+}
+
+func TestSplitShellWords(t *testing.T) {
+	assert.DeepEqual(t, splitShellWords(`sh -c 'echo "a b"'  x`), []string{"sh", "-c", `echo "a b"`, "x"})
+	assert.DeepEqual(t, splitShellWords(`a "" b`), []string{"a", "", "b"})
+}
+
+func TestComposeMemory(t *testing.T) {
+	for value, expected := range map[string]string{"512M": "512Mi", "1gb": "1Gi", "100": "100", "64k": "64Ki"} {
+		quantity, err := composeMemory(value)
+		assert.NilError(t, err)
+		assert.Equal(t, quantity.String(), expected)
+	}
+}
+
+// indexOfYAML skips the warnings which precede the YAML output when stdout and
+// stderr are the same stream
+func indexOfYAML(out string) int {
+	for i := 0; i < len(out); i++ {
+		if (i == 0 || out[i-1] == '\n') && len(out[i:]) > 11 && out[i:i+11] == "apiVersion:" {
+			return i
+		}
+	}
+	return 0
+}
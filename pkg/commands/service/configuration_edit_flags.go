@@ -62,11 +62,15 @@ type ConfigurationEditFlags struct {
 
 	// Preferences about how to do the action.
 	LockToDigest         bool
+	ResolveDigest        bool
 	GenerateRevisionName bool
 	ForceCreate          bool
 
 	Filename string
 
+	// ContainerName selects the container the container related flags apply to
+	ContainerName string
+
 	// Bookkeeping
 	flags []string
 }
@@ -168,6 +172,11 @@ func (p *ConfigurationEditFlags) addSharedFlags(command *cobra.Command) {
 			"the image. (--no-lock-to-digest pulls the image tag afresh with each new revision)")
 	// Don't mark as changing the revision.
 
+	command.Flags().BoolVar(&p.ResolveDigest, "resolve-digest", false,
+		"Resolve the image tags to digests by querying the registries before the service is created or updated, "+
+			"using the image pull secrets of the namespace or the local Docker credentials. "+
+			"Unlike --lock-to-digest, this doesn't depend on the Knative Serving controller and also works with --target.")
+
 	command.Flags().StringArrayVarP(&p.AnnotationsService, "annotation-service", "", []string{},
 		"Service annotation to set. name=value; you may provide this flag "+
 			"any number of times to set multiple annotations. "+
@@ -215,6 +224,9 @@ func (p *ConfigurationEditFlags) AddUpdateFlags(command *cobra.Command) {
 			"any number of times to set multiple labels. "+
 			"To unset, specify the label name followed by a \"-\" (e.g., name-).")
 	p.markFlagMakesRevision("label")
+	command.Flags().StringVar(&p.ContainerName, "container-name", "",
+		"Name of the container to which the container related options like --image, --env, --port, --mount or the probes apply. "+
+			"By default, they apply to the first container. A container with this name is added if the service doesn't have one yet.")
 }
 
 // AddCreateFlags adds the flags specific to create
@@ -251,7 +263,18 @@ func (p *ConfigurationEditFlags) Apply(
 
 	template := &service.Spec.Template
 
-	err := p.PodSpecFlags.ResolvePodSpec(&template.Spec.PodSpec, cmd.Flags(), os.Args)
+	if p.ResolveDigest && !p.LockToDigest {
+		return fmt.Errorf("--resolve-digest can't be combined with --no-lock-to-digest")
+	}
+
+	// Must be checked before the containers are updated
+	mainImageChanged := p.mainImageChanged(cmd, &template.Spec)
+	var err error
+	if p.ContainerName != "" {
+		err = p.PodSpecFlags.ResolveContainer(&template.Spec.PodSpec, p.ContainerName, cmd.Flags(), os.Args)
+	} else {
+		err = p.PodSpecFlags.ResolvePodSpec(&template.Spec.PodSpec, cmd.Flags(), os.Args)
+	}
 	if err != nil {
 		return err
 	}
@@ -269,8 +292,8 @@ func (p *ConfigurationEditFlags) Apply(
 	}
 
 	// If some change happened that can cause a revision, set the update timestamp
-	// But not for "apply", this would destroy idempotency
-	if p.AnyMutation(cmd) && cmd.Name() != "apply" {
+	// But not for "apply" (or "diff" which previews "apply"), this would destroy idempotency
+	if p.AnyMutation(cmd) && !isApplyCommand(cmd) {
 		servinglib.UpdateTimestampAnnotation(template)
 	}
 
@@ -280,7 +303,7 @@ func (p *ConfigurationEditFlags) Apply(
 		// If an --image is given, always use the tagged named to cause a re-resolving
 		// of the digest by the serving backend (except when you use "apply" where you
 		// always have to provide an image
-		if !cmd.Flags().Changed("image") || cmd.Name() == "apply" {
+		if !mainImageChanged || isApplyCommand(cmd) {
 			err = servinglib.PinImageToDigest(template, baseRevision)
 			if err != nil {
 				return err
@@ -563,6 +586,19 @@ func (p *ConfigurationEditFlags) shouldPinToImageDigest(template *servingv1.Revi
 	return p.LockToDigest && p.AnyMutation(cmd)
 }
 
+// mainImageChanged checks whether --image is given for the main container of the revision,
+// and not for another container selected with --container-name
+func (p *ConfigurationEditFlags) mainImageChanged(cmd *cobra.Command, spec *servingv1.RevisionSpec) bool {
+	if !cmd.Flags().Changed("image") {
+		return false
+	}
+	if p.ContainerName == "" {
+		return true
+	}
+	main := servinglib.ContainerOfRevisionSpec(spec)
+	return main == nil || main.Name == p.ContainerName
+}
+
 func (p *ConfigurationEditFlags) updateLabels(obj *metav1.ObjectMeta, flagLabels []string, labelsAllMap map[string]string) error {
 	labelFlagMap, err := util.MapFromArrayAllowingSingles(flagLabels, "=")
 	if err != nil {
@@ -0,0 +1,84 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"io"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// conversionWarnings collects everything which could not be translated when converting
+// other workload definitions into Knative Services
+type conversionWarnings []string
+
+func (w *conversionWarnings) add(format string, a ...interface{}) {
+	*w = append(*w, fmt.Sprintf(format, a...))
+}
+
+// newConvertedService creates an empty service to be filled by a conversion
+func newConvertedService(name, namespace string) *servingv1.Service {
+	return &servingv1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: servingv1.SchemeGroupVersion.String(),
+			Kind:       "Service",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+}
+
+// printConversionWarnings prints the warnings of a conversion. They go to the error
+// stream, so that the output of --dry-run can be piped into other tools.
+func printConversionWarnings(out io.Writer, name string, warnings []string) {
+	for _, warning := range warnings {
+		fmt.Fprintf(out, "Warning: service '%s': %s\n", name, warning)
+	}
+}
+
+// printConvertedServices prints the services of a conversion in the format requested
+// with --output, defaulting to YAML
+func printConvertedServices(printFlags *genericclioptions.PrintFlags, services []*servingv1.Service, out io.Writer) error {
+	if !printFlags.OutputFlagSpecified() {
+		format := "yaml"
+		printFlags.OutputFormat = &format
+	}
+	printer, err := printFlags.ToPrinter()
+	if err != nil {
+		return err
+	}
+	if len(services) == 1 {
+		return printer.PrintObj(services[0], out)
+	}
+	return printer.PrintObj(convertedServiceList(services), out)
+}
+
+func convertedServiceList(services []*servingv1.Service) *servingv1.ServiceList {
+	list := &servingv1.ServiceList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: servingv1.SchemeGroupVersion.String(),
+			Kind:       "ServiceList",
+		},
+	}
+	for _, service := range services {
+		list.Items = append(list.Items, *service)
+	}
+	return list
+}
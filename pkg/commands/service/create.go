@@ -35,10 +35,12 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/wait"
 )
 
 var create_example = `
@@ -92,26 +94,51 @@ var create_example = `
   kn service create tolerationtest --image knativesamples/helloworld --toleration Key="node-role.kubernetes.io/master",Effect="NoSchedule",Operator="Equal",Value=""
 
   # Create a service with node affinity
-  kn service create nodeaffinitytest --image knativesamples/helloworld --node-affinity Type="Required",Key="topology.kubernetes.io/zone",Operator="In",Values="antarctica-east1 antarctica-east2"`
+  kn service create nodeaffinitytest --image knativesamples/helloworld --node-affinity Type="Required",Key="topology.kubernetes.io/zone",Operator="In",Values="antarctica-east1 antarctica-east2"
+
+  # Create a service for every service of a docker-compose file
+  kn service create --from-compose compose.yaml
+
+  # Print the service which would be created for the compose service 'web' without creating it
+  kn service create web --from-compose compose.yaml --dry-run -o yaml
+
+  # Push the image of a local OCI image layout tarball to a registry and create a service with its digest
+  kn service create app --image-tar app.tar --push-to registry.example.com/team/app`
 
 func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 	var editFlags ConfigurationEditFlags
 	var waitFlags commands.WaitFlags
 	var trafficFlags flags.Traffic
+	var fromCompose string
+	var dryRun bool
+	var archiveFlags imageArchiveFlags
+	machineReadablePrintFlags := genericclioptions.NewPrintFlags("")
 
 	serviceCreateCommand := &cobra.Command{
 		Use:     "create NAME --image IMAGE",
 		Short:   "Create a service",
 		Example: create_example,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			if len(args) != 1 && editFlags.Filename == "" {
+			if len(args) > 1 || (len(args) == 0 && editFlags.Filename == "" && fromCompose == "") {
 				return errors.New("'service create' requires the service name given as single argument")
 			}
+			if machineReadablePrintFlags.OutputFlagSpecified() && !dryRun {
+				return errors.New("'service create' supports --output only together with --dry-run")
+			}
+			if err := archiveFlags.validate(cmd); err != nil {
+				return err
+			}
 			name := ""
 			if len(args) == 1 {
 				name = args[0]
 			}
-			if editFlags.PodSpecFlags.Image == "" && editFlags.Filename == "" {
+			if fromCompose != "" {
+				if editFlags.Filename != "" {
+					return errors.New("'service create' doesn't support --from-compose together with --filename")
+				}
+				return createFromCompose(cmd, p, editFlags, waitFlags, fromCompose, name, dryRun, machineReadablePrintFlags)
+			}
+			if editFlags.PodSpecFlags.Image == "" && editFlags.Filename == "" && archiveFlags.ImageTar == "" {
 				return errors.New("'service create' requires the image name to run provided with the --image option")
 			}
 
@@ -119,6 +146,16 @@ func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if archiveFlags.ImageTar != "" {
+				image, err := archiveFlags.pushImageArchive(cmd, dryRun)
+				if err != nil {
+					return err
+				}
+				// The service is created as if the pushed image had been given with --image
+				if err := cmd.Flags().Set("image", image); err != nil {
+					return err
+				}
+			}
 
 			var service *servingv1.Service
 			if editFlags.Filename == "" {
@@ -129,6 +166,9 @@ func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if err := resolveImageDigests(cmd, p, editFlags, namespace, &service.Spec.Template); err != nil {
+				return err
+			}
 			targetFlag := cmd.Flag("target").Value.String()
 			client, err := newServingClient(p, namespace, targetFlag)
 			if err != nil {
@@ -143,6 +183,10 @@ func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 				}
 				service.Spec.Traffic = traffic
 			}
+			if dryRun {
+				service.TypeMeta = newConvertedService("", "").TypeMeta
+				return printConvertedServices(machineReadablePrintFlags, []*servingv1.Service{service}, cmd.OutOrStdout())
+			}
 			serviceExists, err := serviceExists(cmd.Context(), client, service.Name)
 			if err != nil {
 				return err
@@ -170,9 +214,100 @@ func NewServiceCreateCommand(p *commands.KnParams) *cobra.Command {
 	editFlags.AddCreateFlags(serviceCreateCommand)
 	trafficFlags.AddTagFlag(serviceCreateCommand)
 	waitFlags.AddConditionWaitFlags(serviceCreateCommand, commands.WaitDefaultTimeout, "create", "service", "ready")
+	waitFlags.AddConcurrencyFlag(serviceCreateCommand, "service")
+	serviceCreateCommand.Flags().StringVar(&fromCompose, "from-compose", "",
+		"Create a service for every service of the given docker-compose file. "+
+			"If a service name is given, only the compose service with this name is created.")
+	serviceCreateCommand.MarkFlagFilename("from-compose")
+	serviceCreateCommand.Flags().BoolVar(&dryRun, "dry-run", false,
+		"Print the services which would be created instead of creating them. The output format can be chosen with --output.")
+	archiveFlags.addFlags(serviceCreateCommand)
+	machineReadablePrintFlags.AddFlags(serviceCreateCommand)
 	return serviceCreateCommand
 }
 
+// createFromCompose creates a service for the services of a compose file, or for the
+// compose service with the given name only
+func createFromCompose(cmd *cobra.Command, p *commands.KnParams, editFlags ConfigurationEditFlags, waitFlags commands.WaitFlags,
+	filename, name string, dryRun bool, printFlags *genericclioptions.PrintFlags) error {
+	namespace, err := p.GetNamespace(cmd)
+	if err != nil {
+		return err
+	}
+	services, warnings, err := servicesFromCompose(filename, namespace)
+	if err != nil {
+		return err
+	}
+	if name != "" {
+		var selected []*servingv1.Service
+		for _, service := range services {
+			if service.Name == name {
+				selected = append(selected, service)
+			}
+		}
+		if len(selected) == 0 {
+			return fmt.Errorf("compose file '%s' doesn't contain a service '%s'", filename, name)
+		}
+		services = selected
+	}
+
+	for _, service := range services {
+		// Options given on the command line apply to all services
+		if err := editFlags.Apply(service, nil, cmd); err != nil {
+			return err
+		}
+		if err := resolveImageDigests(cmd, p, editFlags, namespace, &service.Spec.Template); err != nil {
+			return err
+		}
+		printConversionWarnings(cmd.ErrOrStderr(), service.Name, warnings[service.Name])
+	}
+	if dryRun {
+		return printConvertedServices(printFlags, services, cmd.OutOrStdout())
+	}
+
+	targetFlag := cmd.Flag("target").Value.String()
+	client, err := newServingClient(p, namespace, targetFlag)
+	if err != nil {
+		return err
+	}
+	out := cmd.OutOrStdout()
+	// Multiple services are waited for at the same time after all of them have been created
+	waitForAll := len(services) > 1 && waitFlags.Wait && targetFlag == ""
+	createFlags := waitFlags
+	if waitForAll {
+		createFlags.Wait = false
+	}
+	var tasks []wait.Task
+	for _, service := range services {
+		exists, err := serviceExists(cmd.Context(), client, service.Name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			if !editFlags.ForceCreate {
+				return fmt.Errorf(
+					"cannot create service '%s' in namespace '%s' "+
+						"because the service already exists and no --force option was given", service.Name, namespace)
+			}
+			err = replaceService(cmd.Context(), client, service, createFlags, out, targetFlag)
+		} else {
+			err = createService(cmd.Context(), client, service, createFlags, out, targetFlag)
+		}
+		if err != nil {
+			return err
+		}
+		tasks = append(tasks, serviceWaitTask(client, service.Name, clientservingv1.WaitConfig{
+			Timeout:     time.Duration(waitFlags.TimeoutInSeconds) * time.Second,
+			ErrorWindow: time.Duration(waitFlags.ErrorWindowInSeconds) * time.Second,
+		}))
+	}
+	if !waitForAll {
+		return nil
+	}
+	fmt.Fprintln(out, "")
+	return commands.WaitForAll(cmd, "Service", namespace, tasks, waitFlags.Concurrency)
+}
+
 func createService(ctx context.Context, client clientservingv1.KnServingClient, service *servingv1.Service, waitFlags commands.WaitFlags, out io.Writer, targetFlag string) error {
 	err := client.CreateService(ctx, service)
 	if err != nil {
@@ -87,6 +87,27 @@ func TestServiceCreateEnvMock(t *testing.T) {
 	r.Validate()
 }
 
+func TestServiceCreateInitContainerMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	r := client.Recorder()
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+
+	service := getService("foo")
+	template := &service.Spec.Template
+	template.Spec.Containers[0].Image = "gcr.io/foo/bar:baz"
+	template.Spec.InitContainers = []corev1.Container{{Name: "setup", Image: "busybox", ImagePullPolicy: corev1.PullIfNotPresent}}
+	template.Annotations = map[string]string{servinglib.UserImageAnnotationKey: "gcr.io/foo/bar:baz"}
+	r.CreateService(verifyService(service, true), nil)
+
+	output, err := executeServiceCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:baz",
+		"--init-container", "name=setup,image=busybox,pull-policy=IfNotPresent", "--no-wait", "--revision-name=")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "created", "foo", "default"))
+
+	r.Validate()
+}
+
 func TestServiceCreateLabel(t *testing.T) {
 	client := knclient.NewMockKnServiceClient(t)
 
@@ -647,6 +668,17 @@ func TestServiceCreateWithScaleServiceAnnotationsError(t *testing.T) {
 	r.Validate()
 }
 
+func TestServiceCreateDryRunMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	output, err := executeServiceCommand(client, "create", "foo", "--image", "gcr.io/foo/bar:baz", "--dry-run", "-o", "json")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, `"kind": "Service"`, `"name": "foo"`, `"image": "gcr.io/foo/bar:baz"`))
+
+	r.Validate()
+}
+
 func getServiceWithUrl(name string, urlName string) *servingv1.Service {
 	service := servingv1.Service{}
 	service.Name = name
@@ -393,6 +393,28 @@ func TestServiceDescribeResources(t *testing.T) {
 	}
 }
 
+func TestServiceDescribeInitContainers(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	expectedService := createTestService("foo", []string{"rev1"}, goodConditions())
+	r.GetService("foo", &expectedService, nil)
+	rev1 := createTestRevision("rev1", 1, goodConditions())
+	rev1.Spec.InitContainers = []v1.Container{
+		{Name: "migrate", Image: "example.com/migrate:v1"},
+		{Name: "setup", Image: "busybox"},
+	}
+	r.GetRevision("rev1", &rev1, nil)
+
+	output, err := executeServiceCommand(client, "describe", "foo")
+	assert.NilError(t, err)
+
+	validateServiceOutput(t, "foo", output)
+	assert.Assert(t, cmp.Regexp("Init Containers:\\s*\n\\s+migrate:\\s+example.com/migrate:v1\\s*\n\\s+setup:\\s+busybox", output))
+
+	r.Validate()
+}
+
 func TestServiceDescribeUserImageVsImage(t *testing.T) {
 	// New mock client
 	client := knclient.NewMockKnServiceClient(t)
@@ -0,0 +1,161 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/output/term"
+	"knative.dev/client/pkg/printers"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+var diffExample = `
+  # Show what 'kn service apply' would change for the service declared in a file
+  kn service diff -f my-svc.yml
+
+  # Show what would change when applying a new image to service 's0'
+  kn service diff s0 --image knativesamples/helloworld --env foo=bar
+
+  # Fail a CI pipeline if the live service differs from the declaration
+  kn service diff -f my-svc.yml > /dev/null || echo "service has drifted"`
+
+// NewServiceDiffCommand represents 'kn service diff' command
+func NewServiceDiffCommand(p *commands.KnParams) *cobra.Command {
+	var applyFlags ConfigurationEditFlags
+
+	command := &cobra.Command{
+		Use:   "diff NAME",
+		Short: "Show the differences between a service declaration and the live service",
+		Long: `Show the differences between a service declaration and the live service
+
+The declaration is merged with the live service in the same way as 'kn service apply' does
+and the result is printed as unified diff against the live service. The command exits with
+exit code 0 if there are no differences and with exit code 1 if differences are found or
+an error occurred.`,
+		Example: diffExample,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if len(args) != 1 && applyFlags.Filename == "" {
+				return errors.New("'service diff' requires the service name given as single argument")
+			}
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			service, err := constructServiceForApply(cmd, applyFlags, args, namespace)
+			if err != nil {
+				return err
+			}
+			if err := resolveImageDigests(cmd, p, applyFlags, namespace, &service.Spec.Template); err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			hasDiff, err := printServiceDiff(cmd.Context(), client, service, cmd.OutOrStdout())
+			if err != nil {
+				return err
+			}
+			if hasDiff {
+				return fmt.Errorf("service '%s' in namespace '%s' differs from the given declaration", service.Name, namespace)
+			}
+			return nil
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	applyFlags.AddCreateFlags(command)
+	return command
+}
+
+// isApplyCommand returns true for commands which construct a service for
+// a three-way merge with the live service
+func isApplyCommand(cmd *cobra.Command) bool {
+	return cmd.Name() == "apply" || cmd.Name() == "diff"
+}
+
+// printServiceDiff prints the differences between the live service and the service that would
+// result from applying the given service. It returns true if there are differences
+func printServiceDiff(ctx context.Context, client clientservingv1.KnServingClient, service *servingv1.Service, out io.Writer) (bool, error) {
+	currentService, err := client.GetService(ctx, service.Name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		currentService = nil
+	}
+
+	current, merged, err := clientservingv1.PreviewApply(currentService, service)
+	if err != nil {
+		return false, err
+	}
+	currentYAML, err := serviceToDiffYAML(current)
+	if err != nil {
+		return false, err
+	}
+	mergedYAML, err := serviceToDiffYAML(merged)
+	if err != nil {
+		return false, err
+	}
+
+	return printers.PrintUnifiedDiff(out, printers.UnifiedDiff{
+		From:      currentYAML,
+		FromLabel: "live/" + service.Name,
+		To:        mergedYAML,
+		ToLabel:   "merged/" + service.Name,
+		Context:   3,
+		Color:     term.IsFancy(out),
+	})
+}
+
+// serviceToDiffYAML serializes a service to YAML, leaving out all fields which would only
+// add noise to a diff
+func serviceToDiffYAML(service *servingv1.Service) (string, error) {
+	if service == nil {
+		return "", nil
+	}
+	service = service.DeepCopy()
+	delete(service.Annotations, corev1.LastAppliedConfigAnnotation)
+	if len(service.Annotations) == 0 {
+		service.Annotations = nil
+	}
+
+	uService, err := util.ToUnstructured(service)
+	if err != nil {
+		return "", err
+	}
+	unstructured.RemoveNestedField(uService.Object, "status")
+	unstructured.RemoveNestedField(uService.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(uService.Object, "spec", "template", "metadata", "creationTimestamp")
+
+	out, err := yaml.Marshal(uService.Object)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
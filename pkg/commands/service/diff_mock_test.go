@@ -0,0 +1,95 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestServiceDiffNewServiceMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", nil, apierrors.NewNotFound(servingv1.Resource("service"), "foo"))
+
+	output, err := executeServiceCommand(client, "diff", "foo", "--image", "gcr.io/foo/bar:baz")
+	assert.ErrorContains(t, err, "differs")
+	assert.Assert(t, util.ContainsAll(output, "--- live/foo", "+++ merged/foo", "+kind: Service", "+      - image: gcr.io/foo/bar:baz"))
+	assert.Assert(t, util.ContainsNone(output, "last-applied-configuration"))
+
+	r.Validate()
+}
+
+func TestServiceDiffChangedMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", createServiceWithImage("foo", "gcr.io/foo/bar:baz"), nil)
+
+	output, err := executeServiceCommand(client, "diff", "foo", "--image", "gcr.io/foo/bar:v2")
+	assert.ErrorContains(t, err, "differs")
+	assert.Assert(t, util.ContainsAll(output, "-      - image: gcr.io/foo/bar:baz", "+      - image: gcr.io/foo/bar:v2"))
+
+	r.Validate()
+}
+
+func TestServiceDiffUnchangedMock(t *testing.T) {
+	testWithServiceFiles(t, func(t *testing.T, file string) {
+		client := knclient.NewMockKnServiceClient(t)
+		r := client.Recorder()
+
+		// Apply the service first to get the service as stored on the cluster
+		var applied *servingv1.Service
+		r.GetService("foo", nil, apierrors.NewNotFound(servingv1.Resource("service"), "foo"))
+		r.ApplyService(func(t *testing.T, a interface{}) {
+			var err error
+			_, applied, err = knclient.PreviewApply(nil, a.(*servingv1.Service))
+			assert.NilError(t, err)
+		}, true, nil)
+		_, err := executeServiceCommand(client, "apply", "--filename", file, "--no-wait")
+		assert.NilError(t, err)
+		r.Validate()
+
+		r.GetService("foo", applied, nil)
+		output, err := executeServiceCommand(client, "diff", "--filename", file)
+		assert.NilError(t, err, output)
+		assert.Equal(t, output, "")
+		r.Validate()
+	})
+}
+
+func TestServiceApplyDryRunMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", createServiceWithImage("foo", "gcr.io/foo/bar:baz"), nil)
+
+	// ApplyService must not be called
+	output, err := executeServiceCommand(client, "apply", "foo", "--image", "gcr.io/foo/bar:v2", "--dry-run")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "+++ merged/foo", "+      - image: gcr.io/foo/bar:v2"))
+
+	r.Validate()
+}
+
+func TestServiceDiffNoName(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	_, err := executeServiceCommand(client, "diff")
+	assert.ErrorContains(t, err, "requires the service name")
+}
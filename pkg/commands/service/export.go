@@ -70,8 +70,9 @@ var IgnoredRevisionLabels = []string{
 }
 
 const (
-	ModeReplay = "replay"
-	ModeExport = "export"
+	ModeReplay     = "replay"
+	ModeExport     = "export"
+	ModeKubernetes = "kubernetes"
 )
 
 // NewServiceExportCommand returns a new command for exporting a service.
@@ -94,7 +95,13 @@ func NewServiceExportCommand(p *commands.KnParams) *cobra.Command {
   kn service export foo --with-revisions --mode=export -n bar -o json
 
   # Export services in kubectl friendly format, as a list kind, one service item for each revision (Beta)
-  kn service export foo --with-revisions --mode=replay -n bar -o json`,
+  kn service export foo --with-revisions --mode=replay -n bar -o json
+
+  # Export a service as Deployment, Service, HorizontalPodAutoscaler and Ingress for clusters without Knative (Beta)
+  kn service export foo --mode=kubernetes -n bar -o yaml
+
+  # Export a service for clusters without Knative, routing to it with a Gateway API HTTPRoute (Beta)
+  kn service export foo --mode=kubernetes --ingress=httproute --gateway=infra/external -n bar -o yaml`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
 				return errors.New("'kn service export' requires name of the service as single argument")
@@ -128,7 +135,12 @@ func NewServiceExportCommand(p *commands.KnParams) *cobra.Command {
 	flags := command.Flags()
 	commands.AddNamespaceFlags(flags, false)
 	flags.Bool("with-revisions", false, "Export all routed revisions (Beta)")
-	flags.String("mode", "", "Format for exporting all routed revisions. One of replay|export (Beta)")
+	flags.String("mode", "", "Format for exporting all routed revisions. One of replay|export|kubernetes. "+
+		"The kubernetes mode exports the service as plain Kubernetes resources, notes about features which can't be converted are added "+
+		"as '"+ExportNotesAnnotationKey+"' annotation (Beta)")
+	flags.String("ingress", ExportIngress, "Resource routing to the service in kubernetes mode. One of ingress|httproute|none (Beta)")
+	flags.String("ingress-class", "", "Class of the Ingress created in kubernetes mode (Beta)")
+	flags.String("gateway", "", "Parent gateway of the HTTPRoute created in kubernetes mode, given as [namespace/]name (Beta)")
 	machineReadablePrintFlags.AddFlags(command)
 	return command
 }
@@ -144,6 +156,23 @@ func exportService(cmd *cobra.Command, service *servingv1.Service, client client
 		return err
 	}
 
+	if mode == ModeKubernetes {
+		if withRevisions {
+			return errors.New("'kn service export' doesn't support --with-revisions in kubernetes mode")
+		}
+		options, err := kubernetesExportOptionsFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		list, notes, err := exportForKubernetes(service.DeepCopy(), options)
+		if err != nil {
+			return err
+		}
+		for _, note := range notes {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s\n", note)
+		}
+		return printer.PrintObj(list, cmd.OutOrStdout())
+	}
 	if mode == ModeReplay {
 		svcList, err := exportServiceListForReplay(cmd.Context(), service.DeepCopy(), client, withRevisions)
 		if err != nil {
@@ -160,6 +189,26 @@ func exportService(cmd *cobra.Command, service *servingv1.Service, client client
 	return printer.PrintObj(knExport, cmd.OutOrStdout())
 }
 
+func kubernetesExportOptionsFromFlags(cmd *cobra.Command) (kubernetesExportOptions, error) {
+	var options kubernetesExportOptions
+	var err error
+	if options.ingress, err = cmd.Flags().GetString("ingress"); err != nil {
+		return options, err
+	}
+	if options.ingressClass, err = cmd.Flags().GetString("ingress-class"); err != nil {
+		return options, err
+	}
+	if options.gateway, err = cmd.Flags().GetString("gateway"); err != nil {
+		return options, err
+	}
+	switch options.ingress {
+	case ExportIngress, ExportHTTPRoute, ExportNoIngress:
+	default:
+		return options, fmt.Errorf("invalid value '%s' for --ingress, must be one of %s|%s|%s", options.ingress, ExportIngress, ExportHTTPRoute, ExportNoIngress)
+	}
+	return options, nil
+}
+
 func exportLatestService(latestSvc *servingv1.Service, withRoutes bool) *servingv1.Service {
 	exportedSvc := servingv1.Service{
 		ObjectMeta: metav1.ObjectMeta{
@@ -293,7 +342,7 @@ func exportForKNImport(ctx context.Context, latestSvc *servingv1.Service, client
 
 func getRevisionsToExport(ctx context.Context, latestSvc *servingv1.Service, client clientservingv1.KnServingClient) (*servingv1.RevisionList, map[string]bool, error) {
 	//get revisions to export from traffic
-	revsMap := getRoutedRevisions(latestSvc)
+	revsMap := clientserving.RoutedRevisions(latestSvc, true)
 
 	// Query for list with filters
 	revisionList, err := client.ListRevisions(ctx, clientservingv1.WithService(latestSvc.ObjectMeta.Name))
@@ -308,18 +357,6 @@ func getRevisionsToExport(ctx context.Context, latestSvc *servingv1.Service, cli
 	return revisionList, revsMap, nil
 }
 
-func getRoutedRevisions(latestSvc *servingv1.Service) map[string]bool {
-	trafficList := latestSvc.Spec.RouteSpec.Traffic
-	revsMap := make(map[string]bool)
-
-	for _, traffic := range trafficList {
-		if traffic.RevisionName != "" {
-			revsMap[traffic.RevisionName] = true
-		}
-	}
-	return revsMap
-}
-
 // sortRevisions sorts revisions by generation and name (in this order)
 func sortRevisions(revisionList *servingv1.RevisionList) {
 	// sort revisionList by configuration generation key
@@ -0,0 +1,460 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	network "knative.dev/networking/pkg/apis/networking"
+	"knative.dev/pkg/kmap"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+const (
+	// ExportNotesAnnotationKey holds the notes about features which got lost when
+	// exporting a service as plain Kubernetes resources
+	ExportNotesAnnotationKey = "client.knative.dev/export-notes"
+
+	// Label selecting the pods of an exported service
+	exportAppLabelKey = "app.kubernetes.io/name"
+
+	// Port Knative uses for the user container if none is given
+	defaultUserPort = 8080
+
+	// Maximum number of replicas of the HPA if the service has no upper scale bound
+	defaultExportMaxReplicas = 10
+
+	// CPU utilization used by the HPA if the service scales on a metric which an HPA
+	// can't handle without custom metrics
+	defaultExportCPUUtilization = 80
+)
+
+// Supported values for --ingress
+const (
+	ExportIngress   = "ingress"
+	ExportHTTPRoute = "httproute"
+	ExportNoIngress = "none"
+)
+
+// kubernetesExportOptions configures how a service is exported as plain Kubernetes resources
+type kubernetesExportOptions struct {
+	// ingress is one of ExportIngress, ExportHTTPRoute or ExportNoIngress
+	ingress string
+	// ingressClass is the class of the created Ingress
+	ingressClass string
+	// gateway is the parent gateway of the HTTPRoute, given as [namespace/]name
+	gateway string
+}
+
+// exportNotes collects notes per exported resource kind
+type exportNotes map[string][]string
+
+func (n exportNotes) add(kind, format string, a ...interface{}) {
+	n[kind] = append(n[kind], fmt.Sprintf(format, a...))
+}
+
+// all returns the notes of all resources, prefixed with their kind
+func (n exportNotes) all() []string {
+	var all []string
+	for _, kind := range []string{"Deployment", "Service", "HorizontalPodAutoscaler", "Ingress", "HTTPRoute"} {
+		for _, note := range n[kind] {
+			all = append(all, kind+": "+note)
+		}
+	}
+	return all
+}
+
+func (n exportNotes) annotate(kind string, meta *metav1.ObjectMeta) {
+	if len(n[kind]) == 0 {
+		return
+	}
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+	meta.Annotations[ExportNotesAnnotationKey] = strings.Join(n[kind], "\n")
+}
+
+// exportForKubernetes converts a service into an equivalent Deployment, Service,
+// HorizontalPodAutoscaler and Ingress or HTTPRoute, for running it on clusters without
+// Knative. Everything which can't be converted is recorded in an annotation of the
+// affected resource and returned as notes.
+func exportForKubernetes(svc *servingv1.Service, options kubernetesExportOptions) (*corev1.List, []string, error) {
+	notes := exportNotes{}
+	name := svc.Name
+	template := svc.Spec.Template.DeepCopy()
+	stripIgnoredAnnotationsFromRevisionTemplate(template)
+	annotations := template.Annotations
+	selector := map[string]string{exportAppLabelKey: name}
+
+	podSpec, port := exportPodSpec(svc, template, notes)
+	minReplicas, maxReplicas := exportScaleBounds(annotations, notes)
+
+	labels := filterKeys(svc.Labels, "serving.knative.dev/", network.VisibilityLabelKey)
+	deployment := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: svc.Namespace, Labels: mergeLabels(labels, selector)},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.Int32(minReplicas),
+			Selector: &metav1.LabelSelector{MatchLabels: selector},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      mergeLabels(filterKeys(template.Labels, "serving.knative.dev/"), selector),
+					Annotations: filterKeys(annotations, autoscaling.GroupName+"/", serving.GroupName+"/", "client.knative.dev/"),
+				},
+				Spec: podSpec,
+			},
+		},
+	}
+	if initial, ok := scaleAnnotation(annotations, autoscaling.InitialScaleAnnotation); ok && initial > minReplicas {
+		deployment.Spec.Replicas = ptr.Int32(initial)
+	}
+	if len(svc.Spec.Traffic) > 1 || (len(svc.Spec.Traffic) == 1 && svc.Spec.Traffic[0].RevisionName != "") {
+		notes.add("Deployment", "traffic splitting and pinned revisions are not supported, the Deployment runs the latest template only")
+	}
+
+	appProtocol := ""
+	portName := "http"
+	if port.Name == "h2c" {
+		appProtocol, portName = "kubernetes.io/h2c", "h2c"
+	}
+	service := &corev1.Service{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: svc.Namespace, Labels: mergeLabels(labels, selector)},
+		Spec: corev1.ServiceSpec{
+			Selector: selector,
+			Ports: []corev1.ServicePort{{
+				Name:       portName,
+				Port:       80,
+				TargetPort: intstr.FromInt32(port.ContainerPort),
+			}},
+		},
+	}
+	if appProtocol != "" {
+		service.Spec.Ports[0].AppProtocol = ptr.String(appProtocol)
+	}
+
+	hpa := exportHPA(svc, annotations, minReplicas, maxReplicas, notes)
+	if hpa == nil {
+		// Without an HPA, the notes about scaling apply to the replicas of the Deployment
+		notes["Deployment"] = append(notes["Deployment"], notes["HorizontalPodAutoscaler"]...)
+		delete(notes, "HorizontalPodAutoscaler")
+	}
+	routeObject, err := exportRoute(svc, options, notes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	notes.annotate("Deployment", &deployment.ObjectMeta)
+	notes.annotate("Service", &service.ObjectMeta)
+	objects := []runtime.Object{deployment, service}
+	if hpa != nil {
+		notes.annotate("HorizontalPodAutoscaler", &hpa.ObjectMeta)
+		objects = append(objects, hpa)
+	}
+	switch route := routeObject.(type) {
+	case *networkingv1.Ingress:
+		notes.annotate("Ingress", &route.ObjectMeta)
+		objects = append(objects, route)
+	case *unstructured.Unstructured:
+		if len(notes["HTTPRoute"]) > 0 {
+			route.SetAnnotations(map[string]string{ExportNotesAnnotationKey: strings.Join(notes["HTTPRoute"], "\n")})
+		}
+		objects = append(objects, route)
+	}
+
+	list := &corev1.List{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "List"}}
+	for _, obj := range objects {
+		list.Items = append(list.Items, runtime.RawExtension{Object: obj})
+	}
+	return list, notes.all(), nil
+}
+
+// exportPodSpec returns the pod spec of the Deployment and the port of the serving container
+func exportPodSpec(svc *servingv1.Service, template *servingv1.RevisionTemplateSpec, notes exportNotes) (corev1.PodSpec, corev1.ContainerPort) {
+	podSpec := *template.Spec.PodSpec.DeepCopy()
+	port := corev1.ContainerPort{ContainerPort: defaultUserPort}
+	servingIndex := 0
+	for i, container := range podSpec.Containers {
+		if len(container.Ports) > 0 {
+			servingIndex = i
+			port = container.Ports[0]
+			if port.ContainerPort == 0 {
+				port.ContainerPort = defaultUserPort
+			}
+		}
+	}
+
+	if len(podSpec.Containers) > 0 {
+		container := &podSpec.Containers[servingIndex]
+		container.Ports = []corev1.ContainerPort{{Name: port.Name, ContainerPort: port.ContainerPort}}
+		if container.Ports[0].Name != "h2c" {
+			container.Ports[0].Name = "http"
+		}
+		// Environment variables which Knative sets for the user container
+		container.Env = append(container.Env,
+			corev1.EnvVar{Name: "PORT", Value: strconv.Itoa(int(port.ContainerPort))},
+			corev1.EnvVar{Name: "K_SERVICE", Value: svc.Name})
+		// Knative probes the serving port if a probe has no port
+		for _, probe := range []*corev1.Probe{container.LivenessProbe, container.ReadinessProbe, container.StartupProbe} {
+			if probe == nil {
+				continue
+			}
+			if probe.HTTPGet != nil && probe.HTTPGet.Port.IntValue() == 0 && probe.HTTPGet.Port.Type == intstr.Int {
+				probe.HTTPGet.Port = intstr.FromInt32(port.ContainerPort)
+			}
+			if probe.TCPSocket != nil && probe.TCPSocket.Port.IntValue() == 0 && probe.TCPSocket.Port.Type == intstr.Int {
+				probe.TCPSocket.Port = intstr.FromInt32(port.ContainerPort)
+			}
+		}
+		if container.ReadinessProbe == nil {
+			container.ReadinessProbe = &corev1.Probe{ProbeHandler: corev1.ProbeHandler{
+				TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt32(port.ContainerPort)},
+			}}
+		}
+	}
+
+	spec := template.Spec
+	if spec.ContainerConcurrency != nil && *spec.ContainerConcurrency > 0 {
+		notes.add("Deployment", "container concurrency %d is not enforced, requests are no longer queued", *spec.ContainerConcurrency)
+	}
+	if spec.TimeoutSeconds != nil {
+		notes.add("Deployment", "request timeout of %ds is not enforced", *spec.TimeoutSeconds)
+	}
+	if spec.ResponseStartTimeoutSeconds != nil {
+		notes.add("Deployment", "response start timeout of %ds is not enforced", *spec.ResponseStartTimeoutSeconds)
+	}
+	if spec.IdleTimeoutSeconds != nil {
+		notes.add("Deployment", "idle timeout of %ds is not enforced", *spec.IdleTimeoutSeconds)
+	}
+	if len(template.Spec.Containers) > 0 && template.Spec.Containers[0].Image != "" &&
+		!strings.Contains(template.Spec.Containers[0].Image, "@") {
+		notes.add("Deployment", "image tags are not resolved to digests, pods may run different images when the tag moves")
+	}
+	return podSpec, port
+}
+
+// exportScaleBounds returns the replica bounds for the HPA. Scale to zero is not
+// possible without Knative, so there is always at least one replica.
+func exportScaleBounds(annotations map[string]string, notes exportNotes) (int32, int32) {
+	minReplicas, _ := scaleAnnotation(annotations, autoscaling.MinScaleAnnotation)
+	if minReplicas < 1 {
+		notes.add("HorizontalPodAutoscaler", "scale to zero is not supported, at least one replica is kept running")
+		minReplicas = 1
+	}
+	maxReplicas, _ := scaleAnnotation(annotations, autoscaling.MaxScaleAnnotation)
+	if maxReplicas < 1 {
+		maxReplicas = defaultExportMaxReplicas
+		if maxReplicas < minReplicas {
+			maxReplicas = minReplicas
+		}
+		notes.add("HorizontalPodAutoscaler", "no upper scale bound is set, maxReplicas is set to %d", maxReplicas)
+	}
+	return minReplicas, maxReplicas
+}
+
+func scaleAnnotation(annotations map[string]string, key kmap.KeyPriority) (int32, bool) {
+	_, value, ok := key.Get(annotations)
+	if !ok {
+		return 0, false
+	}
+	number, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return int32(number), true
+}
+
+// exportHPA maps the autoscaling annotations to a HorizontalPodAutoscaler. No HPA is
+// created if the service runs with a fixed number of replicas.
+func exportHPA(svc *servingv1.Service, annotations map[string]string, minReplicas, maxReplicas int32, notes exportNotes) *autoscalingv2.HorizontalPodAutoscaler {
+	if minReplicas == maxReplicas {
+		return nil
+	}
+	metric := autoscaling.MetricAnnotation.Value(annotations)
+	target := autoscaling.TargetAnnotation.Value(annotations)
+	class := autoscaling.ClassAnnotation.Value(annotations)
+
+	utilization := int32(defaultExportCPUUtilization)
+	resourceName := corev1.ResourceCPU
+	metricTarget := autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: ptr.Int32(utilization)}
+	switch {
+	case metric == autoscaling.CPU && class == autoscaling.HPA:
+		if value, err := strconv.ParseFloat(target, 64); err == nil && value > 0 {
+			metricTarget.AverageUtilization = ptr.Int32(int32(value))
+		}
+	case metric == autoscaling.Memory && class == autoscaling.HPA:
+		// The memory target of the Knative HPA class is an absolute value in Mi
+		value, err := resource.ParseQuantity(target + "Mi")
+		if err != nil || value.Sign() <= 0 {
+			notes.add("HorizontalPodAutoscaler", "memory target '%s' is invalid, scaling on %d%% CPU utilization instead", target, utilization)
+			break
+		}
+		resourceName = corev1.ResourceMemory
+		metricTarget = autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: &value}
+	default:
+		if metric == "" {
+			metric = autoscaling.Concurrency
+		}
+		notes.add("HorizontalPodAutoscaler",
+			"scaling on metric '%s' requires custom metrics, scaling on %d%% CPU utilization instead", metric, utilization)
+	}
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "autoscaling/v2", Kind: "HorizontalPodAutoscaler"},
+		ObjectMeta: metav1.ObjectMeta{Name: svc.Name, Namespace: svc.Namespace},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: svc.Name},
+			MinReplicas:    ptr.Int32(minReplicas),
+			MaxReplicas:    maxReplicas,
+			Metrics: []autoscalingv2.MetricSpec{{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name:   resourceName,
+					Target: metricTarget,
+				},
+			}},
+		},
+	}
+	// The stable window of Knative corresponds to the scale down stabilization of an HPA
+	if window := autoscaling.WindowAnnotation.Value(annotations); window != "" {
+		if duration, err := time.ParseDuration(window); err == nil {
+			hpa.Spec.Behavior = &autoscalingv2.HorizontalPodAutoscalerBehavior{
+				ScaleDown: &autoscalingv2.HPAScalingRules{StabilizationWindowSeconds: ptr.Int32(int32(duration.Seconds()))},
+			}
+		}
+	}
+	if _, _, ok := autoscaling.PanicWindowPercentageAnnotation.Get(annotations); ok {
+		notes.add("HorizontalPodAutoscaler", "panic mode settings have no equivalent and are dropped")
+	}
+	return hpa
+}
+
+// exportRoute creates an Ingress or an HTTPRoute routing the URL of the service to the
+// exported Service
+func exportRoute(svc *servingv1.Service, options kubernetesExportOptions, notes exportNotes) (runtime.Object, error) {
+	kind := "Ingress"
+	if options.ingress == ExportHTTPRoute {
+		kind = "HTTPRoute"
+	}
+	if options.ingress == ExportNoIngress {
+		return nil, nil
+	}
+	if svc.Labels[network.VisibilityLabelKey] == serving.VisibilityClusterLocal {
+		notes.add("Service", "the service is cluster-local, no %s is created", kind)
+		return nil, nil
+	}
+	host := svc.Name + "." + svc.Namespace + ".example.com"
+	tls := false
+	if svc.Status.URL != nil {
+		host = svc.Status.URL.Host
+		tls = svc.Status.URL.Scheme == "https"
+	} else {
+		notes.add(kind, "the service has no URL yet, host '%s' is a placeholder", host)
+	}
+	for _, target := range svc.Status.Traffic {
+		if target.Tag != "" {
+			notes.add(kind, "the URL of tag '%s' is not routed", target.Tag)
+		}
+	}
+
+	if options.ingress == ExportHTTPRoute {
+		gatewayNamespace, gatewayName, found := strings.Cut(options.gateway, "/")
+		if !found {
+			gatewayNamespace, gatewayName = "", options.gateway
+		}
+		if gatewayName == "" {
+			return nil, fmt.Errorf("exporting an HTTPRoute requires the parent gateway given with --gateway")
+		}
+		parentRef := map[string]interface{}{"name": gatewayName}
+		if gatewayNamespace != "" {
+			parentRef["namespace"] = gatewayNamespace
+		}
+		if tls {
+			notes.add(kind, "TLS has to be configured at gateway '%s'", options.gateway)
+		}
+		route := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "gateway.networking.k8s.io/v1",
+			"kind":       "HTTPRoute",
+			"metadata": map[string]interface{}{
+				"name":      svc.Name,
+				"namespace": svc.Namespace,
+			},
+			"spec": map[string]interface{}{
+				"parentRefs": []interface{}{parentRef},
+				"hostnames":  []interface{}{host},
+				"rules": []interface{}{map[string]interface{}{
+					"backendRefs": []interface{}{map[string]interface{}{"name": svc.Name, "port": int64(80)}},
+				}},
+			},
+		}}
+		return route, nil
+	}
+
+	pathType := networkingv1.PathTypePrefix
+	ingress := &networkingv1.Ingress{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "networking.k8s.io/v1", Kind: "Ingress"},
+		ObjectMeta: metav1.ObjectMeta{Name: svc.Name, Namespace: svc.Namespace},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				Host: host,
+				IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{{
+						Path:     "/",
+						PathType: &pathType,
+						Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+							Name: svc.Name,
+							Port: networkingv1.ServiceBackendPort{Number: 80},
+						}},
+					}},
+				}},
+			}},
+		},
+	}
+	if options.ingressClass != "" {
+		ingress.Spec.IngressClassName = ptr.String(options.ingressClass)
+	}
+	if tls {
+		secret := svc.Name + "-tls"
+		ingress.Spec.TLS = []networkingv1.IngressTLS{{Hosts: []string{host}, SecretName: secret}}
+		notes.add(kind, "the TLS certificate has to be provided in secret '%s'", secret)
+	}
+	return ingress, nil
+}
+
+func mergeLabels(labels, additional map[string]string) map[string]string {
+	merged := make(map[string]string, len(labels)+len(additional))
+	for k, v := range labels {
+		merged[k] = v
+	}
+	for k, v := range additional {
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,214 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	network "knative.dev/networking/pkg/apis/networking"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestExportForKubernetes(t *testing.T) {
+	svc := createServiceForKubernetesExport()
+	list, notes, err := exportForKubernetes(svc, kubernetesExportOptions{ingress: ExportIngress, ingressClass: "nginx"})
+	assert.NilError(t, err)
+	assert.Equal(t, len(list.Items), 4)
+
+	deployment := list.Items[0].Object.(*appsv1.Deployment)
+	assert.Equal(t, deployment.Name, "foo")
+	assert.Equal(t, *deployment.Spec.Replicas, int32(2))
+	assert.DeepEqual(t, deployment.Spec.Selector.MatchLabels, map[string]string{exportAppLabelKey: "foo"})
+	assert.Equal(t, deployment.Spec.Template.Labels[exportAppLabelKey], "foo")
+	assert.Equal(t, deployment.Spec.Template.Annotations[autoscaling.MinScaleAnnotationKey], "")
+	container := deployment.Spec.Template.Spec.Containers[0]
+	assert.DeepEqual(t, container.Ports, []corev1.ContainerPort{{Name: "http", ContainerPort: 9000}})
+	assert.DeepEqual(t, container.Env, []corev1.EnvVar{{Name: "a", Value: "b"}, {Name: "PORT", Value: "9000"}, {Name: "K_SERVICE", Value: "foo"}})
+	assert.Equal(t, container.ReadinessProbe.HTTPGet.Port, intstr.FromInt32(9000))
+	assert.Assert(t, util.ContainsAll(deployment.Annotations[ExportNotesAnnotationKey], "container concurrency 10", "request timeout of 300s"))
+
+	service := list.Items[1].Object.(*corev1.Service)
+	assert.Equal(t, service.Spec.Ports[0].Port, int32(80))
+	assert.Equal(t, service.Spec.Ports[0].TargetPort, intstr.FromInt32(9000))
+
+	hpa := list.Items[2].Object.(*autoscalingv2.HorizontalPodAutoscaler)
+	assert.Equal(t, *hpa.Spec.MinReplicas, int32(2))
+	assert.Equal(t, hpa.Spec.MaxReplicas, int32(5))
+	assert.Equal(t, hpa.Spec.Metrics[0].Resource.Name, corev1.ResourceCPU)
+	assert.Equal(t, *hpa.Spec.Metrics[0].Resource.Target.AverageUtilization, int32(80))
+	assert.Equal(t, *hpa.Spec.Behavior.ScaleDown.StabilizationWindowSeconds, int32(120))
+	assert.Assert(t, util.ContainsAll(hpa.Annotations[ExportNotesAnnotationKey], "metric 'concurrency' requires custom metrics"))
+
+	ingress := list.Items[3].Object.(*networkingv1.Ingress)
+	assert.Equal(t, ingress.Spec.Rules[0].Host, "foo.default.example.com")
+	assert.Equal(t, *ingress.Spec.IngressClassName, "nginx")
+	assert.Equal(t, ingress.Spec.TLS[0].SecretName, "foo-tls")
+	assert.Assert(t, util.ContainsAll(ingress.Annotations[ExportNotesAnnotationKey], "secret 'foo-tls'", "tag 'candidate' is not routed"))
+
+	assert.Assert(t, util.ContainsAll(joinWarnings(notes), "Deployment: container concurrency", "HorizontalPodAutoscaler: scaling on metric", "Ingress: the TLS certificate"))
+}
+
+func TestExportForKubernetesInitContainers(t *testing.T) {
+	svc := createServiceForKubernetesExport()
+	initContainers := []corev1.Container{{Name: "setup", Image: "busybox"}}
+	svc.Spec.Template.Spec.InitContainers = initContainers
+	list, _, err := exportForKubernetes(svc, kubernetesExportOptions{ingress: ExportNoIngress})
+	assert.NilError(t, err)
+
+	deployment := list.Items[0].Object.(*appsv1.Deployment)
+	assert.DeepEqual(t, deployment.Spec.Template.Spec.InitContainers, initContainers)
+}
+
+func TestExportForKubernetesHPAClass(t *testing.T) {
+	svc := createServiceForKubernetesExport()
+	svc.Spec.Template.Annotations = map[string]string{
+		autoscaling.ClassAnnotationKey:  autoscaling.HPA,
+		autoscaling.MetricAnnotationKey: autoscaling.CPU,
+		autoscaling.TargetAnnotationKey: "70",
+	}
+	list, notes, err := exportForKubernetes(svc, kubernetesExportOptions{ingress: ExportNoIngress})
+	assert.NilError(t, err)
+	assert.Equal(t, len(list.Items), 3)
+	hpa := list.Items[2].Object.(*autoscalingv2.HorizontalPodAutoscaler)
+	assert.Equal(t, *hpa.Spec.MinReplicas, int32(1))
+	assert.Equal(t, hpa.Spec.MaxReplicas, int32(10))
+	assert.Equal(t, hpa.Spec.Metrics[0].Resource.Name, corev1.ResourceCPU)
+	assert.Equal(t, hpa.Spec.Metrics[0].Resource.Target.Type, autoscalingv2.UtilizationMetricType)
+	assert.Equal(t, *hpa.Spec.Metrics[0].Resource.Target.AverageUtilization, int32(70))
+	assert.Assert(t, util.ContainsAll(joinWarnings(notes), "scale to zero is not supported", "maxReplicas is set to 10"))
+}
+
+func TestExportForKubernetesHPAClassMemory(t *testing.T) {
+	svc := createServiceForKubernetesExport()
+	svc.Spec.Template.Annotations = map[string]string{
+		autoscaling.ClassAnnotationKey:  autoscaling.HPA,
+		autoscaling.MetricAnnotationKey: autoscaling.Memory,
+		autoscaling.TargetAnnotationKey: "150",
+	}
+	list, _, err := exportForKubernetes(svc, kubernetesExportOptions{ingress: ExportNoIngress})
+	assert.NilError(t, err)
+	target := list.Items[2].Object.(*autoscalingv2.HorizontalPodAutoscaler).Spec.Metrics[0].Resource
+	assert.Equal(t, target.Name, corev1.ResourceMemory)
+	assert.Equal(t, target.Target.Type, autoscalingv2.AverageValueMetricType)
+	assert.Equal(t, target.Target.AverageValue.String(), "150Mi")
+	assert.Assert(t, target.Target.AverageUtilization == nil)
+
+	svc.Spec.Template.Annotations[autoscaling.TargetAnnotationKey] = "lots"
+	list, notes, err := exportForKubernetes(svc, kubernetesExportOptions{ingress: ExportNoIngress})
+	assert.NilError(t, err)
+	assert.Equal(t, list.Items[2].Object.(*autoscalingv2.HorizontalPodAutoscaler).Spec.Metrics[0].Resource.Name, corev1.ResourceCPU)
+	assert.Assert(t, util.ContainsAll(joinWarnings(notes), "memory target 'lots' is invalid"))
+}
+
+func TestExportForKubernetesFixedScale(t *testing.T) {
+	svc := createServiceForKubernetesExport()
+	svc.Spec.Template.Annotations = map[string]string{
+		autoscaling.MinScaleAnnotationKey: "3",
+		autoscaling.MaxScaleAnnotationKey: "3",
+	}
+	svc.Labels = map[string]string{network.VisibilityLabelKey: "cluster-local"}
+	list, notes, err := exportForKubernetes(svc, kubernetesExportOptions{ingress: ExportIngress})
+	assert.NilError(t, err)
+	// Neither an HPA nor an Ingress is created
+	assert.Equal(t, len(list.Items), 2)
+	assert.Equal(t, *list.Items[0].Object.(*appsv1.Deployment).Spec.Replicas, int32(3))
+	assert.Assert(t, util.ContainsAll(joinWarnings(notes), "the service is cluster-local, no Ingress is created"))
+
+	// Notes about scaling are attached to the Deployment if no HPA is created
+	svc.Spec.Template.Annotations = map[string]string{autoscaling.MaxScaleAnnotationKey: "1"}
+	list, notes, err = exportForKubernetes(svc, kubernetesExportOptions{ingress: ExportIngress})
+	assert.NilError(t, err)
+	assert.Equal(t, len(list.Items), 2)
+	deployment := list.Items[0].Object.(*appsv1.Deployment)
+	assert.Assert(t, util.ContainsAll(deployment.Annotations[ExportNotesAnnotationKey], "scale to zero is not supported"))
+	assert.Assert(t, util.ContainsAll(joinWarnings(notes), "Deployment: scale to zero is not supported"))
+}
+
+func TestExportForKubernetesHTTPRoute(t *testing.T) {
+	svc := createServiceForKubernetesExport()
+	_, _, err := exportForKubernetes(svc, kubernetesExportOptions{ingress: ExportHTTPRoute})
+	assert.ErrorContains(t, err, "requires the parent gateway")
+
+	list, _, err := exportForKubernetes(svc, kubernetesExportOptions{ingress: ExportHTTPRoute, gateway: "infra/external"})
+	assert.NilError(t, err)
+	route := list.Items[3].Object.(*unstructured.Unstructured)
+	assert.Equal(t, route.GetKind(), "HTTPRoute")
+	hostnames, _, _ := unstructured.NestedStringSlice(route.Object, "spec", "hostnames")
+	assert.DeepEqual(t, hostnames, []string{"foo.default.example.com"})
+	parentRefs, _, _ := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+	assert.DeepEqual(t, parentRefs, []interface{}{map[string]interface{}{"name": "external", "namespace": "infra"}})
+	assert.Assert(t, util.ContainsAll(route.GetAnnotations()[ExportNotesAnnotationKey], "TLS has to be configured at gateway 'infra/external'"))
+}
+
+func TestServiceExportKubernetesMode(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", createServiceForKubernetesExport(), nil)
+	r.GetService("foo", createServiceForKubernetesExport(), nil)
+	r.GetService("foo", createServiceForKubernetesExport(), nil)
+
+	output, err := executeServiceCommand(client, "export", "foo", "--mode", "kubernetes", "-o", "yaml")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Warning: Deployment: container concurrency", "kind: List",
+		"kind: Deployment", "kind: Service", "kind: HorizontalPodAutoscaler", "kind: Ingress"))
+
+	_, err = executeServiceCommand(client, "export", "foo", "--mode", "kubernetes", "-o", "yaml", "--with-revisions")
+	assert.ErrorContains(t, err, "doesn't support --with-revisions in kubernetes mode")
+
+	_, err = executeServiceCommand(client, "export", "foo", "--mode", "kubernetes", "-o", "yaml", "--ingress", "gateway")
+	assert.ErrorContains(t, err, "invalid value 'gateway' for --ingress")
+
+	r.Validate()
+}
+
+func createServiceForKubernetesExport() *servingv1.Service {
+	svc := &servingv1.Service{}
+	svc.Name = "foo"
+	svc.Namespace = "default"
+	svc.Spec.Template.Annotations = map[string]string{
+		autoscaling.MinScaleAnnotationKey: "2",
+		autoscaling.MaxScaleAnnotationKey: "5",
+		autoscaling.WindowAnnotationKey:   "2m",
+	}
+	svc.Spec.Template.Spec.ContainerConcurrency = ptr.Int64(10)
+	svc.Spec.Template.Spec.TimeoutSeconds = ptr.Int64(300)
+	svc.Spec.Template.Spec.Containers = []corev1.Container{{
+		Image: "gcr.io/foo/bar@sha256:deadbeef",
+		Ports: []corev1.ContainerPort{{ContainerPort: 9000}},
+		Env:   []corev1.EnvVar{{Name: "a", Value: "b"}},
+		ReadinessProbe: &corev1.Probe{ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{Path: "/ready"},
+		}},
+	}}
+	svc.Status.URL = &apis.URL{Scheme: "https", Host: "foo.default.example.com"}
+	svc.Status.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: "foo-00001", Percent: ptr.Int64(100)},
+		{RevisionName: "foo-00001", Tag: "candidate", Percent: ptr.Int64(0)},
+	}
+	return svc
+}
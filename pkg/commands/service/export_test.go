@@ -66,6 +66,9 @@ func TestServiceExport(t *testing.T) {
 		{latestSvc: test.BuildServiceWithOptions("foo", servingtest.WithConfigSpec(buildConfiguration()), test.WithRevisionAnnotations(map[string]string{"client.knative.dev/user-image": "busybox:v2"}))},
 		{latestSvc: test.BuildServiceWithOptions("foo", servingtest.WithConfigSpec(buildConfiguration()), servingtest.WithServiceLabel("a", "mouse"), servingtest.WithServiceAnnotation("a", "mouse"))},
 		{latestSvc: test.BuildServiceWithOptions("foo", servingtest.WithConfigSpec(buildConfiguration()), servingtest.WithVolume("secretName", "/mountpath", volumeSource("secretName")))},
+		{latestSvc: test.BuildServiceWithOptions("foo", servingtest.WithConfigSpec(buildConfiguration()), func(svc *servingv1.Service) {
+			svc.Spec.Template.Spec.InitContainers = []v1.Container{{Name: "setup", Image: "busybox"}}
+		})},
 	} {
 		exportServiceTestForReplay(t, &tc)
 		tc.expectedKNExport = test.BuildKNExportWithOptions()
@@ -0,0 +1,300 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"knative.dev/pkg/apis"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/commands/revision"
+	"knative.dev/client/pkg/printers"
+	clientserving "knative.dev/client/pkg/serving"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+var historyExample = `
+  # Show the revision history of service 'svc'
+  kn service history svc
+
+  # Show the revision history of service 'svc' as JSON, e.g. for attaching it to an incident report
+  kn service history svc -o json`
+
+// A service update recorded in the managed fields is attributed to a revision which has
+// been created within this window after the update
+const managerMatchWindow = time.Minute
+
+// revisionHistory is the machine readable revision history of a service
+type revisionHistory struct {
+	Service   string                 `json:"service"`
+	Namespace string                 `json:"namespace"`
+	Revisions []revisionHistoryEntry `json:"revisions"`
+}
+
+// revisionHistoryEntry describes a single revision and how it differs from its predecessor
+type revisionHistoryEntry struct {
+	Name       string      `json:"name"`
+	Generation int         `json:"generation"`
+	Created    metav1.Time `json:"created"`
+	Image      string      `json:"image,omitempty"`
+	Digest     string      `json:"digest,omitempty"`
+	Ready      string      `json:"ready"`
+	// Creator is the user who made the change which created the revision
+	Creator string `json:"creator,omitempty"`
+	// Managers are the field managers which updated the service at the time the revision was created
+	Managers []string `json:"managers,omitempty"`
+	Percent  int64    `json:"percent"`
+	Tags     []string `json:"tags,omitempty"`
+	// RoutingState is one of 'active', 'reserve' or 'pending'
+	RoutingState         string `json:"routingState,omitempty"`
+	RoutingStateModified string `json:"routingStateModified,omitempty"`
+	// Changes are the configuration changes compared to the previous revision
+	Changes []clientserving.RevisionChange `json:"changes,omitempty"`
+
+	// revision is the revision described by this entry
+	revision *servingv1.Revision
+}
+
+// NewServiceHistoryCommand represents 'kn service history' command
+func NewServiceHistoryCommand(p *commands.KnParams) *cobra.Command {
+	machineReadablePrintFlags := genericclioptions.NewPrintFlags("")
+
+	command := &cobra.Command{
+		Use:   "history NAME",
+		Short: "Show the revision history of a service",
+		Long: `Show the revision history of a service
+
+All revisions of the service are listed from the oldest to the newest one, together with
+their image and the resolved digest, the configuration changes compared to the previous
+revision, who made the change and how traffic is currently routed to them. Earlier
+traffic shares aren't recorded by Knative, so the traffic of a revision is always its
+current share and tags. Revisions which have been garbage collected are not part of the
+history anymore.`,
+		Example:           historyExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service history' requires the service name given as single argument")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			service, err := client.GetService(cmd.Context(), name)
+			if err != nil {
+				return err
+			}
+			revisionList, err := client.ListRevisions(cmd.Context(), clientservingv1.WithService(name))
+			if err != nil {
+				return err
+			}
+			history := &revisionHistory{
+				Service:   service.Name,
+				Namespace: service.Namespace,
+				Revisions: buildRevisionHistory(service, revisionList.Items),
+			}
+
+			out := cmd.OutOrStdout()
+			if machineReadablePrintFlags.OutputFlagSpecified() {
+				printer, err := machineReadablePrintFlags.ToPrinter()
+				if err != nil {
+					return err
+				}
+				obj, err := printers.RawObject(history)
+				if err != nil {
+					return err
+				}
+				return printer.PrintObj(obj, out)
+			}
+			return writeRevisionHistory(out, history)
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	machineReadablePrintFlags.AddFlags(command)
+	return command
+}
+
+// buildRevisionHistory creates the history entries of the given revisions, ordered by
+// their generation
+func buildRevisionHistory(service *servingv1.Service, revisions []servingv1.Revision) []revisionHistoryEntry {
+	sorted := make([]*servingv1.Revision, 0, len(revisions))
+	for i := range revisions {
+		sorted = append(sorted, &revisions[i])
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return revisionGeneration(sorted[i]) < revisionGeneration(sorted[j])
+	})
+
+	entries := make([]revisionHistoryEntry, 0, len(sorted))
+	var previous *servingv1.RevisionTemplateSpec
+	for _, rev := range sorted {
+		entry := revisionHistoryEntry{
+			Name:                 rev.Name,
+			Generation:           revisionGeneration(rev),
+			Created:              rev.CreationTimestamp,
+			Digest:               revisionDigest(rev),
+			Ready:                string(corev1.ConditionUnknown),
+			Creator:              rev.Annotations[serving.CreatorAnnotation],
+			RoutingState:         rev.Labels[serving.RoutingStateLabelKey],
+			RoutingStateModified: rev.Annotations[serving.RoutingStateModifiedAnnotationKey],
+			revision:             rev,
+		}
+		if container := clientserving.ContainerOfRevisionSpec(&rev.Spec); container != nil {
+			entry.Image = container.Image
+		}
+		if userImage := clientserving.UserImage(&rev.ObjectMeta); userImage != "" {
+			entry.Image = userImage
+		}
+		if ready := rev.Status.GetCondition(apis.ConditionReady); ready != nil {
+			entry.Ready = string(ready.Status)
+		}
+		for _, target := range service.Status.Traffic {
+			if target.RevisionName != rev.Name {
+				continue
+			}
+			if target.Percent != nil {
+				entry.Percent += *target.Percent
+			}
+			if target.Tag != "" {
+				entry.Tags = append(entry.Tags, target.Tag)
+			}
+		}
+		current := clientserving.RevisionTemplateOf(rev)
+		if previous != nil {
+			entry.Changes = clientserving.DiffRevisionTemplates(previous, current)
+		}
+		previous = current
+		entries = append(entries, entry)
+	}
+	addManagers(entries, service.ManagedFields)
+	return entries
+}
+
+// addManagers attributes the service updates recorded in the managed fields to the first
+// revision created after them. Only the last update of every manager is recorded, so
+// older revisions can't be attributed this way.
+func addManagers(entries []revisionHistoryEntry, managedFields []metav1.ManagedFieldsEntry) {
+	for _, field := range managedFields {
+		if field.Subresource != "" || field.Time == nil || field.Manager == "" {
+			continue
+		}
+		for i := range entries {
+			// The timestamps have a precision of one second only
+			delay := entries[i].Created.Sub(field.Time.Time)
+			if delay >= -time.Second && delay <= managerMatchWindow {
+				entries[i].Managers = append(entries[i].Managers, field.Manager)
+				break
+			}
+		}
+	}
+}
+
+// revisionDigest returns the resolved digests of the revision's containers
+func revisionDigest(rev *servingv1.Revision) string {
+	var digests []string
+	for _, status := range rev.Status.ContainerStatuses {
+		if status.ImageDigest != "" {
+			digests = append(digests, status.ImageDigest)
+		}
+	}
+	return strings.Join(digests, ",")
+}
+
+// writeRevisionHistory prints the history as a timeline, starting with the oldest revision
+func writeRevisionHistory(out io.Writer, history *revisionHistory) error {
+	dw := printers.NewPrefixWriter(out)
+	dw.WriteAttribute("Service", history.Service)
+	dw.WriteAttribute("Namespace", history.Namespace)
+	if len(history.Revisions) == 0 {
+		dw.WriteLine()
+		dw.WriteLine("No revisions found.")
+		return dw.Flush()
+	}
+	for i, entry := range history.Revisions {
+		dw.WriteLine()
+		if err := dw.Flush(); err != nil {
+			return err
+		}
+		header := fmt.Sprintf("%s [%d]", entry.Name, entry.Generation)
+		section := dw.WriteColsLn(header)
+		section.WriteAttribute("Created", fmt.Sprintf("%s (%s)", entry.Created.UTC().Format(time.RFC3339), commands.Age(entry.Created.Time)))
+		revision.WriteImage(section, entry.revision)
+		if changedBy := formatChangedBy(entry); changedBy != "" {
+			section.WriteAttribute("Changed by", changedBy)
+		}
+		section.WriteAttribute("Ready", entry.Ready)
+		section.WriteAttribute("Traffic", formatHistoryTraffic(entry))
+		if i == 0 {
+			section.WriteAttribute("Changes", "initial revision")
+		} else if len(entry.Changes) == 0 {
+			section.WriteAttribute("Changes", "none")
+		} else {
+			revision.WriteRevisionChanges(section.WriteAttribute("Changes", ""), entry.Changes)
+		}
+		if err := dw.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatChangedBy(entry revisionHistoryEntry) string {
+	changedBy := entry.Creator
+	if len(entry.Managers) > 0 {
+		managers := "via " + strings.Join(entry.Managers, ", ")
+		if changedBy == "" {
+			return managers
+		}
+		changedBy += " (" + managers + ")"
+	}
+	return changedBy
+}
+
+// formatHistoryTraffic describes the current traffic share and tags of a revision, and
+// since when it is routed or not routed anymore
+func formatHistoryTraffic(entry revisionHistoryEntry) string {
+	traffic := fmt.Sprintf("%d%%", entry.Percent)
+	for _, tag := range entry.Tags {
+		traffic += " #" + tag
+	}
+	if entry.RoutingState == "" {
+		return traffic
+	}
+	traffic += ", " + entry.RoutingState
+	if modified, err := time.Parse(time.RFC3339, entry.RoutingStateModified); err == nil {
+		traffic += fmt.Sprintf(" since %s (%s)", modified.UTC().Format(time.RFC3339), commands.Age(modified))
+	}
+	return traffic
+}
@@ -0,0 +1,149 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientserving "knative.dev/client/pkg/serving"
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+)
+
+func createServiceAndRevisionsForHistory() (*servingv1.Service, *servingv1.RevisionList) {
+	service := createServiceForRollback("foo", "foo-00003")
+	service.Status.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: "foo-00003", LatestRevision: ptr.Bool(true), Percent: ptr.Int64(90)},
+		{RevisionName: "foo-00002", Tag: "stable", Percent: ptr.Int64(10)},
+	}
+	revisions := createRevisionsForRollback("foo", 3)
+	created := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	for i := range revisions.Items {
+		rev := &revisions.Items[i]
+		rev.CreationTimestamp = metav1.Time{Time: created.Add(time.Duration(i) * time.Hour)}
+		rev.Annotations[serving.CreatorAnnotation] = "alice"
+		rev.Labels[serving.RoutingStateLabelKey] = "active"
+	}
+	revisions.Items[0].Labels[serving.RoutingStateLabelKey] = "reserve"
+	revisions.Items[0].Annotations[serving.RoutingStateModifiedAnnotationKey] = created.Add(2 * time.Hour).Format(time.RFC3339)
+	revisions.Items[2].Annotations[serving.CreatorAnnotation] = "bob"
+	revisions.Items[2].Annotations["autoscaling.knative.dev/max-scale"] = "5"
+	revisions.Items[2].Spec.Containers[0].Env = []corev1.EnvVar{
+		{Name: "env1", Value: "changed"},
+		{Name: "env3", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "creds"}, Key: "token"}}},
+	}
+	revisions.Items[2].Status.ContainerStatuses = []servingv1.ContainerStatus{{ImageDigest: "gcr.io/foo/bar@sha256:deadbeef"}}
+
+	updated := metav1.Time{Time: revisions.Items[2].CreationTimestamp.Add(-time.Second)}
+	service.ManagedFields = []metav1.ManagedFieldsEntry{
+		{Manager: "kn", Operation: metav1.ManagedFieldsOperationUpdate, Time: &updated},
+		{Manager: "controller", Operation: metav1.ManagedFieldsOperationUpdate, Time: &updated, Subresource: "status"},
+	}
+	// Revisions are not guaranteed to be returned in order
+	revisions.Items[0], revisions.Items[2] = revisions.Items[2], revisions.Items[0]
+	return service, revisions
+}
+
+func TestServiceHistoryMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service, revisions := createServiceAndRevisionsForHistory()
+	r.GetService("foo", service, nil)
+	r.ListRevisions(mock.Any(), revisions, nil)
+
+	output, err := executeServiceCommand(client, "history", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Service:", "foo", "Namespace:", "default"))
+	assert.Assert(t, util.ContainsAll(output, "foo-00001 [1]", "foo-00002 [2]", "foo-00003 [3]"))
+	assert.Assert(t, strings.Index(output, "foo-00001") < strings.Index(output, "foo-00002"))
+	assert.Assert(t, strings.Index(output, "foo-00002") < strings.Index(output, "foo-00003"))
+	assert.Assert(t, util.ContainsAll(output, "Created:", "2026-10-01T12:00:00Z", "initial revision"))
+	assert.Assert(t, util.ContainsAll(output, "0%, reserve since 2026-10-01T14:00:00Z"))
+	assert.Assert(t, util.ContainsAll(output, "10% #stable, active", "90%, active"))
+	assert.Assert(t, cmp.Regexp(`~\s+image\s+gcr.io/foo/bar:1 → gcr.io/foo/bar:2`, output))
+	assert.Assert(t, util.ContainsAll(output, "Changed by:", "alice", "bob (via kn)"))
+	assert.Assert(t, cmp.Regexp(`~\s+env env1\s+eval1 → changed`, output))
+	assert.Assert(t, cmp.Regexp(`-\s+env env2\s+eval2`, output))
+	assert.Assert(t, cmp.Regexp(`\+\s+env env3\s+secret:creds:token`, output))
+	assert.Assert(t, cmp.Regexp(`Autoscaling:\s+\+\s+autoscaling.knative.dev/max-scale\s+5`, output))
+	assert.Assert(t, util.ContainsNone(output, "controller"))
+
+	r.Validate()
+}
+
+func TestServiceHistoryJSONMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service, revisions := createServiceAndRevisionsForHistory()
+	r.GetService("foo", service, nil)
+	r.ListRevisions(mock.Any(), revisions, nil)
+
+	output, err := executeServiceCommand(client, "history", "foo", "-o", "json")
+	assert.NilError(t, err)
+	var history revisionHistory
+	assert.NilError(t, json.Unmarshal([]byte(output), &history))
+	// The history isn't an API resource, so it has no kind
+	assert.Assert(t, util.ContainsNone(output, `"kind"`, `"apiVersion"`))
+	assert.Equal(t, history.Service, "foo")
+	assert.Equal(t, len(history.Revisions), 3)
+	first, last := history.Revisions[0], history.Revisions[2]
+	assert.Equal(t, first.Name, "foo-00001")
+	assert.Assert(t, first.Changes == nil)
+	assert.Equal(t, last.Name, "foo-00003")
+	assert.Equal(t, last.Generation, 3)
+	assert.Equal(t, last.Image, "gcr.io/foo/bar:3")
+	assert.Equal(t, last.Digest, "gcr.io/foo/bar@sha256:deadbeef")
+	assert.Equal(t, last.Creator, "bob")
+	assert.DeepEqual(t, last.Managers, []string{"kn"})
+	assert.Equal(t, last.Percent, int64(90))
+	assert.DeepEqual(t, last.Changes[0], clientserving.RevisionChange{
+		Section: "Container", Field: "image", Change: clientserving.RevisionChangeChanged,
+		From: "gcr.io/foo/bar:2", To: "gcr.io/foo/bar:3"})
+	assert.DeepEqual(t, history.Revisions[1].Tags, []string{"stable"})
+
+	r.GetService("foo", service, nil)
+	r.ListRevisions(mock.Any(), revisions, nil)
+	_, err = executeServiceCommand(client, "history", "foo", "-o", "table")
+	assert.ErrorContains(t, err, "unable to match a printer")
+
+	_, err = executeServiceCommand(client, "history")
+	assert.ErrorContains(t, err, "requires the service name")
+
+	r.Validate()
+}
+
+func TestBuildRevisionHistoryKeepsRevisions(t *testing.T) {
+	service, revisions := createServiceAndRevisionsForHistory()
+	entries := buildRevisionHistory(service, revisions.Items)
+	assert.Equal(t, revisions.Items[0].Name, "foo-00003")
+	for _, entry := range entries {
+		assert.Equal(t, entry.revision.Name, entry.Name)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"knative.dev/client/pkg/oci"
+	"knative.dev/client/pkg/output"
+	"knative.dev/client/pkg/output/term"
+	"knative.dev/client/pkg/output/tui"
+)
+
+// imageArchiveFlags are the flags for deploying an image from a local archive
+type imageArchiveFlags struct {
+	ImageTar string
+	PushTo   string
+}
+
+func (f *imageArchiveFlags) addFlags(command *cobra.Command) {
+	command.Flags().StringVar(&f.ImageTar, "image-tar", "",
+		"Image archive to deploy instead of --image, either an OCI image layout directory, a tarball of an OCI image layout "+
+			"or a tarball written by 'docker save'. The image is pushed to the repository given with --push-to "+
+			"and the service is created with the digest of the pushed image.")
+	command.MarkFlagFilename("image-tar")
+	command.Flags().StringVar(&f.PushTo, "push-to", "",
+		"Repository to push the image of --image-tar to, e.g. 'registry.example.com/team/app'. "+
+			"If a tag is given, like in 'registry.example.com/team/app:v1', the image is also tagged.")
+}
+
+// validate checks the combination of the image archive flags with other options of the command
+func (f *imageArchiveFlags) validate(cmd *cobra.Command) error {
+	if f.ImageTar == "" && f.PushTo == "" {
+		return nil
+	}
+	if f.ImageTar == "" || f.PushTo == "" {
+		return errors.New("--image-tar and --push-to must be given together")
+	}
+	for _, flag := range []string{"image", "filename", "from-compose"} {
+		if cmd.Flags().Changed(flag) {
+			return fmt.Errorf("--image-tar can't be combined with --%s", flag)
+		}
+	}
+	return nil
+}
+
+// pushImageArchive pushes the image archive to its target repository and returns the reference
+// of the pushed image pinned to its digest. Nothing is pushed for a dry run. The progress is shown
+// as a progress bar when writing to a terminal.
+func (f *imageArchiveFlags) pushImageArchive(cmd *cobra.Command, dryRun bool) (string, error) {
+	archive, err := oci.LoadArchive(f.ImageTar)
+	if err != nil {
+		return "", err
+	}
+	defer archive.Close()
+	if dryRun {
+		return oci.PushedReference(archive, f.PushTo)
+	}
+
+	out := cmd.OutOrStdout()
+	pusher := oci.NewPusher(nil)
+	var ref string
+	if term.IsWriterTerminal(out) {
+		ctx := output.WithContext(cmd.Context(), output.NewPrinter(cmd))
+		message := tui.Message{Text: fmt.Sprintf("Pushing '%s'", f.ImageTar)}
+		err = tui.NewWidgets(ctx).NewProgress(int(archive.Size), message).With(func(control tui.ProgressControl) error {
+			ref, err = pusher.Push(ctx, archive, f.PushTo, progressWriter(control))
+			return err
+		})
+	} else {
+		fmt.Fprintf(out, "Pushing image archive '%s' to '%s' ...\n", f.ImageTar, f.PushTo)
+		ref, err = pusher.Push(cmd.Context(), archive, f.PushTo, nil)
+	}
+	if err != nil {
+		return "", err
+	}
+	fmt.Fprintf(out, "Pushed image '%s'.\n\n", ref)
+	return ref, nil
+}
+
+// progressWriter feeds the number of uploaded bytes into a progress bar, which counts
+// the bytes written to it
+func progressWriter(w io.Writer) oci.ProgressFunc {
+	chunk := make([]byte, 32*1024)
+	return func(uploaded int64) {
+		for uploaded > 0 {
+			n := min(uploaded, int64(len(chunk)))
+			w.Write(chunk[:n])
+			uploaded -= n
+		}
+	}
+}
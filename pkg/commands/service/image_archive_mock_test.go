@@ -0,0 +1,101 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/api/errors"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/oci"
+	"knative.dev/client/pkg/oci/ocitest"
+	clientserving "knative.dev/client/pkg/serving"
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func TestServiceCreateImageTarMock(t *testing.T) {
+	server, host := ocitest.NewTestRegistry("", "")
+	defer server.Close()
+	dir := t.TempDir()
+	img, err := random.Image(512, 2)
+	assert.NilError(t, err)
+	path, err := layout.Write(dir, empty.Index)
+	assert.NilError(t, err)
+	assert.NilError(t, path.AppendImage(img))
+	digest, err := img.Digest()
+	assert.NilError(t, err)
+	image := host + "/team/app@" + digest.String()
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	service := getService("foo")
+	service.Spec.Template.Spec.Containers[0].Image = image
+	service.Spec.Template.Annotations = map[string]string{clientserving.UserImageAnnotationKey: ""}
+	r.CreateService(verifyService(service, true), nil)
+
+	output, err := executeServiceCommand(client, "create", "foo", "--image-tar", dir, "--push-to", host+"/team/app:v1", "--no-wait", "--revision-name=")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Pushing image archive '"+dir+"' to '"+host+"/team/app:v1'", "Pushed image '"+image+"'", "created", "foo"))
+	r.Validate()
+
+	// The image is in the registry and tagged
+	resolved, err := oci.NewResolver(nil).Resolve(context.Background(), host+"/team/app:v1")
+	assert.NilError(t, err)
+	assert.Equal(t, resolved, image)
+}
+
+func TestServiceCreateImageTarDryRunMock(t *testing.T) {
+	dir := t.TempDir()
+	img, err := random.Image(512, 1)
+	assert.NilError(t, err)
+	path, err := layout.Write(dir, empty.Index)
+	assert.NilError(t, err)
+	assert.NilError(t, path.AppendImage(img))
+	digest, err := img.Digest()
+	assert.NilError(t, err)
+
+	// No registry is needed as nothing is pushed
+	client := knclient.NewMockKnServiceClient(t)
+	output, err := executeServiceCommand(client, "create", "foo", "--image-tar", dir, "--push-to", "registry.example.com/app", "--dry-run", "-o", "yaml")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "image: registry.example.com/app@"+digest.String()))
+	assert.Assert(t, util.ContainsNone(output, "Pushing"))
+	client.Recorder().Validate()
+}
+
+func TestServiceCreateImageTarErrors(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	for _, tc := range []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"--image-tar", "app.tar"}, "--image-tar and --push-to must be given together"},
+		{[]string{"--push-to", "example.com/app"}, "--image-tar and --push-to must be given together"},
+		{[]string{"--image-tar", "app.tar", "--push-to", "example.com/app", "--image", "nginx"}, "--image-tar can't be combined with --image"},
+		{[]string{"--image-tar", "missing.tar", "--push-to", "example.com/app"}, "cannot read image archive 'missing.tar'"},
+	} {
+		_, err := executeServiceCommand(client, append([]string{"create", "foo", "--no-wait"}, tc.args...)...)
+		assert.ErrorContains(t, err, tc.expected)
+	}
+	client.Recorder().Validate()
+}
@@ -26,6 +26,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/util/retry"
 
 	clientv1alpha1 "knative.dev/client/pkg/apis/client/v1alpha1"
@@ -38,6 +39,10 @@ import (
 // NewServiceImportCommand returns a new command for importing a service.
 func NewServiceImportCommand(p *commands.KnParams) *cobra.Command {
 	var waitFlags commands.WaitFlags
+	var fromDeployment string
+	var format string
+	var dryRun bool
+	machineReadablePrintFlags := genericclioptions.NewPrintFlags("")
 
 	command := &cobra.Command{
 		Use:   "import FILENAME",
@@ -47,48 +52,137 @@ func NewServiceImportCommand(p *commands.KnParams) *cobra.Command {
  kn service import /path/to/file.yaml
 
  # Import a service from JSON file (Beta)
- kn service import /path/to/file.json`,
+ kn service import /path/to/file.json
+
+ # Import a Cloud Run service exported with 'gcloud run services describe web --format yaml'
+ kn service import --format cloudrun web.yaml
+
+ # Import the Kubernetes Deployment 'web' and the Kubernetes Services exposing it as a service
+ kn service import --from-deployment web
+
+ # Print the service which would be created for the Kubernetes Deployment 'web'
+ kn service import --from-deployment web --dry-run -o yaml`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) != 1 {
+			if format != ImportFormatKn && format != ImportFormatCloudRun {
+				return fmt.Errorf("invalid value '%s' for --format, must be one of '%s' or '%s'", format, ImportFormatKn, ImportFormatCloudRun)
+			}
+			if fromDeployment != "" {
+				if len(args) != 0 {
+					return errors.New("'kn service import' doesn't accept a filename together with --from-deployment")
+				}
+				if format != ImportFormatKn {
+					return errors.New("'kn service import' doesn't support --format together with --from-deployment")
+				}
+			} else if len(args) != 1 {
 				return errors.New("'kn service import' requires filename of import file as single argument")
 			}
-			filename := args[0]
+			if dryRun && fromDeployment == "" && format != ImportFormatCloudRun {
+				return errors.New("'kn service import' supports --dry-run only together with --from-deployment or --format cloudrun")
+			}
+			if machineReadablePrintFlags.OutputFlagSpecified() && !dryRun {
+				return errors.New("'kn service import' supports --output only together with --dry-run")
+			}
 
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
 				return err
 			}
 
+			if fromDeployment != "" {
+				kubeClient, err := p.NewKubeClient()
+				if err != nil {
+					return err
+				}
+				service, warnings, err := serviceFromCluster(cmd.Context(), kubeClient, namespace, fromDeployment)
+				if err != nil {
+					return err
+				}
+				printConversionWarnings(cmd.ErrOrStderr(), service.Name, warnings)
+				if dryRun {
+					return printConvertedServices(machineReadablePrintFlags, []*servingv1.Service{service}, cmd.OutOrStdout())
+				}
+				client, err := p.NewServingClient(namespace)
+				if err != nil {
+					return err
+				}
+				return importFromDeployment(cmd.Context(), client, service, cmd.OutOrStdout(), waitFlags)
+			}
+
+			if format == ImportFormatCloudRun {
+				cloudRunService, err := readCloudRunService(args[0])
+				if err != nil {
+					return err
+				}
+				service, warnings, err := serviceFromCloudRun(cloudRunService, namespace)
+				if err != nil {
+					return err
+				}
+				printConversionWarnings(cmd.ErrOrStderr(), service.Name, warnings)
+				if dryRun {
+					return printConvertedServices(machineReadablePrintFlags, []*servingv1.Service{service}, cmd.OutOrStdout())
+				}
+				client, err := p.NewServingClient(namespace)
+				if err != nil {
+					return err
+				}
+				return importFromCloudRun(cmd.Context(), client, service, cmd.OutOrStdout(), waitFlags)
+			}
+
 			client, err := p.NewServingClient(namespace)
 			if err != nil {
 				return err
 			}
 
-			return importWithOwnerRef(cmd.Context(), client, filename, cmd.OutOrStdout(), waitFlags)
+			return importWithOwnerRef(cmd.Context(), client, args[0], cmd.OutOrStdout(), waitFlags)
 		},
 	}
 	flags := command.Flags()
 	commands.AddNamespaceFlags(flags, false)
+	flags.StringVar(&fromDeployment, "from-deployment", "",
+		"Import the Kubernetes Deployment with the given name, together with the Kubernetes Services exposing it, as service.")
+	flags.StringVar(&format, "format", ImportFormatKn,
+		"Format of the import file. '"+ImportFormatKn+"' expects the output of 'kn service export', '"+ImportFormatCloudRun+
+			"' the service YAML of 'gcloud run services describe --format yaml'. A Cloud Run service replaces an existing service of the same name.")
+	flags.BoolVar(&dryRun, "dry-run", false,
+		"Print the service which would be imported instead of creating it. The output format can be chosen with --output.")
+	machineReadablePrintFlags.AddFlags(command)
 	waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "import", "service", "ready")
 
 	return command
 }
 
-func importWithOwnerRef(ctx context.Context, client clientservingv1.KnServingClient, filename string, out io.Writer, waitFlags commands.WaitFlags) error {
-	var export clientv1alpha1.Export
-	file, err := os.Open(filename)
+// importFromDeployment creates a service translated from a Deployment. The Deployment
+// itself is left untouched, so that it can be removed once the service is ready.
+func importFromDeployment(ctx context.Context, client clientservingv1.KnServingClient, service *servingv1.Service, out io.Writer, waitFlags commands.WaitFlags) error {
+	svcExists, err := serviceExists(ctx, client, service.Name)
 	if err != nil {
 		return err
 	}
-	decoder := yaml.NewYAMLOrJSONDecoder(file, 512)
-	err = decoder.Decode(&export)
-	if err != nil {
+	if svcExists {
+		return fmt.Errorf("cannot import deployment as service '%s' in namespace '%s' because the service already exists",
+			service.Name, client.Namespace())
+	}
+	if err := client.CreateService(ctx, service); err != nil {
+		return err
+	}
+	return waitIfRequested(ctx, client, waitFlags, service.Name, "Importing", "imported", "", out)
+}
+
+func importWithOwnerRef(ctx context.Context, client clientservingv1.KnServingClient, filename string, out io.Writer, waitFlags commands.WaitFlags) error {
+	var export clientv1alpha1.Export
+	if err := decodeImportFile(filename, &export); err != nil {
 		return err
 	}
 	if export.Spec.Service.Name == "" {
-		return fmt.Errorf("provided import file doesn't contain service name, please note that only kn's custom export format is supported")
+		return fmt.Errorf("provided import file doesn't contain service name, please note that only kn's custom export format is supported, " +
+			"use --format cloudrun for Cloud Run services")
 	}
+	return importExport(ctx, client, &export, false, out, waitFlags)
+}
 
+// importExport creates the service of the export and its revisions. If replace is set, an
+// existing service of the same name is updated instead and the revisions of the export are ignored.
+func importExport(ctx context.Context, client clientservingv1.KnServingClient, export *clientv1alpha1.Export, replace bool, out io.Writer, waitFlags commands.WaitFlags) error {
 	serviceName := export.Spec.Service.Name
 
 	// Return error if service already exists
@@ -96,24 +190,32 @@ func importWithOwnerRef(ctx context.Context, client clientservingv1.KnServingCli
 	if err != nil {
 		return err
 	}
-	if svcExists {
+	switch {
+	case svcExists && replace:
+		changed, err := prepareAndUpdateService(ctx, client, &export.Spec.Service)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			fmt.Fprintf(out, "Service '%s' imported in namespace '%s' (unchanged).\n", serviceName, client.Namespace())
+			return nil
+		}
+	case svcExists:
 		return fmt.Errorf("cannot import service '%s' in namespace '%s' because the service already exists",
 			serviceName, client.Namespace())
+	default:
+		if err := client.CreateService(ctx, &export.Spec.Service); err != nil {
+			return err
+		}
 	}
 
-	err = client.CreateService(ctx, &export.Spec.Service)
-	if err != nil {
-		return err
-	}
-
-	// Retrieve current Configuration to be use in OwnerReference
-	currentConf, err := getConfigurationWithRetry(ctx, client, serviceName)
-	if err != nil {
-		return err
-	}
-
-	// Create revision with current Configuration's OwnerReference
-	if len(export.Spec.Revisions) > 0 {
+	if !replace {
+		// Retrieve current Configuration to be use in OwnerReference
+		currentConf, err := getConfigurationWithRetry(ctx, client, serviceName)
+		if err != nil {
+			return err
+		}
+		// Create revision with current Configuration's OwnerReference
 		for _, r := range export.Spec.Revisions {
 			tmp := r.DeepCopy()
 			// OwnerRef ensures that Revisions are recognized by controller
@@ -124,11 +226,17 @@ func importWithOwnerRef(ctx context.Context, client clientservingv1.KnServingCli
 		}
 	}
 
-	err = waitIfRequested(ctx, client, waitFlags, serviceName, "Importing", "imported", "", out)
+	return waitIfRequested(ctx, client, waitFlags, serviceName, "Importing", "imported", "", out)
+}
+
+// decodeImportFile decodes the YAML or JSON import file into the given object
+func decodeImportFile(filename string, into interface{}) error {
+	file, err := os.Open(filename)
 	if err != nil {
 		return err
 	}
-	return err
+	defer file.Close()
+	return yaml.NewYAMLOrJSONDecoder(file, 512).Decode(into)
 }
 
 func getConfigurationWithRetry(ctx context.Context, client clientservingv1.KnServingClient, name string) (*servingv1.Configuration, error) {
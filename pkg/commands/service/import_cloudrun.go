@@ -0,0 +1,249 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	network "knative.dev/networking/pkg/apis/networking"
+	"knative.dev/pkg/kmap"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientv1alpha1 "knative.dev/client/pkg/apis/client/v1alpha1"
+	"knative.dev/client/pkg/commands"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+const (
+	// ImportFormatKn is the format written by 'kn service export'
+	ImportFormatKn = "kn"
+	// ImportFormatCloudRun is the service YAML written by 'gcloud run services describe'
+	ImportFormatCloudRun = "cloudrun"
+)
+
+const (
+	cloudRunPrefix = "run.googleapis.com/"
+	// Default of max-revision-timeout-seconds in Knative Serving
+	defaultMaxRevisionTimeoutSeconds = 600
+)
+
+// Cloud Run annotations which only carry information about the Cloud Run deployment
+// and which are dropped silently
+var cloudRunInformationalAnnotations = []string{
+	cloudRunPrefix + "client-name",
+	cloudRunPrefix + "client-version",
+	cloudRunPrefix + "operation-id",
+	cloudRunPrefix + "ingress-status",
+	cloudRunPrefix + "urls",
+	cloudRunPrefix + "launch-stage",
+	cloudRunPrefix + "build-",
+	cloudRunPrefix + "source-location",
+	serving.CreatorAnnotation,
+	serving.UpdaterAnnotation,
+}
+
+// Explanations for Cloud Run annotations which have no counterpart in Knative
+var cloudRunUnsupportedAnnotations = map[string]string{
+	cloudRunPrefix + "cpu-throttling":         "CPU is allocated for the whole lifetime of a pod in Knative",
+	cloudRunPrefix + "startup-cpu-boost":      "set a higher CPU request instead",
+	cloudRunPrefix + "execution-environment":  "the execution environment is determined by the cluster",
+	cloudRunPrefix + "vpc-access-connector":   "networking has to be configured in the cluster",
+	cloudRunPrefix + "vpc-access-egress":      "networking has to be configured in the cluster",
+	cloudRunPrefix + "network-interfaces":     "networking has to be configured in the cluster",
+	cloudRunPrefix + "cloudsql-instances":     "add a Cloud SQL Auth Proxy container instead",
+	cloudRunPrefix + "sessionAffinity":        "session affinity is not supported by Knative",
+	cloudRunPrefix + "container-dependencies": "containers are started in parallel in Knative",
+	cloudRunPrefix + "binary-authorization":   "use an admission controller of the cluster instead",
+	cloudRunPrefix + "invoker-iam-disabled":   "access control has to be configured in the cluster",
+	cloudRunPrefix + "default-url-disabled":   "use the cluster-local visibility instead",
+	cloudRunPrefix + "encryption-key":         "customer managed encryption keys are not supported",
+}
+
+// serviceFromCloudRun translates a Cloud Run service into a Knative Service. Cloud Run
+// specific annotations and fields are translated where possible, everything else is
+// dropped and reported in the returned warnings.
+func serviceFromCloudRun(in *servingv1.Service, namespace string) (*servingv1.Service, []string, error) {
+	if in.Kind != "Service" || in.APIVersion != servingv1.SchemeGroupVersion.String() {
+		return nil, nil, fmt.Errorf("provided import file doesn't contain a Cloud Run service but '%s' of kind '%s'", in.APIVersion, in.Kind)
+	}
+	if in.Name == "" {
+		return nil, nil, fmt.Errorf("provided import file doesn't contain service name")
+	}
+
+	var warnings conversionWarnings
+	service := newConvertedService(in.Name, namespace)
+	service.Labels = filterKeys(in.Labels, "cloud.googleapis.com/", cloudRunPrefix, serving.GroupName+"/")
+	service.Annotations = translateCloudRunAnnotations(in.Annotations, &warnings)
+	switch ingress := in.Annotations[cloudRunPrefix+"ingress"]; ingress {
+	case "", "all":
+	case "internal", "internal-and-cloud-load-balancing":
+		service.Labels = setLabel(service.Labels, network.VisibilityLabelKey, serving.VisibilityClusterLocal)
+		if ingress != "internal" {
+			warnings.add("ingress '%s' is translated to a cluster-local service, the load balancer has to be configured separately", ingress)
+		}
+	default:
+		warnings.add("unknown ingress '%s' is dropped, the service is publicly reachable", ingress)
+	}
+
+	template := in.Spec.Template.DeepCopy()
+	if template.Name != "" {
+		warnings.add("revision name '%s' is dropped, a new name is generated", template.Name)
+	}
+	service.Spec.Template.Labels = filterKeys(template.Labels, "cloud.googleapis.com/", cloudRunPrefix, serving.GroupName+"/", "client.knative.dev/nonce")
+	service.Spec.Template.Annotations = translateCloudRunAnnotations(template.Annotations, &warnings)
+	service.Spec.Template.Spec = convertCloudRunRevisionSpec(template.Spec, &warnings)
+
+	service.Spec.Traffic = convertCloudRunTraffic(in.Spec.Traffic, &warnings)
+	return service, warnings, nil
+}
+
+// translateCloudRunAnnotations drops the Cloud Run annotations and normalizes the
+// deprecated variants of the autoscaling annotations, which Cloud Run still uses
+func translateCloudRunAnnotations(annotations map[string]string, warnings *conversionWarnings) map[string]string {
+	var keys []string
+	for key := range annotations {
+		if strings.HasPrefix(key, cloudRunPrefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if key == cloudRunPrefix+"ingress" || isInformationalCloudRunAnnotation(key) {
+			continue
+		}
+		if reason, ok := cloudRunUnsupportedAnnotations[key]; ok {
+			warnings.add("annotation '%s: %s' is not supported and is dropped, %s", key, annotations[key], reason)
+		} else {
+			warnings.add("annotation '%s: %s' is specific to Cloud Run and is dropped", key, annotations[key])
+		}
+	}
+
+	out := filterKeys(annotations, append(cloudRunInformationalAnnotations, cloudRunPrefix)...)
+	return kmap.UpdateKeys(out,
+		autoscaling.MinScaleAnnotation,
+		autoscaling.MaxScaleAnnotation,
+		autoscaling.InitialScaleAnnotation,
+		autoscaling.MetricAggregationAlgorithmAnnotation,
+		autoscaling.PanicThresholdPercentageAnnotation,
+		autoscaling.PanicWindowPercentageAnnotation,
+		autoscaling.ScaleDownDelayAnnotation,
+		autoscaling.ScaleToZeroPodRetentionPeriodAnnotation,
+		autoscaling.TargetBurstCapacityAnnotation,
+		autoscaling.TargetUtilizationPercentageAnnotation)
+}
+
+func isInformationalCloudRunAnnotation(key string) bool {
+	for _, informational := range cloudRunInformationalAnnotations {
+		if key == informational || (strings.HasSuffix(informational, "-") && strings.HasPrefix(key, informational)) {
+			return true
+		}
+	}
+	return false
+}
+
+// convertCloudRunRevisionSpec translates the revision spec. Cloud Run always serves the
+// single container which declares a port.
+func convertCloudRunRevisionSpec(in servingv1.RevisionSpec, warnings *conversionWarnings) servingv1.RevisionSpec {
+	out := servingv1.RevisionSpec{
+		ContainerConcurrency: in.ContainerConcurrency,
+		TimeoutSeconds:       in.TimeoutSeconds,
+	}
+	if in.TimeoutSeconds != nil && *in.TimeoutSeconds > defaultMaxRevisionTimeoutSeconds {
+		warnings.add("timeout of %ds exceeds the default maximum of %ds of Knative Serving, the maximum has to be raised in the cluster",
+			*in.TimeoutSeconds, defaultMaxRevisionTimeoutSeconds)
+	}
+	podSpec := in.PodSpec
+	if strings.Contains(podSpec.ServiceAccountName, "@") {
+		warnings.add("Google service account '%s' is dropped, the default service account of the namespace is used", podSpec.ServiceAccountName)
+		podSpec.ServiceAccountName = ""
+	}
+	warnAboutSecretManagerReferences(podSpec, warnings)
+
+	var port *servingPort
+	for _, container := range podSpec.Containers {
+		if len(container.Ports) > 0 {
+			port = &servingPort{container: container.Name, port: container.Ports[0]}
+			break
+		}
+	}
+	out.PodSpec = convertPodSpec(podSpec, port, warnings)
+	return out
+}
+
+// warnAboutSecretManagerReferences reminds that Cloud Run secrets refer to the Secret
+// Manager, for which Kubernetes Secrets have to be created with the same names
+func warnAboutSecretManagerReferences(spec corev1.PodSpec, warnings *conversionWarnings) {
+	seen := map[string]bool{}
+	warn := func(name, key string) {
+		if seen[name+"/"+key] {
+			return
+		}
+		seen[name+"/"+key] = true
+		warnings.add("secret '%s' with key '%s' refers to the Secret Manager, a Kubernetes Secret with that name and key has to exist", name, key)
+	}
+	for _, container := range spec.Containers {
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+				warn(env.ValueFrom.SecretKeyRef.Name, env.ValueFrom.SecretKeyRef.Key)
+			}
+		}
+	}
+	for _, volume := range spec.Volumes {
+		if volume.Secret == nil {
+			continue
+		}
+		for _, item := range volume.Secret.Items {
+			warn(volume.Secret.SecretName, item.Key)
+		}
+	}
+}
+
+// convertCloudRunTraffic keeps the traffic block only if it refers to the latest revision
+// exclusively, as the revisions of the Cloud Run service are not imported
+func convertCloudRunTraffic(traffic []servingv1.TrafficTarget, warnings *conversionWarnings) []servingv1.TrafficTarget {
+	var out []servingv1.TrafficTarget
+	for _, target := range traffic {
+		if target.RevisionName != "" {
+			warnings.add("traffic split refers to revision '%s' which is not imported, all traffic is routed to the latest revision", target.RevisionName)
+			return nil
+		}
+		target.URL = nil
+		out = append(out, target)
+	}
+	return out
+}
+
+// readCloudRunService reads the output of 'gcloud run services describe --format yaml'
+func readCloudRunService(filename string) (*servingv1.Service, error) {
+	var service servingv1.Service
+	if err := decodeImportFile(filename, &service); err != nil {
+		return nil, err
+	}
+	return &service, nil
+}
+
+// importFromCloudRun creates the translated service or replaces the service of the
+// same name if it already exists
+func importFromCloudRun(ctx context.Context, client clientservingv1.KnServingClient, service *servingv1.Service, out io.Writer, waitFlags commands.WaitFlags) error {
+	export := &clientv1alpha1.Export{Spec: clientv1alpha1.ExportSpec{Service: *service}}
+	return importExport(ctx, client, export, true, out, waitFlags)
+}
@@ -0,0 +1,231 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes/fake"
+	network "knative.dev/networking/pkg/apis/networking"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/yaml"
+
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+var cloudRunYAML = `
+apiVersion: serving.knative.dev/v1
+kind: Service
+metadata:
+  name: hello
+  namespace: '123456789012'
+  selfLink: /apis/serving.knative.dev/v1/namespaces/123456789012/services/hello
+  uid: 0b1c2d3e-aaaa-bbbb-cccc-0123456789ab
+  resourceVersion: AAYc1Nxbu2Q
+  generation: 3
+  creationTimestamp: '2026-01-10T10:00:00.000000Z'
+  labels:
+    cloud.googleapis.com/location: europe-west1
+    team: web
+  annotations:
+    serving.knative.dev/creator: dev@example.com
+    serving.knative.dev/lastModifier: dev@example.com
+    run.googleapis.com/client-name: gcloud
+    run.googleapis.com/operation-id: 4d6e1c1e-0000-0000-0000-000000000000
+    run.googleapis.com/ingress: internal
+    run.googleapis.com/ingress-status: internal
+    run.googleapis.com/binary-authorization: default
+    run.googleapis.com/urls: '["https://hello-123456789012.europe-west1.run.app"]'
+spec:
+  template:
+    metadata:
+      name: hello-00003-xyz
+      labels:
+        client.knative.dev/nonce: abcdef
+        run.googleapis.com/startupProbeType: Default
+      annotations:
+        autoscaling.knative.dev/minScale: '1'
+        autoscaling.knative.dev/maxScale: '20'
+        run.googleapis.com/cpu-throttling: 'false'
+        run.googleapis.com/cloudsql-instances: project:region:db
+        run.googleapis.com/client-name: gcloud
+    spec:
+      containerConcurrency: 80
+      timeoutSeconds: 900
+      serviceAccountName: 123456789012-compute@developer.gserviceaccount.com
+      containers:
+      - name: hello-1
+        image: europe-docker.pkg.dev/project/repo/hello@sha256:deadbeef
+        ports:
+        - name: http1
+          containerPort: 8080
+        env:
+        - name: TARGET
+          value: world
+        - name: API_KEY
+          valueFrom:
+            secretKeyRef:
+              name: api-key
+              key: latest
+        resources:
+          limits:
+            cpu: 1000m
+            memory: 512Mi
+        startupProbe:
+          timeoutSeconds: 240
+          periodSeconds: 240
+          failureThreshold: 1
+          tcpSocket:
+            port: 8080
+        volumeMounts:
+        - name: bucket
+          mountPath: /data
+      volumes:
+      - name: bucket
+        csi:
+          driver: gcsfuse.run.googleapis.com
+  traffic:
+  - percent: 100
+    latestRevision: true
+status:
+  observedGeneration: 3
+  url: https://hello-123456789012.europe-west1.run.app
+`
+
+func TestServiceFromCloudRun(t *testing.T) {
+	var cloudRunService servingv1.Service
+	assert.NilError(t, yaml.Unmarshal([]byte(cloudRunYAML), &cloudRunService))
+
+	service, warnings, err := serviceFromCloudRun(&cloudRunService, "default")
+	assert.NilError(t, err)
+	assert.Equal(t, service.Name, "hello")
+	assert.Equal(t, service.Namespace, "default")
+	assert.Equal(t, service.ResourceVersion, "")
+	assert.Equal(t, string(service.UID), "")
+	assert.DeepEqual(t, service.Labels, map[string]string{"team": "web", network.VisibilityLabelKey: "cluster-local"})
+	assert.Equal(t, len(service.Annotations), 0)
+	assert.Assert(t, service.Status.URL == nil)
+
+	template := service.Spec.Template
+	assert.Equal(t, template.Name, "")
+	assert.Equal(t, len(template.Labels), 0)
+	assert.DeepEqual(t, template.Annotations, map[string]string{
+		autoscaling.MinScaleAnnotationKey: "1",
+		autoscaling.MaxScaleAnnotationKey: "20",
+	})
+	assert.Equal(t, *template.Spec.ContainerConcurrency, int64(80))
+	assert.Equal(t, template.Spec.ServiceAccountName, "")
+	assert.Equal(t, len(template.Spec.Volumes), 0)
+	container := template.Spec.Containers[0]
+	assert.DeepEqual(t, container.Ports, []corev1.ContainerPort{{Name: "http1", ContainerPort: 8080}})
+	assert.Equal(t, len(container.VolumeMounts), 0)
+	assert.Equal(t, container.StartupProbe.TCPSocket.Port.IntVal, int32(0))
+	assert.Equal(t, len(service.Spec.Traffic), 1)
+	assert.Assert(t, *service.Spec.Traffic[0].LatestRevision)
+
+	all := joinWarnings(warnings)
+	assert.Assert(t, util.ContainsAll(all,
+		"'run.googleapis.com/binary-authorization: default' is not supported",
+		"'run.googleapis.com/cpu-throttling: false' is not supported",
+		"'run.googleapis.com/cloudsql-instances: project:region:db' is not supported",
+		"revision name 'hello-00003-xyz' is dropped",
+		"timeout of 900s exceeds the default maximum",
+		"Google service account '123456789012-compute@developer.gserviceaccount.com' is dropped",
+		"secret 'api-key' with key 'latest' refers to the Secret Manager",
+		"volume 'bucket' has an unsupported volume type"))
+	assert.Assert(t, util.ContainsNone(all, "client-name", "operation-id", "ingress"))
+}
+
+func TestServiceFromCloudRunTraffic(t *testing.T) {
+	var cloudRunService servingv1.Service
+	assert.NilError(t, yaml.Unmarshal([]byte(cloudRunYAML), &cloudRunService))
+	cloudRunService.Spec.Traffic = []servingv1.TrafficTarget{{RevisionName: "hello-00001-abc", Percent: ptr.Int64(100)}}
+
+	service, warnings, err := serviceFromCloudRun(&cloudRunService, "default")
+	assert.NilError(t, err)
+	assert.Equal(t, len(service.Spec.Traffic), 0)
+	assert.Assert(t, util.ContainsAll(joinWarnings(warnings), "refers to revision 'hello-00001-abc' which is not imported"))
+
+	cloudRunService.Kind = "Job"
+	_, _, err = serviceFromCloudRun(&cloudRunService, "default")
+	assert.ErrorContains(t, err, "doesn't contain a Cloud Run service")
+}
+
+func TestServiceImportCloudRunDryRun(t *testing.T) {
+	file, err := generateFile(t, []byte(cloudRunYAML))
+	assert.NilError(t, err)
+	client := knclient.NewMockKnServiceClient(t)
+
+	out, stderr, err := executeServiceImportCommand(client, fake.NewSimpleClientset(), "--format", "cloudrun", file, "--dry-run")
+	assert.NilError(t, err)
+	var service servingv1.Service
+	assert.NilError(t, yaml.Unmarshal([]byte(out), &service))
+	assert.Equal(t, service.Name, "hello")
+	assert.Equal(t, service.Spec.Template.Annotations[autoscaling.MaxScaleAnnotationKey], "20")
+	assert.Assert(t, util.ContainsAll(stderr, "Warning: service 'hello': annotation 'run.googleapis.com/cpu-throttling"))
+}
+
+func TestServiceImportCloudRun(t *testing.T) {
+	file, err := generateFile(t, []byte(cloudRunYAML))
+	assert.NilError(t, err)
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	// Create a new service
+	r.GetService("hello", nil, errors.NewNotFound(servingv1.Resource("service"), "hello"))
+	r.CreateService(func(t *testing.T, service *servingv1.Service) {
+		assert.Equal(t, service.Spec.Template.Spec.Containers[0].Image, "europe-docker.pkg.dev/project/repo/hello@sha256:deadbeef")
+	}, nil)
+
+	// Replace the existing service
+	existing := &servingv1.Service{}
+	existing.Name = "hello"
+	existing.ResourceVersion = "42"
+	r.GetService("hello", existing, nil)
+	r.GetService("hello", existing, nil)
+	r.UpdateService(func(t *testing.T, service *servingv1.Service) {
+		assert.Equal(t, service.ResourceVersion, "42")
+		assert.Equal(t, service.Spec.Template.Annotations[autoscaling.MinScaleAnnotationKey], "1")
+	}, true, nil)
+
+	out, _, err := executeServiceImportCommand(client, nil, "--format", "cloudrun", file, "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Service 'hello' imported"))
+
+	out, _, err = executeServiceImportCommand(client, nil, "--format", "cloudrun", file, "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Service 'hello' imported"))
+
+	r.Validate()
+}
+
+func TestServiceImportFormatErrors(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	_, _, err := executeServiceImportCommand(client, nil, "--format", "gcloud", "file.yaml")
+	assert.ErrorContains(t, err, "invalid value 'gcloud' for --format")
+
+	_, _, err = executeServiceImportCommand(client, nil, "--format", "cloudrun", "--from-deployment", "web")
+	assert.ErrorContains(t, err, "doesn't support --format together with --from-deployment")
+
+	_, _, err = executeServiceImportCommand(client, nil, "file.yaml", "--dry-run")
+	assert.ErrorContains(t, err, "--dry-run only together with --from-deployment or --format cloudrun")
+}
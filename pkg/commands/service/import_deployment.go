@@ -0,0 +1,414 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	network "knative.dev/networking/pkg/apis/networking"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	"knative.dev/serving/pkg/apis/config"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// Environment variables which are set by Knative and must not be set by the user
+var reservedEnvVars = []string{"PORT", "K_SERVICE", "K_CONFIGURATION", "K_REVISION"}
+
+// serviceFromCluster reads the given Deployment and the Kubernetes Services exposing its
+// pods and translates them into a Knative Service
+func serviceFromCluster(ctx context.Context, client kubernetes.Interface, namespace, name string) (*servingv1.Service, []string, error) {
+	deployment, err := client.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	serviceList, err := client.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	var services []corev1.Service
+	for _, svc := range serviceList.Items {
+		if len(svc.Spec.Selector) > 0 && labels.SelectorFromSet(svc.Spec.Selector).Matches(labels.Set(deployment.Spec.Template.Labels)) {
+			services = append(services, svc)
+		}
+	}
+	service, warnings := serviceFromDeployment(deployment, services)
+	service.Namespace = namespace
+	return service, warnings, nil
+}
+
+// serviceFromDeployment translates a Deployment and the Kubernetes Services selecting
+// its pods into a Knative Service. Everything which cannot be translated is dropped
+// and reported in the returned warnings.
+func serviceFromDeployment(deployment *appsv1.Deployment, services []corev1.Service) (*servingv1.Service, []string) {
+	var warnings conversionWarnings
+	service := newConvertedService(deployment.Name, deployment.Namespace)
+	service.Labels = filterKeys(deployment.Labels)
+	service.Annotations = filterKeys(deployment.Annotations, "deployment.kubernetes.io/", corev1.LastAppliedConfigAnnotation)
+
+	template := deployment.Spec.Template.DeepCopy()
+	service.Spec.Template.Labels = template.Labels
+	service.Spec.Template.Annotations = filterKeys(template.Annotations, "kubectl.kubernetes.io/restartedAt")
+	if deployment.Spec.Replicas != nil && *deployment.Spec.Replicas > 0 {
+		setTemplateAnnotation(&service.Spec.Template, autoscaling.MinScaleAnnotationKey, strconv.Itoa(int(*deployment.Spec.Replicas)))
+		warnings.add("%d replicas of deployment '%s' are kept as min-scale, the service does not scale to zero unless the annotation '%s' is removed",
+			*deployment.Spec.Replicas, deployment.Name, autoscaling.MinScaleAnnotationKey)
+	}
+	if deployment.Spec.Paused {
+		warnings.add("deployment '%s' is paused, the Knative Service will be rolled out nevertheless", deployment.Name)
+	}
+
+	port := selectServingPort(template.Spec.Containers, services, &warnings)
+	service.Spec.Template.Spec.PodSpec = convertPodSpec(template.Spec, port, &warnings)
+
+	if clusterLocal(services) {
+		service.Labels = setLabel(service.Labels, network.VisibilityLabelKey, serving.VisibilityClusterLocal)
+	}
+	return service, warnings
+}
+
+// servingPort is the container port which receives the requests
+type servingPort struct {
+	container string
+	port      corev1.ContainerPort
+}
+
+// selectServingPort picks the single port which Knative routes requests to. Ports targeted
+// by a Kubernetes Service take precedence over other container ports.
+func selectServingPort(containers []corev1.Container, services []corev1.Service, warnings *conversionWarnings) *servingPort {
+	var selected *servingPort
+	for _, svc := range services {
+		for _, svcPort := range svc.Spec.Ports {
+			container, port, found := resolveTargetPort(containers, svcPort)
+			if !found {
+				warnings.add("port '%s' of service '%s' does not match any container port and is dropped", svcPort.TargetPort.String(), svc.Name)
+				continue
+			}
+			if selected != nil {
+				if selected.container != container || selected.port.ContainerPort != port.ContainerPort {
+					warnings.add("a Knative Service exposes a single port only, port %d of service '%s' is dropped", svcPort.Port, svc.Name)
+				}
+				continue
+			}
+			if isH2CPort(svcPort) {
+				port.Name = "h2c"
+			}
+			selected = &servingPort{container: container, port: port}
+		}
+	}
+	if selected != nil {
+		return selected
+	}
+	for _, container := range containers {
+		if len(container.Ports) > 0 {
+			return &servingPort{container: container.Name, port: container.Ports[0]}
+		}
+	}
+	return nil
+}
+
+func resolveTargetPort(containers []corev1.Container, svcPort corev1.ServicePort) (string, corev1.ContainerPort, bool) {
+	target := svcPort.TargetPort
+	if target.Type == intstr.Int && target.IntVal == 0 {
+		target = intstr.FromInt32(svcPort.Port)
+	}
+	for _, container := range containers {
+		for _, port := range container.Ports {
+			if (target.Type == intstr.String && port.Name == target.StrVal) ||
+				(target.Type == intstr.Int && port.ContainerPort == target.IntVal) {
+				return container.Name, port, true
+			}
+		}
+	}
+	// A numeric target port does not need to be declared in the container
+	if target.Type == intstr.Int && len(containers) == 1 {
+		return containers[0].Name, corev1.ContainerPort{ContainerPort: target.IntVal}, true
+	}
+	return "", corev1.ContainerPort{}, false
+}
+
+func isH2CPort(port corev1.ServicePort) bool {
+	if port.AppProtocol != nil && (*port.AppProtocol == "kubernetes.io/h2c" || *port.AppProtocol == "h2c" || *port.AppProtocol == "grpc") {
+		return true
+	}
+	return port.Name == "h2c" || port.Name == "grpc" || strings.HasPrefix(port.Name, "grpc-")
+}
+
+// clusterLocal returns true if the pods are exposed by services which are reachable
+// from within the cluster only
+func clusterLocal(services []corev1.Service) bool {
+	if len(services) == 0 {
+		return false
+	}
+	for _, svc := range services {
+		if svc.Spec.Type == corev1.ServiceTypeLoadBalancer || svc.Spec.Type == corev1.ServiceTypeNodePort {
+			return false
+		}
+	}
+	return true
+}
+
+// convertPodSpec strips all fields from the pod spec which are not supported by Knative
+func convertPodSpec(in corev1.PodSpec, port *servingPort, warnings *conversionWarnings) corev1.PodSpec {
+	out := corev1.PodSpec{
+		ServiceAccountName: in.ServiceAccountName,
+		ImagePullSecrets:   in.ImagePullSecrets,
+		EnableServiceLinks: in.EnableServiceLinks,
+	}
+	if in.AutomountServiceAccountToken != nil && !*in.AutomountServiceAccountToken {
+		out.AutomountServiceAccountToken = in.AutomountServiceAccountToken
+	}
+
+	// Fields which are supported if the corresponding feature is enabled in Knative Serving
+	gated := []struct {
+		field   string
+		feature string
+		set     bool
+	}{
+		{"affinity", config.FeaturePodSpecAffinity, in.Affinity != nil},
+		{"topologySpreadConstraints", config.FeaturePodSpecTopologySpreadConstraints, len(in.TopologySpreadConstraints) > 0},
+		{"hostAliases", config.FeaturePodSpecHostAliases, len(in.HostAliases) > 0},
+		{"nodeSelector", config.FeaturePodSpecNodeSelector, len(in.NodeSelector) > 0},
+		{"runtimeClassName", config.FeaturePodSpecRuntimeClassName, in.RuntimeClassName != nil},
+		{"tolerations", config.FeaturePodSpecTolerations, len(in.Tolerations) > 0},
+		{"securityContext", config.FeaturePodSpecSecurityContext, hasPodSecurityContext(in)},
+		{"shareProcessNamespace", config.FeaturePodSpecShareProcessNamespace, in.ShareProcessNamespace != nil},
+		{"priorityClassName", config.FeaturePodSpecPriorityClassName, in.PriorityClassName != ""},
+		{"schedulerName", config.FeaturePodSpecSchedulerName, in.SchedulerName != "" && in.SchedulerName != corev1.DefaultSchedulerName},
+		{"initContainers", config.FeaturePodSpecInitContainers, len(in.InitContainers) > 0},
+		{"dnsPolicy", config.FeaturePodSpecDNSPolicy, in.DNSPolicy != "" && in.DNSPolicy != corev1.DNSClusterFirst},
+		{"dnsConfig", config.FeaturePodSpecDNSConfig, in.DNSConfig != nil},
+		{"hostIPC", config.FeaturePodSpecHostIPC, in.HostIPC},
+		{"hostPID", config.FeaturePodSpecHostPID, in.HostPID},
+		{"hostNetwork", config.FeaturePodSpecHostNetwork, in.HostNetwork},
+	}
+	for _, g := range gated {
+		if g.set {
+			warnings.add("pod field '%s' requires the feature '%s' to be enabled in Knative Serving", g.field, g.feature)
+		}
+	}
+	out.Affinity = in.Affinity
+	out.TopologySpreadConstraints = in.TopologySpreadConstraints
+	out.HostAliases = in.HostAliases
+	out.NodeSelector = in.NodeSelector
+	out.RuntimeClassName = in.RuntimeClassName
+	out.Tolerations = in.Tolerations
+	if hasPodSecurityContext(in) {
+		out.SecurityContext = in.SecurityContext
+	}
+	out.ShareProcessNamespace = in.ShareProcessNamespace
+	out.PriorityClassName = in.PriorityClassName
+	if in.SchedulerName != corev1.DefaultSchedulerName {
+		out.SchedulerName = in.SchedulerName
+	}
+	out.InitContainers = in.InitContainers
+	if in.DNSPolicy != corev1.DNSClusterFirst {
+		out.DNSPolicy = in.DNSPolicy
+	}
+	out.DNSConfig = in.DNSConfig
+	out.HostIPC = in.HostIPC
+	out.HostPID = in.HostPID
+	out.HostNetwork = in.HostNetwork
+
+	dropped := []struct {
+		field string
+		set   bool
+	}{
+		{"nodeName", in.NodeName != ""},
+		{"hostname", in.Hostname != ""},
+		{"subdomain", in.Subdomain != ""},
+		{"readinessGates", len(in.ReadinessGates) > 0},
+		{"activeDeadlineSeconds", in.ActiveDeadlineSeconds != nil},
+		{"ephemeralContainers", len(in.EphemeralContainers) > 0},
+		{"resourceClaims", len(in.ResourceClaims) > 0},
+	}
+	for _, d := range dropped {
+		if d.set {
+			warnings.add("pod field '%s' is not supported by Knative and is dropped", d.field)
+		}
+	}
+
+	var droppedVolumes []string
+	for _, volume := range in.Volumes {
+		source := volume.VolumeSource
+		switch {
+		case source.ConfigMap != nil, source.Secret != nil, source.Projected != nil, source.EmptyDir != nil:
+		case source.PersistentVolumeClaim != nil:
+			warnings.add("volume '%s' requires the feature '%s' to be enabled in Knative Serving", volume.Name, config.FeaturePodSpecPVClaim)
+		case source.HostPath != nil:
+			warnings.add("volume '%s' requires the feature '%s' to be enabled in Knative Serving", volume.Name, config.FeaturePodSpecHostPath)
+		default:
+			warnings.add("volume '%s' has an unsupported volume type and is dropped together with its mounts", volume.Name)
+			droppedVolumes = append(droppedVolumes, volume.Name)
+			continue
+		}
+		out.Volumes = append(out.Volumes, volume)
+	}
+
+	for _, container := range in.Containers {
+		out.Containers = append(out.Containers, convertContainer(container, port, droppedVolumes, warnings))
+	}
+	return out
+}
+
+// convertContainer strips the fields of a container which are not supported by Knative
+func convertContainer(container corev1.Container, port *servingPort, droppedVolumes []string, warnings *conversionWarnings) corev1.Container {
+	var servingPortNumber int32
+	isServing := port != nil && port.container == container.Name
+	if isServing {
+		servingPortNumber = port.port.ContainerPort
+	}
+	declaredPorts := container.Ports
+	for _, p := range declaredPorts {
+		if !isServing || p.ContainerPort != servingPortNumber {
+			warnings.add("a Knative Service exposes a single port only, port %d of container '%s' is dropped", p.ContainerPort, container.Name)
+		}
+	}
+	container.Ports = nil
+	if isServing {
+		name := port.port.Name
+		if name != "h2c" && name != "http1" {
+			name = ""
+		}
+		container.Ports = []corev1.ContainerPort{{Name: name, ContainerPort: servingPortNumber}}
+	}
+
+	var env []corev1.EnvVar
+	for _, e := range container.Env {
+		if isReservedEnvVar(e.Name) {
+			warnings.add("environment variable '%s' of container '%s' is set by Knative and is dropped", e.Name, container.Name)
+			continue
+		}
+		env = append(env, e)
+	}
+	container.Env = env
+
+	var mounts []corev1.VolumeMount
+	for _, mount := range container.VolumeMounts {
+		if !contains(droppedVolumes, mount.Name) {
+			mounts = append(mounts, mount)
+		}
+	}
+	container.VolumeMounts = mounts
+
+	for _, probe := range []*corev1.Probe{container.LivenessProbe, container.ReadinessProbe, container.StartupProbe} {
+		convertProbe(probe, container.Name, declaredPorts, servingPortNumber, warnings)
+	}
+
+	if container.Lifecycle != nil {
+		warnings.add("lifecycle hooks of container '%s' are not supported by Knative and are dropped", container.Name)
+		container.Lifecycle = nil
+	}
+	if len(container.VolumeDevices) > 0 {
+		warnings.add("volume devices of container '%s' are not supported by Knative and are dropped", container.Name)
+		container.VolumeDevices = nil
+	}
+	container.Stdin, container.StdinOnce, container.TTY = false, false, false
+	return container
+}
+
+// convertProbe removes the port of probes which target the serving port, as Knative
+// probes the serving port through the queue proxy
+func convertProbe(probe *corev1.Probe, container string, ports []corev1.ContainerPort, servingPort int32, warnings *conversionWarnings) {
+	if probe == nil {
+		return
+	}
+	var port *intstr.IntOrString
+	switch {
+	case probe.HTTPGet != nil:
+		port = &probe.HTTPGet.Port
+	case probe.TCPSocket != nil:
+		port = &probe.TCPSocket.Port
+	default:
+		return
+	}
+	if port.Type == intstr.Int && port.IntVal == 0 {
+		return
+	}
+	if port.Type == intstr.String {
+		for _, p := range ports {
+			if p.Name == port.StrVal {
+				*port = intstr.FromInt32(p.ContainerPort)
+			}
+		}
+	}
+	if port.Type == intstr.Int && port.IntVal == servingPort {
+		*port = intstr.IntOrString{}
+		return
+	}
+	warnings.add("probe of container '%s' uses port '%s' which is not the serving port, Knative might reject it", container, port.String())
+}
+
+func hasPodSecurityContext(spec corev1.PodSpec) bool {
+	return spec.SecurityContext != nil && !equality.Semantic.DeepEqual(*spec.SecurityContext, corev1.PodSecurityContext{})
+}
+
+func isReservedEnvVar(name string) bool {
+	return contains(reservedEnvVars, name)
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// filterKeys returns a copy of the map without the given keys or key prefixes
+func filterKeys(values map[string]string, remove ...string) map[string]string {
+	var filtered map[string]string
+	for key, value := range values {
+		skip := false
+		for _, r := range remove {
+			if key == r || (strings.HasSuffix(r, "/") && strings.HasPrefix(key, r)) {
+				skip = true
+			}
+		}
+		if skip {
+			continue
+		}
+		if filtered == nil {
+			filtered = make(map[string]string)
+		}
+		filtered[key] = value
+	}
+	return filtered
+}
+
+func setLabel(labels map[string]string, key, value string) map[string]string {
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[key] = value
+	return labels
+}
+
+func setTemplateAnnotation(template *servingv1.RevisionTemplateSpec, key, value string) {
+	if template.Annotations == nil {
+		template.Annotations = make(map[string]string)
+	}
+	template.Annotations[key] = value
+}
@@ -0,0 +1,235 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	network "knative.dev/networking/pkg/apis/networking"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/yaml"
+
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+	"knative.dev/client/pkg/wait"
+)
+
+func TestServiceFromDeployment(t *testing.T) {
+	deployment := createTestDeploymentForImport()
+	services := []corev1.Service{createTestK8sService("web", "http", corev1.ServiceTypeClusterIP)}
+
+	service, warnings := serviceFromDeployment(deployment, services)
+	assert.Equal(t, service.Name, "web")
+	assert.Equal(t, service.Labels[network.VisibilityLabelKey], "cluster-local")
+	assert.Equal(t, service.Annotations["team"], "a")
+	assert.Equal(t, service.Spec.Template.Annotations[autoscaling.MinScaleAnnotationKey], "2")
+
+	podSpec := service.Spec.Template.Spec.PodSpec
+	assert.Equal(t, len(podSpec.Containers), 2)
+	app := podSpec.Containers[0]
+	assert.DeepEqual(t, app.Ports, []corev1.ContainerPort{{ContainerPort: 8080}})
+	assert.DeepEqual(t, app.Env, []corev1.EnvVar{{Name: "TARGET", Value: "world"}})
+	assert.Equal(t, app.ReadinessProbe.HTTPGet.Port, intstr.IntOrString{})
+	assert.Equal(t, app.LivenessProbe.TCPSocket.Port, intstr.FromInt32(9000))
+	assert.DeepEqual(t, app.VolumeMounts, []corev1.VolumeMount{{Name: "config", MountPath: "/config"}})
+	assert.Assert(t, app.Lifecycle == nil)
+	assert.Equal(t, len(podSpec.Containers[1].Ports), 0)
+	assert.Equal(t, len(podSpec.Volumes), 1)
+	assert.Assert(t, podSpec.NodeName == "")
+	assert.Assert(t, podSpec.SchedulerName == "")
+
+	assert.Assert(t, util.ContainsAll(joinWarnings(warnings),
+		"port 9000 of container 'app' is dropped",
+		"port 9100 of container 'sidecar' is dropped",
+		"environment variable 'PORT' of container 'app' is set by Knative",
+		"probe of container 'app' uses port '9000'",
+		"volume 'cache' has an unsupported volume type",
+		"lifecycle hooks of container 'app'",
+		"pod field 'nodeSelector' requires the feature 'kubernetes.podspec-nodeselector'",
+		"pod field 'nodeName' is not supported",
+		"2 replicas of deployment 'web' are kept as min-scale, the service does not scale to zero"))
+}
+
+func TestServiceFromDeploymentKeepsInput(t *testing.T) {
+	deployment := createTestDeploymentForImport()
+	deployment.Labels = map[string]string{"app": "web"}
+	services := []corev1.Service{createTestK8sService("web", "http", corev1.ServiceTypeClusterIP)}
+
+	service, _ := serviceFromDeployment(deployment, services)
+	assert.DeepEqual(t, service.Labels, map[string]string{"app": "web", network.VisibilityLabelKey: "cluster-local"})
+	assert.DeepEqual(t, deployment.Labels, map[string]string{"app": "web"})
+}
+
+func TestServiceFromDeploymentPortSelection(t *testing.T) {
+	deployment := createTestDeploymentForImport()
+	// A service targeting the metrics port wins over the first container port
+	services := []corev1.Service{createTestK8sService("web", "metrics", corev1.ServiceTypeLoadBalancer)}
+	services[0].Spec.Ports = append(services[0].Spec.Ports, corev1.ServicePort{Port: 80, TargetPort: intstr.FromInt32(8080)})
+	services[0].Spec.Ports[0].Name = "grpc"
+
+	service, warnings := serviceFromDeployment(deployment, services)
+	assert.DeepEqual(t, service.Spec.Template.Spec.Containers[0].Ports, []corev1.ContainerPort{{Name: "h2c", ContainerPort: 9000}})
+	assert.Equal(t, service.Labels[network.VisibilityLabelKey], "")
+	assert.Assert(t, util.ContainsAll(joinWarnings(warnings), "port 80 of service 'web' is dropped", "port 8080 of container 'app' is dropped"))
+
+	// Without any services the first container port is used
+	service, _ = serviceFromDeployment(deployment, nil)
+	assert.DeepEqual(t, service.Spec.Template.Spec.Containers[0].Ports, []corev1.ContainerPort{{ContainerPort: 8080}})
+}
+
+func TestServiceImportFromDeploymentDryRun(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(createTestDeploymentForImport(), k8sServicePtr(createTestK8sService("web", "http", corev1.ServiceTypeClusterIP)))
+	client := knclient.NewMockKnServiceClient(t)
+
+	out, stderr, err := executeServiceImportCommand(client, kubeClient, "--from-deployment", "web", "--dry-run")
+	assert.NilError(t, err)
+	var service servingv1.Service
+	assert.NilError(t, yaml.Unmarshal([]byte(out), &service))
+	assert.Equal(t, service.Kind, "Service")
+	assert.Equal(t, service.Name, "web")
+	assert.Equal(t, service.Namespace, "default")
+	assert.Equal(t, service.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/web:v1")
+	assert.Assert(t, util.ContainsAll(stderr, "Warning: service 'web':", "is dropped"))
+
+	out, _, err = executeServiceImportCommand(client, kubeClient, "--from-deployment", "web", "--dry-run", "-o", "json")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, `"kind": "Service"`, `"image": "gcr.io/foo/web:v1"`))
+}
+
+func TestServiceImportFromDeployment(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset(createTestDeploymentForImport())
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("web", nil, errors.NewNotFound(servingv1.Resource("service"), "web"))
+	r.CreateService(func(t *testing.T, service *servingv1.Service) {
+		assert.Equal(t, service.Name, "web")
+		assert.Equal(t, service.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/web:v1")
+	}, nil)
+	r.WaitForService("web", mock.Any(), wait.NoopMessageCallback(), nil, time.Second)
+	r.GetService("web", getServiceWithUrl("web", "http://web.example.com"), nil)
+
+	out, _, err := executeServiceImportCommand(client, kubeClient, "--from-deployment", "web")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Service", "'web'", "default", "imported", "http://web.example.com"))
+
+	r.Validate()
+}
+
+func TestServiceImportFromDeploymentErrors(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	client := knclient.NewMockKnServiceClient(t)
+
+	_, _, err := executeServiceImportCommand(client, kubeClient, "--from-deployment", "web", "file.yaml")
+	assert.ErrorContains(t, err, "doesn't accept a filename")
+
+	_, _, err = executeServiceImportCommand(client, kubeClient, "file.yaml", "--dry-run")
+	assert.ErrorContains(t, err, "--dry-run only together with --from-deployment")
+
+	_, _, err = executeServiceImportCommand(client, kubeClient, "--from-deployment", "web", "-o", "yaml")
+	assert.ErrorContains(t, err, "--output only together with --dry-run")
+
+	_, _, err = executeServiceImportCommand(client, kubeClient, "--from-deployment", "web")
+	assert.Assert(t, errors.IsNotFound(err))
+}
+
+func joinWarnings(warnings []string) string {
+	var buf bytes.Buffer
+	for _, w := range warnings {
+		buf.WriteString(w + "\n")
+	}
+	return buf.String()
+}
+
+func createTestDeploymentForImport() *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web",
+			Namespace: "default",
+			Annotations: map[string]string{
+				"team":                              "a",
+				"deployment.kubernetes.io/revision": "3",
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.Int32(2),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+				Spec: corev1.PodSpec{
+					NodeName:      "node-1",
+					NodeSelector:  map[string]string{"disk": "ssd"},
+					SchedulerName: corev1.DefaultSchedulerName,
+					DNSPolicy:     corev1.DNSClusterFirst,
+					RestartPolicy: corev1.RestartPolicyAlways,
+					Containers: []corev1.Container{
+						{
+							Name:  "app",
+							Image: "gcr.io/foo/web:v1",
+							Ports: []corev1.ContainerPort{
+								{Name: "http", ContainerPort: 8080, Protocol: corev1.ProtocolTCP},
+								{Name: "metrics", ContainerPort: 9000, Protocol: corev1.ProtocolTCP},
+							},
+							Env: []corev1.EnvVar{{Name: "TARGET", Value: "world"}, {Name: "PORT", Value: "8080"}},
+							ReadinessProbe: &corev1.Probe{ProbeHandler: corev1.ProbeHandler{
+								HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromString("http")},
+							}},
+							LivenessProbe: &corev1.Probe{ProbeHandler: corev1.ProbeHandler{
+								TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromString("metrics")},
+							}},
+							VolumeMounts: []corev1.VolumeMount{{Name: "config", MountPath: "/config"}, {Name: "cache", MountPath: "/cache"}},
+							Lifecycle:    &corev1.Lifecycle{PreStop: &corev1.LifecycleHandler{Exec: &corev1.ExecAction{Command: []string{"sleep", "5"}}}},
+						},
+						{
+							Name:  "sidecar",
+							Image: "gcr.io/foo/sidecar:v1",
+							Ports: []corev1.ContainerPort{{ContainerPort: 9100}},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "web"}}}},
+						{Name: "cache", VolumeSource: corev1.VolumeSource{NFS: &corev1.NFSVolumeSource{Server: "nfs", Path: "/cache"}}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func createTestK8sService(name, targetPort string, serviceType corev1.ServiceType) corev1.Service {
+	return corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: corev1.ServiceSpec{
+			Type:     serviceType,
+			Selector: map[string]string{"app": "web"},
+			Ports:    []corev1.ServicePort{{Name: "http", Port: 80, TargetPort: intstr.FromString(targetPort)}},
+		},
+	}
+}
+
+func k8sServicePtr(service corev1.Service) runtime.Object {
+	return &service
+}
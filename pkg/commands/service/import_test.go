@@ -15,14 +15,19 @@
 package service
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/spf13/cobra"
 	"gotest.tools/v3/assert"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/client/pkg/commands"
+	knflags "knative.dev/client/pkg/flags"
 	knclient "knative.dev/client/pkg/serving/v1"
 	"knative.dev/client/pkg/util"
 	"knative.dev/client/pkg/util/mock"
@@ -30,6 +35,27 @@ import (
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 )
 
+func executeServiceImportCommand(client knclient.KnServingClient, kubeClient kubernetes.Interface, args ...string) (string, string, error) {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+	knParams.NewServingClient = func(namespace string) (knclient.KnServingClient, error) {
+		return client, nil
+	}
+	knParams.NewKubeClient = func() (kubernetes.Interface, error) {
+		return kubeClient, nil
+	}
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd := NewServiceCommand(knParams)
+	cmd.SetArgs(append([]string{"import"}, args...))
+	cmd.SetOut(stdout)
+	cmd.SetErr(stderr)
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		return knflags.ReconcileBoolFlags(cmd.Flags())
+	}
+	err := cmd.Execute()
+	return stdout.String(), stderr.String(), err
+}
+
 func TestServiceImportFilenameError(t *testing.T) {
 	client := knclient.NewMockKnServiceClient(t)
 	r := client.Recorder()
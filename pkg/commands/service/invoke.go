@@ -0,0 +1,332 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"knative.dev/networking/pkg/http/header"
+	"knative.dev/pkg/apis"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/printers"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+var invokeExample = `
+  # Send a GET request to service 'svc'
+  kn service invoke svc
+
+  # Send a POST request with the content of 'data.json' to the path '/api' of the revision tagged with 'candidate'
+  kn service invoke svc --tag candidate --path /api --data @data.json -H Content-Type:application/json
+
+  # Route the request to the tagged revision with the 'Knative-Serving-Tag' header instead of the tag URL
+  kn service invoke svc --tag candidate --tag-header
+
+  # Use the cluster-local address of the service, e.g. when running within the cluster
+  kn service invoke svc --cluster-local`
+
+// invokeFlags holds the flags for 'kn service invoke'
+type invokeFlags struct {
+	tag          string
+	tagHeader    bool
+	clusterLocal bool
+	path         string
+	method       string
+	data         string
+	headers      []string
+	insecure     bool
+	caCert       string
+	timeout      time.Duration
+}
+
+// NewServiceInvokeCommand represents 'kn service invoke' command
+func NewServiceInvokeCommand(p *commands.KnParams) *cobra.Command {
+	var invokeFlags invokeFlags
+	command := &cobra.Command{
+		Use:               "invoke NAME",
+		Short:             "Send an HTTP request to a service",
+		Example:           invokeExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service invoke' requires the service name given as single argument")
+			}
+			if invokeFlags.tagHeader && invokeFlags.tag == "" {
+				return errors.New("--tag-header requires a tag given with --tag")
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			targetURL, tagHeader, err := resolveInvokeURL(cmd.Context(), client, args[0], invokeFlags)
+			if err != nil {
+				return err
+			}
+			req, err := invokeFlags.newRequest(cmd.Context(), targetURL.String(), cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+			if tagHeader != "" {
+				req.Header.Set(header.RouteTagKey, tagHeader)
+			}
+			httpClient, err := invokeFlags.newHTTPClient()
+			if err != nil {
+				return err
+			}
+			return invoke(httpClient, req, cmd.OutOrStdout())
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.StringVar(&invokeFlags.tag, "tag", "", "Send the request to the revision with the given traffic tag.")
+	flags.BoolVar(&invokeFlags.tagHeader, "tag-header", false,
+		"Route the request to the tagged revision by sending the tag in the '"+header.RouteTagKey+"' header to the service URL instead of using the tag URL. "+
+			"Requires tag header based routing to be enabled in the cluster.")
+	flags.BoolVar(&invokeFlags.clusterLocal, "cluster-local", false,
+		"Send the request to the cluster-local address of the service. A tag is sent in the '"+header.RouteTagKey+"' header then.")
+	flags.StringVar(&invokeFlags.path, "path", "", "Path to append to the URL of the service, optionally with a query, e.g. '/api?x=1'.")
+	flags.StringVarP(&invokeFlags.method, "method", "X", "", "HTTP method to use. Defaults to GET, or POST if --data is given.")
+	flags.StringVarP(&invokeFlags.data, "data", "d", "",
+		"Request body to send. Use '@filename' to read the body from a file or '@-' to read it from stdin.")
+	flags.StringArrayVarP(&invokeFlags.headers, "header", "H", nil,
+		"Request header to send (format: --header Name:Value). This flag can be specified multiple times.")
+	flags.BoolVar(&invokeFlags.insecure, "insecure", false, "Skip the verification of the TLS certificate of the service.")
+	flags.StringVar(&invokeFlags.caCert, "cacert", "", "Path to a PEM file with additional CA certificates for verifying the TLS certificate of the service.")
+	flags.DurationVar(&invokeFlags.timeout, "timeout", 30*time.Second, "Timeout for the request.")
+	return command
+}
+
+// resolveInvokeURL returns the URL to send the request to and the tag to send in the
+// tag header, if tag header based routing is used
+func resolveInvokeURL(ctx context.Context, client clientservingv1.KnServingClient, name string, flags invokeFlags) (*apis.URL, string, error) {
+	service, err := client.GetService(ctx, name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var targetURL *apis.URL
+	if flags.clusterLocal {
+		if service.Status.Address != nil {
+			targetURL = service.Status.Address.URL
+		}
+	} else {
+		targetURL = service.Status.URL
+	}
+	if targetURL == nil {
+		return nil, "", fmt.Errorf("service '%s' in namespace '%s' has no URL yet, it might not be ready", name, client.Namespace())
+	}
+
+	tagHeader := ""
+	if flags.tag != "" {
+		tagURL, err := tagTargetURL(ctx, client, service, flags.tag)
+		if err != nil {
+			return nil, "", err
+		}
+		if flags.tagHeader || flags.clusterLocal {
+			tagHeader = flags.tag
+		} else {
+			targetURL = tagURL
+		}
+	}
+
+	targetURL = targetURL.DeepCopy()
+	if flags.path != "" {
+		path, err := url.Parse(flags.path)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid value for --path '%s': %w", flags.path, err)
+		}
+		if path.Scheme != "" || path.Host != "" {
+			return nil, "", fmt.Errorf("invalid value for --path '%s': must be a path and an optional query, not a URL", flags.path)
+		}
+		targetURL.Path = strings.TrimSuffix(targetURL.Path, "/") + "/" + strings.TrimPrefix(path.Path, "/")
+		if path.RawQuery != "" {
+			if targetURL.RawQuery != "" {
+				targetURL.RawQuery += "&"
+			}
+			targetURL.RawQuery += path.RawQuery
+		}
+	}
+	return targetURL, tagHeader, nil
+}
+
+// tagTargetURL looks up the URL of a tagged traffic target in the status of the
+// service, falling back to the route of the service
+func tagTargetURL(ctx context.Context, client clientservingv1.KnServingClient, service *servingv1.Service, tag string) (*apis.URL, error) {
+	traffic := service.Status.Traffic
+	if !hasTagURL(traffic, tag) {
+		route, err := client.GetRoute(ctx, service.Name)
+		if err != nil {
+			return nil, err
+		}
+		traffic = route.Status.Traffic
+	}
+	for _, target := range traffic {
+		if target.Tag == tag && target.URL != nil {
+			return target.URL, nil
+		}
+	}
+	return nil, fmt.Errorf("no traffic target with tag '%s' found for service '%s'", tag, service.Name)
+}
+
+func hasTagURL(traffic []servingv1.TrafficTarget, tag string) bool {
+	for _, target := range traffic {
+		if target.Tag == tag && target.URL != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// newRequest creates the HTTP request from the flags
+func (f *invokeFlags) newRequest(ctx context.Context, url string, stdin io.Reader) (*http.Request, error) {
+	body, err := f.readData(stdin)
+	if err != nil {
+		return nil, err
+	}
+	method := f.method
+	if method == "" {
+		method = http.MethodGet
+		if body != nil {
+			method = http.MethodPost
+		}
+	}
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, strings.ToUpper(method), url, bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	for _, h := range f.headers {
+		key, value, found := strings.Cut(h, ":")
+		if !found || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("invalid header '%s', expected format Name:Value", h)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		if strings.EqualFold(key, "Host") {
+			req.Host = value
+			continue
+		}
+		req.Header.Add(key, value)
+	}
+	return req, nil
+}
+
+// readData returns the request body given with --data, or nil if no body is given
+func (f *invokeFlags) readData(stdin io.Reader) ([]byte, error) {
+	switch {
+	case f.data == "":
+		return nil, nil
+	case f.data == "@-":
+		return io.ReadAll(stdin)
+	case strings.HasPrefix(f.data, "@"):
+		return os.ReadFile(f.data[1:])
+	}
+	return []byte(f.data), nil
+}
+
+// newHTTPClient creates an HTTP client honoring the TLS related flags
+func (f *invokeFlags) newHTTPClient() (*http.Client, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: f.insecure, //nolint:gosec // explicitly requested with --insecure
+	}
+	if f.caCert != "" {
+		pem, err := os.ReadFile(f.caCert)
+		if err != nil {
+			return nil, err
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no PEM encoded certificates found in '%s'", f.caCert)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport, Timeout: f.timeout}, nil
+}
+
+// invoke sends the request and prints the status, latency, headers and body of the response
+func invoke(httpClient *http.Client, req *http.Request, out io.Writer) error {
+	start := time.Now()
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("cannot send request to %s: %w", req.URL, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("cannot read response from %s: %w", req.URL, err)
+	}
+	latency := time.Since(start)
+
+	dw := printers.NewPrefixWriter(out)
+	dw.WriteAttribute("URL", req.URL.String())
+	dw.WriteAttribute("Status", fmt.Sprintf("%s %s", resp.Proto, resp.Status))
+	dw.WriteAttribute("Latency", latency.Round(time.Millisecond).String())
+	headerSection := dw.WriteAttribute("Headers", "")
+	keys := make([]string, 0, len(resp.Header))
+	for key := range resp.Header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range resp.Header[key] {
+			headerSection.WriteAttribute(key, value)
+		}
+	}
+	if err := dw.Flush(); err != nil {
+		return err
+	}
+
+	if len(body) > 0 {
+		fmt.Fprintln(out, "")
+		if _, err := out.Write(body); err != nil {
+			return err
+		}
+		if body[len(body)-1] != '\n' {
+			fmt.Fprintln(out, "")
+		}
+	}
+	return nil
+}
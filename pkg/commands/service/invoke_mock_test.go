@@ -0,0 +1,211 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+// newEchoServer returns a test server which echoes method, path with query, tag header and body
+func newEchoServer(tls bool) *httptest.Server {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		w.Header().Set("X-Reply", "echo")
+		fmt.Fprintf(w, "%s %s tag=%s test=%s body=%s", req.Method, req.URL.RequestURI(), req.Header.Get("Knative-Serving-Tag"), req.Header.Get("X-Test"), body)
+	})
+	if tls {
+		return httptest.NewTLSServer(handler)
+	}
+	return httptest.NewServer(handler)
+}
+
+func TestServiceInvokeMock(t *testing.T) {
+	server := newEchoServer(false)
+	defer server.Close()
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", createServiceForInvoke("foo", server.URL, nil), nil)
+
+	output, err := executeServiceCommand(client, "invoke", "foo", "--path", "/hello", "-H", "X-Test: yes")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "URL:", server.URL+"/hello", "Status:", "200 OK", "Latency:", "Headers:", "X-Reply:", "echo",
+		"GET /hello tag= test=yes body="))
+
+	r.Validate()
+}
+
+func TestServiceInvokePathWithQueryMock(t *testing.T) {
+	server := newEchoServer(false)
+	defer server.Close()
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", createServiceForInvoke("foo", server.URL, nil), nil)
+	r.GetService("foo", createServiceForInvoke("foo", server.URL, nil), nil)
+
+	output, err := executeServiceCommand(client, "invoke", "foo", "--path", "/api?x=1&y=a%20b")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, server.URL+"/api?x=1&y=a%20b", "GET /api?x=1&y=a%20b tag="))
+
+	_, err = executeServiceCommand(client, "invoke", "foo", "--path", "http://example.com/api")
+	assert.ErrorContains(t, err, "must be a path and an optional query, not a URL")
+
+	r.Validate()
+}
+
+func TestServiceInvokeDataFromFileMock(t *testing.T) {
+	server := newEchoServer(false)
+	defer server.Close()
+
+	file := filepath.Join(t.TempDir(), "data.json")
+	assert.NilError(t, os.WriteFile(file, []byte(`{"hello":"world"}`), 0600))
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", createServiceForInvoke("foo", server.URL, nil), nil)
+	r.GetService("foo", createServiceForInvoke("foo", server.URL, nil), nil)
+
+	output, err := executeServiceCommand(client, "invoke", "foo", "--data", "@"+file)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, `POST / tag= test= body={"hello":"world"}`))
+
+	output, err = executeServiceCommand(client, "invoke", "foo", "--data", "plain", "-X", "put")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, `PUT / tag= test= body=plain`))
+
+	r.Validate()
+}
+
+func TestServiceInvokeTagMock(t *testing.T) {
+	server := newEchoServer(false)
+	defer server.Close()
+	tagServer := newEchoServer(false)
+	defer tagServer.Close()
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	service := createServiceForInvoke("foo", server.URL, map[string]string{"candidate": tagServer.URL})
+	r.GetService("foo", service, nil)
+	r.GetService("foo", service, nil)
+
+	output, err := executeServiceCommand(client, "invoke", "foo", "--tag", "candidate")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, tagServer.URL, "GET / tag= "))
+
+	output, err = executeServiceCommand(client, "invoke", "foo", "--tag", "candidate", "--tag-header")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, server.URL, "GET / tag=candidate "))
+
+	r.Validate()
+}
+
+func TestServiceInvokeTagFromRouteMock(t *testing.T) {
+	tagServer := newEchoServer(false)
+	defer tagServer.Close()
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	route := &servingv1.Route{}
+	route.Status.Traffic = createServiceForInvoke("foo", "", map[string]string{"candidate": tagServer.URL}).Status.Traffic
+	r.GetService("foo", createServiceForInvoke("foo", "http://foo.example.com", nil), nil)
+	r.GetRoute("foo", route, nil)
+	r.GetService("foo", createServiceForInvoke("foo", "http://foo.example.com", nil), nil)
+	r.GetRoute("foo", route, nil)
+
+	output, err := executeServiceCommand(client, "invoke", "foo", "--tag", "candidate")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, tagServer.URL))
+
+	_, err = executeServiceCommand(client, "invoke", "foo", "--tag", "unknown")
+	assert.ErrorContains(t, err, "no traffic target with tag 'unknown'")
+
+	r.Validate()
+}
+
+func TestServiceInvokeTLSMock(t *testing.T) {
+	server := newEchoServer(true)
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	assert.NilError(t, os.WriteFile(caFile, caPEM, 0600))
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	for i := 0; i < 3; i++ {
+		r.GetService("foo", createServiceForInvoke("foo", server.URL, nil), nil)
+	}
+
+	_, err := executeServiceCommand(client, "invoke", "foo")
+	assert.ErrorContains(t, err, "certificate")
+
+	output, err := executeServiceCommand(client, "invoke", "foo", "--insecure")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "200 OK"))
+
+	output, err = executeServiceCommand(client, "invoke", "foo", "--cacert", caFile)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "200 OK"))
+
+	r.Validate()
+}
+
+func TestServiceInvokeErrorsMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", createServiceForInvoke("foo", "http://foo.example.com", nil), nil)
+	r.GetService("foo", &servingv1.Service{}, nil)
+
+	_, err := executeServiceCommand(client, "invoke", "foo", "-H", "invalid")
+	assert.ErrorContains(t, err, "invalid header 'invalid'")
+
+	_, err = executeServiceCommand(client, "invoke", "foo")
+	assert.ErrorContains(t, err, "has no URL yet")
+
+	_, err = executeServiceCommand(client, "invoke", "foo", "--tag-header")
+	assert.ErrorContains(t, err, "--tag-header requires a tag")
+
+	r.Validate()
+}
+
+func createServiceForInvoke(name, serviceURL string, tagURLs map[string]string) *servingv1.Service {
+	service := &servingv1.Service{}
+	service.Name = name
+	if serviceURL != "" {
+		service.Status.URL, _ = apis.ParseURL(serviceURL)
+		service.Status.Address = &duckv1.Addressable{URL: service.Status.URL}
+	}
+	for tag, tagURL := range tagURLs {
+		parsed, _ := apis.ParseURL(tagURL)
+		service.Status.Traffic = append(service.Status.Traffic, servingv1.TrafficTarget{Tag: tag, URL: parsed})
+	}
+	return service
+}
@@ -0,0 +1,225 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/config"
+	"knative.dev/client/pkg/printers"
+	"knative.dev/client/pkg/serving/lint"
+)
+
+var lintExample = `
+  # Lint the service 'svc' in the current namespace
+  kn service lint svc
+
+  # Lint all services defined in a YAML file and report the findings in SARIF format
+  kn service lint -f services.yaml -o sarif > lint.sarif
+
+  # Don't check service 'svc' for readiness probes
+  kn service update svc --annotation-service client.knative.dev/lint-disable=readiness-probe`
+
+// NewServiceLintCommand represents 'kn service lint' command
+func NewServiceLintCommand(p *commands.KnParams) *cobra.Command {
+	var filename string
+	var output string
+	var maxTimeout int64
+
+	command := &cobra.Command{
+		Use:   "lint NAME | -f FILENAME",
+		Short: "Check a service for common problems",
+		Long: `Check a service for common problems
+
+The service is either fetched from the cluster or read from a file, which can contain
+multiple services as separate YAML documents. The following rules are checked:
+
+` + lintRulesDescription() + `
+
+Rules can be disabled for a single service with the annotation '` + lint.DisableAnnotationKey + `'
+holding a comma separated list of rule IDs, or for all services in the configuration file:
+
+  lint:
+    disabled-rules:
+    - readiness-probe
+
+The command fails if a rule with severity 'error' has been violated.`,
+		Example:           lintExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if (len(args) == 1) == (filename != "") || len(args) > 1 {
+				return errors.New("'service lint' requires either the service name given as single argument or --filename")
+			}
+			if output != "" && output != "json" && output != "sarif" {
+				return fmt.Errorf("invalid value '%s' for output flag, choose one among 'json' or 'sarif'", output)
+			}
+
+			var services []*servingv1.Service
+			if filename != "" {
+				var err error
+				if services, err = readServicesForLint(filename); err != nil {
+					return err
+				}
+			} else {
+				namespace, err := p.GetNamespace(cmd)
+				if err != nil {
+					return err
+				}
+				target := cmd.Flag("target").Value.String()
+				client, err := newServingClient(p, namespace, target)
+				if err != nil {
+					return err
+				}
+				if target == "" {
+					maxTimeout = clusterMaxTimeout(cmd.Context(), p, maxTimeout)
+				}
+				service, err := client.GetService(cmd.Context(), args[0])
+				if err != nil {
+					return err
+				}
+				services = append(services, service)
+			}
+
+			linter := lint.NewLinter(lint.Options{MaxTimeoutSeconds: maxTimeout}, config.GlobalConfig.LintDisabledRules()...)
+			var findings []lint.Finding
+			for _, service := range services {
+				findings = append(findings, linter.Lint(service)...)
+			}
+
+			if err := printLintFindings(cmd.OutOrStdout(), output, findings, linter.Rules(), filename); err != nil {
+				return err
+			}
+			if errorCount := lint.CountErrors(findings); errorCount > 0 {
+				return fmt.Errorf("linting found %d error(s)", errorCount)
+			}
+			return nil
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	commands.AddGitOpsFlags(flags)
+	flags.StringVarP(&filename, "filename", "f", "", "Lint the services defined in this YAML or JSON file instead of a service in the cluster.")
+	command.MarkFlagFilename("filename")
+	flags.StringVarP(&output, "output", "o", "", "Output format. One of: json|sarif.")
+	flags.Int64Var(&maxTimeout, "max-timeout", lint.DefaultOptions.MaxTimeoutSeconds,
+		"Maximum request timeout in seconds allowed by the cluster, used if 'max-revision-timeout-seconds' can't be read from the 'config-defaults' ConfigMap of Knative Serving.")
+	return command
+}
+
+// clusterMaxTimeout reads the maximum request timeout from the 'config-defaults' ConfigMap of
+// Knative Serving. The given timeout is returned if the ConfigMap can't be read, e.g. because
+// access to the 'knative-serving' namespace is forbidden.
+func clusterMaxTimeout(ctx context.Context, p *commands.KnParams, fallback int64) int64 {
+	kubeClient, err := p.NewKubeClient()
+	if err != nil {
+		return fallback
+	}
+	configMap, err := kubeClient.CoreV1().ConfigMaps("knative-serving").Get(ctx, "config-defaults", metav1.GetOptions{})
+	if err != nil {
+		return fallback
+	}
+	timeout, err := strconv.ParseInt(configMap.Data["max-revision-timeout-seconds"], 10, 64)
+	if err != nil || timeout <= 0 {
+		return fallback
+	}
+	return timeout
+}
+
+// readServicesForLint reads all services from a file, other resources are ignored
+func readServicesForLint(filename string) ([]*servingv1.Service, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var services []*servingv1.Service
+	decoder := yaml.NewYAMLOrJSONDecoder(file, 512)
+	for {
+		service := &servingv1.Service{}
+		err := decoder.Decode(service)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot read services from '%s': %w", filename, err)
+		}
+		if service.Kind == "Service" && strings.HasPrefix(service.APIVersion, "serving.knative.dev/") {
+			services = append(services, service)
+		}
+	}
+	if len(services) == 0 {
+		return nil, fmt.Errorf("no service found in '%s'", filename)
+	}
+	return services, nil
+}
+
+func printLintFindings(out io.Writer, output string, findings []lint.Finding, rules []lint.Rule, filename string) error {
+	switch output {
+	case "json":
+		if findings == nil {
+			findings = []lint.Finding{}
+		}
+		b, err := json.MarshalIndent(findings, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(b))
+		return nil
+	case "sarif":
+		b, err := json.MarshalIndent(lint.ToSARIF(findings, rules, filename), "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(b))
+		return nil
+	}
+
+	if len(findings) == 0 {
+		fmt.Fprintln(out, "No problems found.")
+		return nil
+	}
+	dw := printers.NewPrefixWriter(out)
+	dw.WriteColsLn("SERVICE", "SEVERITY", "RULE", "MESSAGE")
+	for _, finding := range findings {
+		dw.WriteColsLn(finding.Service, string(finding.Severity), finding.Rule, finding.Message)
+	}
+	dw.WriteLine()
+	errorCount := lint.CountErrors(findings)
+	dw.WriteLine(fmt.Sprintf("%d problem(s) found, %d of them error(s).", len(findings), errorCount))
+	return dw.Flush()
+}
+
+// lintRulesDescription lists the default rules for the help message
+func lintRulesDescription() string {
+	var lines []string
+	for _, rule := range lint.NewLinter(lint.DefaultOptions).Rules() {
+		lines = append(lines, fmt.Sprintf("  %-20s %-8s %s", rule.ID, rule.Severity, rule.Description))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,216 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/ptr"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/config"
+	knflags "knative.dev/client/pkg/flags"
+	"knative.dev/client/pkg/serving/lint"
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+const lintTestServices = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+---
+apiVersion: serving.knative.dev/v1
+kind: Service
+metadata:
+  name: web
+spec:
+  template:
+    spec:
+      timeoutSeconds: 900
+      containers:
+      - image: gcr.io/foo/web:latest
+        env:
+        - name: API_KEY
+          value: abc
+---
+apiVersion: serving.knative.dev/v1
+kind: Service
+metadata:
+  name: worker
+  annotations:
+    client.knative.dev/lint-disable: resource-requests
+spec:
+  template:
+    spec:
+      containers:
+      - image: gcr.io/foo/worker:v1
+        readinessProbe:
+          httpGet:
+            path: /healthz
+`
+
+func writeLintTestFile(t *testing.T, content string) string {
+	file := filepath.Join(t.TempDir(), "services.yaml")
+	assert.NilError(t, os.WriteFile(file, []byte(content), 0600))
+	return file
+}
+
+func executeServiceLintCommand(client knclient.KnServingClient, kubeClient kubernetes.Interface, args ...string) (string, error) {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+	knParams.NewServingClient = func(namespace string) (knclient.KnServingClient, error) {
+		return client, nil
+	}
+	knParams.NewKubeClient = func() (kubernetes.Interface, error) {
+		return kubeClient, nil
+	}
+	output := new(bytes.Buffer)
+	cmd := NewServiceCommand(knParams)
+	cmd.SetArgs(append([]string{"lint"}, args...))
+	cmd.SetOutput(output)
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		return knflags.ReconcileBoolFlags(cmd.Flags())
+	}
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+func TestServiceLintFile(t *testing.T) {
+	file := writeLintTestFile(t, lintTestServices)
+
+	output, err := executeServiceCommand(knclient.NewMockKnServiceClient(t), "lint", "-f", file)
+	assert.ErrorContains(t, err, "linting found 1 error(s)")
+	lines := strings.Split(output, "\n")
+	assert.Assert(t, util.ContainsAll(lines[0], "SERVICE", "SEVERITY", "RULE", "MESSAGE"))
+	assert.Assert(t, util.ContainsAll(output, "web", "latest-image", "readiness-probe", "resource-requests", "secret-env", "'API_KEY'"))
+	assert.Assert(t, util.ContainsAll(output, "error", "timeout", "900 seconds"))
+	assert.Assert(t, util.ContainsNone(output, "worker", "settings"))
+	assert.Assert(t, util.ContainsAll(output, "5 problem(s) found, 1 of them error(s)."))
+
+	// The maximum timeout depends on the cluster
+	output, err = executeServiceCommand(knclient.NewMockKnServiceClient(t), "lint", "-f", file, "--max-timeout", "1200")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsNone(output, "timeout"))
+}
+
+func TestServiceLintFileConfig(t *testing.T) {
+	oldConfig := config.GlobalConfig
+	config.GlobalConfig = &config.TestConfig{TestLintDisabledRules: []string{"readiness-probe", "resource-requests", "secret-env", "timeout"}}
+	defer func() { config.GlobalConfig = oldConfig }()
+
+	file := writeLintTestFile(t, lintTestServices)
+	output, err := executeServiceCommand(knclient.NewMockKnServiceClient(t), "lint", "-f", file, "-o", "json")
+	assert.NilError(t, err)
+	var findings []lint.Finding
+	assert.NilError(t, json.Unmarshal([]byte(output), &findings))
+	assert.DeepEqual(t, findings, []lint.Finding{{
+		Service:  "web",
+		Rule:     "latest-image",
+		Severity: lint.SeverityWarning,
+		Message:  "container uses image 'gcr.io/foo/web:latest' which is neither pinned to a digest nor to a version tag",
+	}})
+
+	output, err = executeServiceCommand(knclient.NewMockKnServiceClient(t), "lint", "-f", file, "-o", "sarif")
+	assert.NilError(t, err)
+	var sarif lint.SARIFLog
+	assert.NilError(t, json.Unmarshal([]byte(output), &sarif))
+	assert.Assert(t, strings.Contains(output, `"$schema"`))
+	assert.Equal(t, len(sarif.Runs[0].Tool.Driver.Rules), 3)
+	assert.Equal(t, sarif.Runs[0].Results[0].RuleID, "latest-image")
+	assert.Equal(t, sarif.Runs[0].Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI, file)
+}
+
+func TestServiceLintMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := createServiceWithImage("foo", "gcr.io/foo/bar:v1")
+	service.Annotations = map[string]string{lint.DisableAnnotationKey: "resource-requests"}
+	r.GetService("foo", service, nil)
+	output, err := executeServiceLintCommand(client, kubefake.NewSimpleClientset(), "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "foo", "warning", "readiness-probe"))
+
+	service = createServiceWithImage("foo", "gcr.io/foo/bar:v1")
+	r.GetService("foo", service, nil)
+	output, err = executeServiceLintCommand(client, kubefake.NewSimpleClientset(), "foo", "-o", "json")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, `"rule": "resource-requests"`, `"rule": "readiness-probe"`))
+
+	r.Validate()
+}
+
+func TestServiceLintClusterMaxTimeout(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := createServiceWithImage("foo", "gcr.io/foo/bar:v1")
+	service.Spec.Template.Spec.TimeoutSeconds = ptr.Int64(900)
+	configDefaults := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "config-defaults", Namespace: "knative-serving"},
+		Data:       map[string]string{"max-revision-timeout-seconds": "1200"},
+	}
+
+	// The maximum timeout of the cluster takes precedence
+	r.GetService("foo", service, nil)
+	output, err := executeServiceLintCommand(client, kubefake.NewSimpleClientset(configDefaults), "foo", "--max-timeout", "300")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsNone(output, "exceeds the maximum"))
+
+	// Without access to the ConfigMap the flag is used
+	forbidden := kubefake.NewSimpleClientset(configDefaults)
+	forbidden.PrependReactor("get", "configmaps", func(a clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(corev1.Resource("configmaps"), "config-defaults", nil)
+	})
+	r.GetService("foo", service, nil)
+	_, err = executeServiceLintCommand(client, forbidden, "foo")
+	assert.ErrorContains(t, err, "linting found 1 error(s)")
+	r.GetService("foo", service, nil)
+	_, err = executeServiceLintCommand(client, forbidden, "foo", "--max-timeout", "1200")
+	assert.NilError(t, err)
+
+	r.Validate()
+}
+
+func TestServiceLintErrors(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+
+	_, err := executeServiceCommand(client, "lint")
+	assert.ErrorContains(t, err, "requires either the service name")
+	_, err = executeServiceCommand(client, "lint", "foo", "-f", "services.yaml")
+	assert.ErrorContains(t, err, "requires either the service name")
+	_, err = executeServiceCommand(client, "lint", "foo", "-o", "yaml")
+	assert.ErrorContains(t, err, "invalid value 'yaml' for output flag")
+
+	file := writeLintTestFile(t, "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\n")
+	_, err = executeServiceCommand(client, "lint", "-f", file)
+	assert.ErrorContains(t, err, "no service found")
+}
@@ -15,11 +15,11 @@
 package service
 
 import (
-	"context"
 	"fmt"
 	"sort"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"knative.dev/client/pkg/commands"
@@ -30,6 +30,7 @@ import (
 // NewServiceListCommand represents 'kn service list' command
 func NewServiceListCommand(p *commands.KnParams) *cobra.Command {
 	serviceListFlags := flags.NewListPrintFlags(ServiceListHandlers)
+	var watchList bool
 
 	serviceListCommand := &cobra.Command{
 		Use:     "list",
@@ -45,6 +46,9 @@ func NewServiceListCommand(p *commands.KnParams) *cobra.Command {
   # List service 'web'
   kn service list web
 
+  # List all services and keep the list updated when they change
+  kn service list --watch
+
   # List the services in offline mode instead of kubernetes cluster (Beta)
   kn service list --target=/user/knfiles
   kn service list --target=/user/knfiles/test.json
@@ -52,6 +56,11 @@ func NewServiceListCommand(p *commands.KnParams) *cobra.Command {
   kn service list -n test-ns --target=/user/knfiles`,
 
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if watchList {
+				if err := serviceListFlags.ValidateWatch(); err != nil {
+					return err
+				}
+			}
 			namespace, err := p.GetNamespace(cmd)
 			if err != nil {
 				return err
@@ -60,13 +69,17 @@ func NewServiceListCommand(p *commands.KnParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			serviceList, err := getServiceInfo(cmd.Context(), args, client)
+			listConfig, err := serviceListConfig(args)
+			if err != nil {
+				return err
+			}
+			serviceList, err := client.ListServices(cmd.Context(), listConfig...)
 			if err != nil {
 				return err
 			}
 
 			// Stop if nothing found
-			if !serviceListFlags.GenericPrintFlags.OutputFlagSpecified() && len(serviceList.Items) == 0 {
+			if !watchList && !serviceListFlags.GenericPrintFlags.OutputFlagSpecified() && len(serviceList.Items) == 0 {
 				fmt.Fprintf(cmd.OutOrStdout(), "No services found.\n")
 				return nil
 			}
@@ -76,38 +89,55 @@ func NewServiceListCommand(p *commands.KnParams) *cobra.Command {
 				serviceListFlags.EnsureWithNamespace()
 			}
 
-			// Sort serviceList by namespace and name (in this order)
-			sort.SliceStable(serviceList.Items, func(i, j int) bool {
-				a := serviceList.Items[i]
-				b := serviceList.Items[j]
+			sortServices(serviceList)
 
-				if a.Namespace != b.Namespace {
-					return a.Namespace < b.Namespace
+			if watchList {
+				watcher, err := client.WatchServices(cmd.Context(), serviceList.ResourceVersion, listConfig...)
+				if err != nil {
+					return err
 				}
-				return a.ObjectMeta.Name < b.ObjectMeta.Name
-			})
-
+				defer watcher.Stop()
+				return serviceListFlags.PrintWatch(cmd.Context(), flags.ListWatch{
+					List:  serviceList,
+					Watch: watcher,
+					Prepare: func(list runtime.Object) error {
+						sortServices(list.(*servingv1.ServiceList))
+						return nil
+					},
+				}, cmd.OutOrStdout())
+			}
 			return serviceListFlags.Print(serviceList, cmd.OutOrStdout())
 		},
 	}
 	commands.AddNamespaceFlags(serviceListCommand.Flags(), true)
 	commands.AddGitOpsFlags(serviceListCommand.Flags())
 	serviceListFlags.AddFlags(serviceListCommand)
+	serviceListCommand.Flags().BoolVarP(&watchList, "watch", "w", false,
+		"Keep the list open and update it when services change, until interrupted. "+
+			"Together with '-o json' every change is printed as a watch event in a single line.")
 	return serviceListCommand
 }
 
-func getServiceInfo(ctx context.Context, args []string, client clientservingv1.KnServingClient) (*servingv1.ServiceList, error) {
-	var (
-		serviceList *servingv1.ServiceList
-		err         error
-	)
+func serviceListConfig(args []string) ([]clientservingv1.ListConfig, error) {
 	switch len(args) {
 	case 0:
-		serviceList, err = client.ListServices(ctx)
+		return nil, nil
 	case 1:
-		serviceList, err = client.ListServices(ctx, clientservingv1.WithName(args[0]))
+		return []clientservingv1.ListConfig{clientservingv1.WithName(args[0])}, nil
 	default:
 		return nil, fmt.Errorf("'kn service list' accepts maximum 1 argument")
 	}
-	return serviceList, err
+}
+
+// sortServices sorts services by namespace and name (in this order)
+func sortServices(serviceList *servingv1.ServiceList) {
+	sort.SliceStable(serviceList.Items, func(i, j int) bool {
+		a := serviceList.Items[i]
+		b := serviceList.Items[j]
+
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.ObjectMeta.Name < b.ObjectMeta.Name
+	})
 }
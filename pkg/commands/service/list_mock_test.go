@@ -19,11 +19,13 @@ import (
 	"testing"
 
 	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/watch"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
 	"knative.dev/client/pkg/util"
 	"knative.dev/client/pkg/util/mock"
+	"knative.dev/client/pkg/wait"
 )
 
 func TestServiceListAllNamespaceMock(t *testing.T) {
@@ -181,6 +183,56 @@ func TestServiceListWithTwoSrvNameAliasMock(t *testing.T) {
 	r.Validate()
 }
 
+func TestServiceListWatchMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service1 := createMockServiceWithParams("foo", "default", "http://foo.default.example.com", "foo-xyz")
+	serviceList := &servingv1.ServiceList{Items: []servingv1.Service{*service1}}
+	serviceList.ResourceVersion = "42"
+	r.ListServices(mock.Any(), serviceList, nil)
+	service2 := createMockServiceWithParams("bar", "default", "http://bar.default.example.com", "bar-xyz")
+	updated := createMockServiceWithParams("foo", "default", "http://foo.default.example.com", "foo-abc")
+	fakeWatch := wait.NewFakeWatch([]watch.Event{
+		{Type: watch.Added, Object: service2},
+		{Type: watch.Modified, Object: updated},
+	})
+	fakeWatch.StartAndClose()
+	r.WatchServices("42", mock.Any(), fakeWatch, nil)
+
+	output, err := executeServiceCommand(client, "list", "--watch")
+	assert.NilError(t, err)
+	outputLines := strings.Split(output, "\n")
+	assert.Check(t, util.ContainsAll(outputLines[0], "NAME", "URL", "LATEST"))
+	assert.Check(t, util.ContainsAll(outputLines[1], "foo", "foo-xyz"))
+	assert.Check(t, util.ContainsAll(outputLines[2], "bar", "bar-xyz"))
+	assert.Check(t, util.ContainsAll(outputLines[3], "foo", "foo-abc"))
+	assert.Equal(t, fakeWatch.StopCalled, 1)
+
+	r.Validate()
+}
+
+func TestServiceListWatchEmptyJSONMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.ListServices(mock.Any(), &servingv1.ServiceList{}, nil)
+	service := createMockServiceWithParams("foo", "default", "http://foo.default.example.com", "foo-xyz")
+	fakeWatch := wait.NewFakeWatch([]watch.Event{{Type: watch.Added, Object: service}})
+	fakeWatch.StartAndClose()
+	r.WatchServices("", mock.Any(), fakeWatch, nil)
+
+	output, err := executeServiceCommand(client, "list", "-w", "-o", "json")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsNone(output, "No services found"))
+	assert.Assert(t, util.ContainsAll(output, `{"type":"ADDED","object":{`, `"name":"foo"`))
+
+	_, err = executeServiceCommand(client, "list", "-w", "-o", "yaml")
+	assert.ErrorContains(t, err, "--watch supports only the default output format and '-o json'")
+
+	r.Validate()
+}
+
 func getServiceWithNamespace(name, namespace string) *servingv1.Service {
 	service := servingv1.Service{}
 	service.Name = name
@@ -0,0 +1,360 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"knative.dev/serving/pkg/apis/serving"
+
+	"knative.dev/client/pkg/commands"
+)
+
+const (
+	// Name of the sidecar container injected by Knative Serving into every revision pod
+	queueProxyContainerName = "queue-proxy"
+
+	// Special values for --container
+	containerSelectorUser = "user"
+	containerSelectorAll  = "all"
+)
+
+var logsExample = `
+  # Print the logs of all user containers of all pods of service 'svc'
+  kn service logs svc
+
+  # Follow the logs of revision 'svc-00002', including pods which are scaled up later
+  kn service logs svc --revision svc-00002 --follow
+
+  # Print the logs of the queue-proxy sidecar written in the last 10 minutes
+  kn service logs svc --container queue-proxy --since 10m
+
+  # Print the logs of an extra container 'sidecar' added with --containers
+  kn service logs svc --container sidecar`
+
+// logsFlags holds the flags for 'kn service logs'
+type logsFlags struct {
+	revision  string
+	container string
+	since     time.Duration
+	follow    bool
+}
+
+// NewServiceLogsCommand represents 'kn service logs' command
+func NewServiceLogsCommand(p *commands.KnParams) *cobra.Command {
+	var logsFlags logsFlags
+	command := &cobra.Command{
+		Use:               "logs NAME",
+		Short:             "Print the container logs of a service",
+		Example:           logsExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service logs' requires the service name given as single argument")
+			}
+			if logsFlags.since < 0 {
+				return fmt.Errorf("invalid value for --since: %s, must not be negative", logsFlags.since)
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewKubeClient()
+			if err != nil {
+				return err
+			}
+
+			streamer := &logStreamer{
+				client:    client,
+				namespace: namespace,
+				flags:     logsFlags,
+				out:       cmd.OutOrStdout(),
+				streamed:  make(map[string]bool),
+			}
+			return streamer.run(cmd.Context(), args[0])
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.StringVar(&logsFlags.revision, "revision", "", "Only print the logs of pods belonging to the given revision.")
+	flags.StringVarP(&logsFlags.container, "container", "c", containerSelectorUser,
+		"Container to print the logs of. Either the name of a container, '"+containerSelectorUser+"' for all user containers "+
+			"(including the ones added with --containers), '"+queueProxyContainerName+"' for the Knative sidecar or '"+containerSelectorAll+"' for all containers.")
+	flags.DurationVar(&logsFlags.since, "since", 0, "Only print logs newer than a relative duration like 5s, 2m, or 3h. Defaults to all logs.")
+	flags.BoolVarP(&logsFlags.follow, "follow", "f", false, "Stream the logs and pick up pods which are started later on, e.g. when the service scales up.")
+	return command
+}
+
+// logStreamer fetches the logs of all pods of a service and multiplexes
+// them line by line to a single writer
+type logStreamer struct {
+	client    kubernetes.Interface
+	namespace string
+	flags     logsFlags
+
+	// guards out and streamed
+	mu       sync.Mutex
+	out      io.Writer
+	streamed map[string]bool
+
+	wg sync.WaitGroup
+}
+
+func (s *logStreamer) run(ctx context.Context, serviceName string) error {
+	selector := labels.Set{serving.ServiceLabelKey: serviceName}
+	if s.flags.revision != "" {
+		selector[serving.RevisionLabelKey] = s.flags.revision
+	}
+	listOptions := metav1.ListOptions{LabelSelector: selector.String()}
+
+	podList, err := s.client.CoreV1().Pods(s.namespace).List(ctx, listOptions)
+	if err != nil {
+		return err
+	}
+	pods := podList.Items
+	sortPods(pods)
+
+	if !s.flags.follow {
+		return s.printLogs(ctx, serviceName, pods)
+	}
+
+	if len(pods) == 0 {
+		s.println(fmt.Sprintf("No pods found for service '%s' in namespace '%s', waiting for pods to be started ...", serviceName, s.namespace))
+	}
+	for i := range pods {
+		s.followPod(ctx, &pods[i])
+	}
+	err = s.watchPods(ctx, listOptions, podList.ResourceVersion)
+	s.wg.Wait()
+	return err
+}
+
+// printLogs prints out the logs of the given pods one after each other
+func (s *logStreamer) printLogs(ctx context.Context, serviceName string, pods []corev1.Pod) error {
+	found := false
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Status.Phase == corev1.PodPending || pod.Status.Phase == corev1.PodUnknown {
+			continue
+		}
+		for _, container := range s.selectContainers(pod) {
+			found = true
+			if err := s.stream(ctx, pod, container); err != nil {
+				return err
+			}
+		}
+	}
+	if !found {
+		if s.isContainerName() {
+			return fmt.Errorf("no running pods with container '%s' found for service '%s' in namespace '%s'", s.flags.container, serviceName, s.namespace)
+		}
+		s.println(fmt.Sprintf("No running pods found for service '%s' in namespace '%s'.", serviceName, s.namespace))
+	}
+	return nil
+}
+
+// watchPods watches for pod changes and starts streaming logs of pods that become ready.
+// It returns when the given context is done.
+func (s *logStreamer) watchPods(ctx context.Context, listOptions metav1.ListOptions, resourceVersion string) error {
+	for {
+		opts := listOptions
+		opts.ResourceVersion = resourceVersion
+		watcher, err := s.client.CoreV1().Pods(s.namespace).Watch(ctx, opts)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		resourceVersion, err = s.handlePodEvents(ctx, watcher, resourceVersion)
+		watcher.Stop()
+		if err != nil || ctx.Err() != nil {
+			return err
+		}
+	}
+}
+
+// handlePodEvents consumes events until the watch is closed or the context is done
+// and returns the last seen resource version
+func (s *logStreamer) handlePodEvents(ctx context.Context, watcher watch.Interface, resourceVersion string) (string, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return resourceVersion, nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return resourceVersion, nil
+			}
+			switch event.Type {
+			case watch.Error:
+				// Let the caller start over again with a fresh watch
+				return "", nil
+			case watch.Added, watch.Modified:
+				pod, ok := event.Object.(*corev1.Pod)
+				if !ok {
+					continue
+				}
+				resourceVersion = pod.ResourceVersion
+				s.followPod(ctx, pod)
+			}
+		}
+	}
+}
+
+// followPod starts streaming the logs of the selected running containers of a
+// pod in the background. Containers which are already streamed are skipped. A
+// container is streamed again when it has been restarted after its stream ended.
+func (s *logStreamer) followPod(ctx context.Context, pod *corev1.Pod) {
+	if pod.Status.Phase != corev1.PodRunning {
+		return
+	}
+	for _, container := range s.selectContainers(pod) {
+		if !isContainerRunning(pod, container) {
+			continue
+		}
+		key := pod.Name + "/" + container
+		s.mu.Lock()
+		alreadyStreamed := s.streamed[key]
+		s.streamed[key] = true
+		s.mu.Unlock()
+		if alreadyStreamed {
+			continue
+		}
+
+		s.wg.Add(1)
+		go func(pod *corev1.Pod, container, key string) {
+			defer s.wg.Done()
+			if err := s.stream(ctx, pod, container); err != nil && ctx.Err() == nil {
+				s.println(fmt.Sprintf("%sError: %v", logPrefix(pod, container), err))
+			}
+			s.mu.Lock()
+			delete(s.streamed, key)
+			s.mu.Unlock()
+		}(pod.DeepCopy(), container, key)
+	}
+}
+
+// isContainerRunning returns whether the status of the pod reports the given container as running
+func isContainerRunning(pod *corev1.Pod, container string) bool {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == container {
+			return status.State.Running != nil
+		}
+	}
+	return false
+}
+
+// stream copies the logs of a single container to the output, prefixing each line
+func (s *logStreamer) stream(ctx context.Context, pod *corev1.Pod, container string) error {
+	logOptions := &corev1.PodLogOptions{
+		Container: container,
+		Follow:    s.flags.follow,
+	}
+	if s.flags.since > 0 {
+		sinceSeconds := int64((s.flags.since + time.Second - 1) / time.Second)
+		logOptions.SinceSeconds = &sinceSeconds
+	}
+	reader, err := s.client.CoreV1().Pods(s.namespace).GetLogs(pod.Name, logOptions).Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get logs of container '%s' in pod '%s': %w", container, pod.Name, err)
+	}
+	defer reader.Close()
+
+	if err := s.printLines(logPrefix(pod, container), reader); err != nil && ctx.Err() == nil {
+		return err
+	}
+	return nil
+}
+
+// printLines prints the lines read from the reader with the given prefix. Lines can
+// be of arbitrary length, e.g. for stack traces logged as single JSON object.
+func (s *logStreamer) printLines(prefix string, reader io.Reader) error {
+	lineReader := bufio.NewReader(reader)
+	for {
+		line, err := lineReader.ReadString('\n')
+		if line != "" {
+			s.println(prefix + strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r"))
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// selectContainers returns the names of the containers of the given pod which match
+// the --container flag
+func (s *logStreamer) selectContainers(pod *corev1.Pod) []string {
+	var names []string
+	for _, container := range pod.Spec.Containers {
+		switch s.flags.container {
+		case containerSelectorAll:
+			names = append(names, container.Name)
+		case containerSelectorUser:
+			if container.Name != queueProxyContainerName {
+				names = append(names, container.Name)
+			}
+		default:
+			if container.Name == s.flags.container {
+				names = append(names, container.Name)
+			}
+		}
+	}
+	return names
+}
+
+func (s *logStreamer) isContainerName() bool {
+	return s.flags.container != containerSelectorUser && s.flags.container != containerSelectorAll
+}
+
+func (s *logStreamer) println(line string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fmt.Fprintln(s.out, line)
+}
+
+func logPrefix(pod *corev1.Pod, container string) string {
+	revision := pod.Labels[serving.RevisionLabelKey]
+	return fmt.Sprintf("[%s/%s/%s] ", revision, pod.Name, container)
+}
+
+// sortPods sorts pods by revision and name (in this order)
+func sortPods(pods []corev1.Pod) {
+	sort.SliceStable(pods, func(i, j int) bool {
+		a, b := pods[i], pods[j]
+		aRev, bRev := a.Labels[serving.RevisionLabelKey], b.Labels[serving.RevisionLabelKey]
+		if aRev != bRev {
+			return aRev < bRev
+		}
+		return a.Name < b.Name
+	})
+}
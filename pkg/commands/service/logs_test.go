@@ -0,0 +1,281 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"knative.dev/serving/pkg/apis/serving"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/util"
+)
+
+// syncBuffer is a bytes.Buffer which can be written and read concurrently
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func executeServiceLogsCommand(ctx context.Context, client kubernetes.Interface, output *syncBuffer, args ...string) error {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+	knParams.NewKubeClient = func() (kubernetes.Interface, error) {
+		return client, nil
+	}
+	cmd := NewServiceCommand(knParams)
+	cmd.SetArgs(append([]string{"logs"}, args...))
+	cmd.SetOut(output)
+	return cmd.ExecuteContext(ctx)
+}
+
+func TestServiceLogs(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		createTestPod("foo", "foo-00002", "foo-00002-deployment-b", corev1.PodRunning, "user-container", queueProxyContainerName),
+		createTestPod("foo", "foo-00001", "foo-00001-deployment-a", corev1.PodRunning, "user-container", "sidecar", queueProxyContainerName),
+		createTestPod("foo", "foo-00001", "foo-00001-deployment-p", corev1.PodPending, "user-container", queueProxyContainerName),
+		createTestPod("bar", "bar-00001", "bar-00001-deployment-a", corev1.PodRunning, "user-container", queueProxyContainerName))
+
+	output := &syncBuffer{}
+	err := executeServiceLogsCommand(context.Background(), client, output, "foo")
+	assert.NilError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	assert.DeepEqual(t, lines, []string{
+		"[foo-00001/foo-00001-deployment-a/user-container] fake logs",
+		"[foo-00001/foo-00001-deployment-a/sidecar] fake logs",
+		"[foo-00002/foo-00002-deployment-b/user-container] fake logs",
+	})
+}
+
+func TestServiceLogsContainerSelection(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		createTestPod("foo", "foo-00001", "foo-00001-deployment-a", corev1.PodRunning, "user-container", "sidecar", queueProxyContainerName))
+
+	for _, tc := range []struct {
+		container string
+		expected  []string
+	}{
+		{queueProxyContainerName, []string{queueProxyContainerName}},
+		{"sidecar", []string{"sidecar"}},
+		{"all", []string{"user-container", "sidecar", queueProxyContainerName}},
+	} {
+		t.Run(tc.container, func(t *testing.T) {
+			output := &syncBuffer{}
+			err := executeServiceLogsCommand(context.Background(), client, output, "foo", "--container", tc.container)
+			assert.NilError(t, err)
+			lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+			assert.Equal(t, len(lines), len(tc.expected))
+			for i, container := range tc.expected {
+				assert.Equal(t, lines[i], "[foo-00001/foo-00001-deployment-a/"+container+"] fake logs")
+			}
+		})
+	}
+
+	output := &syncBuffer{}
+	err := executeServiceLogsCommand(context.Background(), client, output, "foo", "--container", "unknown")
+	assert.ErrorContains(t, err, "no running pods with container 'unknown'")
+}
+
+func TestServiceLogsRevisionAndSince(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		createTestPod("foo", "foo-00001", "foo-00001-deployment-a", corev1.PodRunning, "user-container"),
+		createTestPod("foo", "foo-00002", "foo-00002-deployment-b", corev1.PodRunning, "user-container"))
+
+	output := &syncBuffer{}
+	err := executeServiceLogsCommand(context.Background(), client, output, "foo", "--revision", "foo-00002", "--since", "90s")
+	assert.NilError(t, err)
+	assert.Equal(t, strings.TrimSpace(output.String()), "[foo-00002/foo-00002-deployment-b/user-container] fake logs")
+
+	var logOptions *corev1.PodLogOptions
+	for _, action := range client.Actions() {
+		if action.GetSubresource() == "log" {
+			logOptions = action.(clienttesting.GenericAction).GetValue().(*corev1.PodLogOptions)
+		}
+	}
+	assert.Assert(t, logOptions != nil)
+	assert.Equal(t, *logOptions.SinceSeconds, int64(90))
+	assert.Equal(t, logOptions.Follow, false)
+}
+
+func TestServiceLogsNoPods(t *testing.T) {
+	output := &syncBuffer{}
+	err := executeServiceLogsCommand(context.Background(), fake.NewSimpleClientset(), output, "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output.String(), "No running pods", "foo", "default"))
+}
+
+func TestServiceLogsErrors(t *testing.T) {
+	output := &syncBuffer{}
+	err := executeServiceLogsCommand(context.Background(), fake.NewSimpleClientset(), output)
+	assert.ErrorContains(t, err, "single argument")
+
+	err = executeServiceLogsCommand(context.Background(), fake.NewSimpleClientset(), output, "foo", "--since", "-1m")
+	assert.ErrorContains(t, err, "--since")
+}
+
+func TestServiceLogsFollowPicksUpNewPods(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		createTestPod("foo", "foo-00001", "foo-00001-deployment-a", corev1.PodRunning, "user-container"))
+	fakeWatch := watch.NewFake()
+	client.PrependWatchReactor("pods", func(action clienttesting.Action) (bool, watch.Interface, error) {
+		return true, fakeWatch, nil
+	})
+
+	// Logs are returned only when released, so that the streams are still open meanwhile
+	release := make(chan struct{})
+	client.PrependReactor("get", "pods", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() == "log" {
+			<-release
+		}
+		return false, nil, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	output := &syncBuffer{}
+	errChan := make(chan error)
+	go func() {
+		errChan <- executeServiceLogsCommand(ctx, client, output, "foo", "--follow")
+	}()
+
+	// Pending pods are picked up as soon as they are running
+	pending := createTestPod("foo", "foo-00001", "foo-00001-deployment-b", corev1.PodPending, "user-container")
+	fakeWatch.Add(pending)
+	running := setContainerState(pending, corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}, 0)
+	running.Status.Phase = corev1.PodRunning
+	fakeWatch.Modify(running)
+	// Already streamed pods are not streamed again
+	fakeWatch.Modify(running)
+	fakeWatch.Modify(running)
+	close(release)
+
+	waitForOutput(t, output, "foo-00001-deployment-a", "foo-00001-deployment-b")
+	cancel()
+	assert.NilError(t, <-errChan)
+	assert.Equal(t, strings.Count(output.String(), "foo-00001-deployment-b"), 1)
+}
+
+func TestServiceLogsFollowRestartedContainers(t *testing.T) {
+	running := createTestPod("foo", "foo-00001", "foo-00001-deployment-a", corev1.PodRunning, "user-container")
+	client := fake.NewSimpleClientset(running)
+	fakeWatch := watch.NewFake()
+	client.PrependWatchReactor("pods", func(action clienttesting.Action) (bool, watch.Interface, error) {
+		return true, fakeWatch, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	output := &syncBuffer{}
+	errChan := make(chan error)
+	go func() {
+		errChan <- executeServiceLogsCommand(ctx, client, output, "foo", "--follow")
+	}()
+	waitForOutput(t, output, "foo-00001-deployment-a")
+
+	// The stream has ended with the container, terminated containers are not streamed
+	fakeWatch.Modify(setContainerState(running, corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}}, 0))
+	// but the restarted container is followed again
+	fakeWatch.Modify(setContainerState(running, corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}, 1))
+
+	for i := 0; i < 100 && strings.Count(output.String(), "foo-00001-deployment-a") < 2; i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+	cancel()
+	assert.NilError(t, <-errChan)
+	assert.Equal(t, strings.Count(output.String(), "foo-00001-deployment-a"), 2)
+}
+
+func TestServiceLogsLongLines(t *testing.T) {
+	output := &syncBuffer{}
+	streamer := &logStreamer{out: output}
+	long := strings.Repeat("x", 1024*1024)
+	err := streamer.printLines("[pod] ", strings.NewReader("first\r\n"+long+"\nlast"))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, strings.Split(strings.TrimSpace(output.String()), "\n"), []string{"[pod] first", "[pod] " + long, "[pod] last"})
+}
+
+func waitForOutput(t *testing.T, output *syncBuffer, expected ...string) {
+	for i := 0; i < 100; i++ {
+		if util.ContainsAll(output.String(), expected...)().Success() {
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	t.Fatalf("expected output to contain %v, got:\n%s", expected, output.String())
+}
+
+func createTestPod(service, revision, name string, phase corev1.PodPhase, containers ...string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels: map[string]string{
+				serving.ServiceLabelKey:  service,
+				serving.RevisionLabelKey: revision,
+			},
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+	for _, container := range containers {
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: container})
+		if phase == corev1.PodRunning {
+			pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, corev1.ContainerStatus{
+				Name:  container,
+				State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			})
+		}
+	}
+	return pod
+}
+
+// setContainerState sets the state of all containers of the pod
+func setContainerState(pod *corev1.Pod, state corev1.ContainerState, restartCount int32) *corev1.Pod {
+	pod = pod.DeepCopy()
+	pod.Status.ContainerStatuses = nil
+	for _, container := range pod.Spec.Containers {
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, corev1.ContainerStatus{
+			Name:         container.Name,
+			State:        state,
+			RestartCount: restartCount,
+		})
+	}
+	return pod
+}
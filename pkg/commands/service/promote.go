@@ -0,0 +1,215 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/config"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+var promoteExample = `
+  # Route all traffic of service 'svc' to the revision tagged with 'candidate'
+  kn service promote svc --tag candidate
+
+  # Blue/green deployment: route all traffic to the revision tagged with 'green' and
+  # move the tag 'green' to the revision which served the traffic before. If this
+  # revision has a tag, e.g. 'blue', this tag moves to the promoted revision.
+  kn service promote svc --tag green --swap`
+
+// NewServicePromoteCommand represents 'kn service promote' command
+func NewServicePromoteCommand(p *commands.KnParams) *cobra.Command {
+	var waitFlags commands.WaitFlags
+	var tag string
+	var swap bool
+
+	command := &cobra.Command{
+		Use:   "promote NAME --tag TAG",
+		Short: "Route all traffic of a service to a tagged revision",
+		Long: `Route all traffic of a service to a tagged revision
+
+The tagged revision has to be ready and receives all traffic of the service with a single
+update. Traffic tags are kept but do not receive any traffic anymore. With --swap, the tag
+of the promoted revision and the tag of the revision which received most of the traffic
+before are exchanged.
+
+When waiting for the service, the traffic is reverted if the service doesn't become ready.`,
+		Example:           promoteExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service promote' requires the service name given as single argument")
+			}
+			if tag == "" {
+				return errors.New("'service promote' requires the tag of the revision to promote given with --tag")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			service, err := client.GetService(cmd.Context(), name)
+			if err != nil {
+				return err
+			}
+			candidate := revisionOfTag(service, tag)
+			if candidate == "" {
+				return fmt.Errorf("tag '%s' not found in the traffic of service '%s' in namespace '%s'", tag, name, namespace)
+			}
+			revision, err := client.GetRevision(cmd.Context(), candidate)
+			if err != nil {
+				return err
+			}
+			if !revision.IsReady() {
+				return fmt.Errorf("revision '%s' with tag '%s' is not ready, refusing to promote it", candidate, tag)
+			}
+			stable := mostRoutedRevision(service, candidate)
+			if swap && stable == "" {
+				return fmt.Errorf("revision '%s' already receives all traffic of service '%s', there is no revision to swap tags with", candidate, name)
+			}
+
+			var originalTraffic []servingv1.TrafficTarget
+			updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
+				originalTraffic = service.DeepCopy().Spec.Traffic
+				service.Spec.Traffic = promoteTraffic(service, tag, candidate, stable, swap)
+				return service, nil
+			}
+			changed, err := client.UpdateServiceWithRetry(cmd.Context(), name, updateFunc, config.DefaultRetry.Steps)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if !changed {
+				fmt.Fprintf(out, "Service '%s' in namespace '%s' already routes all traffic to revision '%s'.\n", name, namespace, candidate)
+				return nil
+			}
+			if !waitFlags.Wait {
+				fmt.Fprintf(out, "Service '%s' promoted revision '%s' with tag '%s' in namespace '%s'.\n", name, candidate, tag, namespace)
+				return nil
+			}
+
+			fmt.Fprintf(out, "Promoting revision '%s' with tag '%s' of service '%s' in namespace '%s':\n", candidate, tag, name, namespace)
+			fmt.Fprintln(out, "")
+			wconfig := clientservingv1.WaitConfig{
+				Timeout:     time.Duration(waitFlags.TimeoutInSeconds) * time.Second,
+				ErrorWindow: time.Duration(waitFlags.ErrorWindowInSeconds) * time.Second,
+			}
+			if err := waitForService(cmd.Context(), client, name, out, wconfig); err != nil {
+				fmt.Fprintln(out, "")
+				fmt.Fprintf(out, "Promotion failed: %v\n", err)
+				fmt.Fprintf(out, "Reverting traffic of service '%s' ...\n", name)
+				return revertTraffic(cmd.Context(), client, name, originalTraffic, "promotion", err, out, wconfig)
+			}
+			fmt.Fprintln(out, "")
+
+			service, err = client.GetService(cmd.Context(), name)
+			if err != nil {
+				return fmt.Errorf("cannot fetch service '%s' in namespace '%s' for extracting the URL: %w", name, namespace, err)
+			}
+			fmt.Fprintf(out, "Service '%s' promoted revision '%s' and is available at URL:\n%s\n", name, candidate, service.Status.URL.String())
+			return nil
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.StringVar(&tag, "tag", "", "Tag of the revision to promote.")
+	flags.BoolVar(&swap, "swap", false,
+		"Swap the tag of the promoted revision with the tag of the revision which received most of the traffic before, "+
+			"e.g. for blue/green deployments. If that revision has no tag, it gets the tag of the promoted revision.")
+	waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "promote", "service", "ready")
+	return command
+}
+
+// revisionOfTag returns the name of the revision which the given tag currently refers to
+func revisionOfTag(service *servingv1.Service, tag string) string {
+	for _, target := range service.Status.Traffic {
+		if target.Tag == tag {
+			return target.RevisionName
+		}
+	}
+	return ""
+}
+
+// promoteTraffic routes all traffic to the candidate revision. The tags which are moved
+// between revisions are pinned to the revisions they refer to.
+func promoteTraffic(service *servingv1.Service, tag, candidate, stable string, swap bool) []servingv1.TrafficTarget {
+	targets := service.DeepCopy().Spec.Traffic
+	stableTag := ""
+	for i := range targets {
+		target := &targets[i]
+		if target.Tag == "" {
+			continue
+		}
+		revision := target.RevisionName
+		if target.LatestRevision != nil && *target.LatestRevision {
+			revision = revisionOfTag(service, target.Tag)
+		}
+		switch {
+		case target.Tag == tag:
+			target.RevisionName = candidate
+			target.LatestRevision = ptr.Bool(false)
+		case swap && stableTag == "" && revision == stable:
+			stableTag = target.Tag
+			target.RevisionName = stable
+			target.LatestRevision = ptr.Bool(false)
+		}
+	}
+
+	targets = rollbackTraffic(targets, candidate)
+	if !swap {
+		return targets
+	}
+	for i := range targets {
+		switch targets[i].Tag {
+		case "":
+			continue
+		case tag:
+			targets[i].Tag = stableTag
+		case stableTag:
+			targets[i].Tag = tag
+		}
+	}
+	if stableTag == "" {
+		targets = append(targets, servingv1.TrafficTarget{
+			Tag:            tag,
+			RevisionName:   stable,
+			LatestRevision: ptr.Bool(false),
+			Percent:        ptr.Int64(0),
+		})
+	}
+	// Drop targets which lost their tag and don't receive traffic
+	result := make([]servingv1.TrafficTarget, 0, len(targets))
+	for _, target := range targets {
+		if target.Tag != "" || (target.Percent != nil && *target.Percent > 0) {
+			result = append(result, target)
+		}
+	}
+	return result
+}
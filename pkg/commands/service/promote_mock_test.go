@@ -0,0 +1,165 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+	"knative.dev/client/pkg/wait"
+)
+
+func TestServicePromoteMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := createServiceForPromote("foo")
+	revisions := createRevisionsForRollback("foo", 2)
+	r.GetService("foo", service, nil)
+	r.GetRevision("foo-00002", &revisions.Items[1], nil)
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, svc *servingv1.Service) {
+		assert.DeepEqual(t, svc.Spec.Traffic, []servingv1.TrafficTarget{
+			{Tag: "current", RevisionName: "foo-00001", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(0)},
+			{Tag: "candidate", RevisionName: "foo-00002", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)},
+		})
+	}, true, nil)
+
+	output, err := executeServiceCommand(client, "promote", "foo", "--tag", "candidate", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "promoted revision 'foo-00002'", "candidate", "default"))
+
+	r.Validate()
+}
+
+func TestServicePromoteSwapMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := createServiceForPromote("foo")
+	revisions := createRevisionsForRollback("foo", 2)
+	r.GetService("foo", service, nil)
+	r.GetRevision("foo-00002", &revisions.Items[1], nil)
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, svc *servingv1.Service) {
+		assert.DeepEqual(t, svc.Spec.Traffic, []servingv1.TrafficTarget{
+			{Tag: "candidate", RevisionName: "foo-00001", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(0)},
+			{Tag: "current", RevisionName: "foo-00002", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)},
+		})
+	}, true, nil)
+	r.WaitForService("foo", mock.Any(), wait.NoopMessageCallback(), nil, time.Second)
+	r.GetService("foo", getServiceWithUrl("foo", "http://foo.example.com"), nil)
+
+	output, err := executeServiceCommand(client, "promote", "foo", "--tag", "candidate", "--swap")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Promoting revision 'foo-00002'", "Ready to serve", "http://foo.example.com"))
+
+	r.Validate()
+}
+
+func TestServicePromoteRevertMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := createServiceForPromote("foo")
+	revisions := createRevisionsForRollback("foo", 2)
+	r.GetService("foo", service, nil)
+	r.GetRevision("foo-00002", &revisions.Items[1], nil)
+	r.GetService("foo", service, nil)
+	r.UpdateService(mock.Any(), true, nil)
+	r.WaitForService("foo", mock.Any(), wait.NoopMessageCallback(), errors.New("revision failed"), time.Second)
+	// Revert
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, svc *servingv1.Service) {
+		assert.DeepEqual(t, svc.Spec.Traffic, service.Spec.Traffic)
+	}, true, nil)
+	r.WaitForService("foo", mock.Any(), wait.NoopMessageCallback(), nil, time.Second)
+
+	output, err := executeServiceCommand(client, "promote", "foo", "--tag", "candidate")
+	assert.ErrorContains(t, err, "promotion of service 'foo' failed and traffic has been reverted: revision failed")
+	assert.Assert(t, util.ContainsAll(output, "Promotion failed", "Reverting traffic"))
+
+	r.Validate()
+}
+
+func TestServicePromoteErrorsMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	_, err := executeServiceCommand(client, "promote", "foo")
+	assert.ErrorContains(t, err, "requires the tag")
+
+	_, err = executeServiceCommand(client, "promote", "--tag", "candidate")
+	assert.ErrorContains(t, err, "requires the service name")
+
+	r.GetService("foo", createServiceForPromote("foo"), nil)
+	_, err = executeServiceCommand(client, "promote", "foo", "--tag", "unknown")
+	assert.ErrorContains(t, err, "tag 'unknown' not found")
+
+	notReady := createTestRevision("foo-00002", 2, unknownConditions())
+	r.GetService("foo", createServiceForPromote("foo"), nil)
+	r.GetRevision("foo-00002", &notReady, nil)
+	_, err = executeServiceCommand(client, "promote", "foo", "--tag", "candidate")
+	assert.ErrorContains(t, err, "is not ready")
+
+	revisions := createRevisionsForRollback("foo", 2)
+	r.GetService("foo", createServiceForPromote("foo"), nil)
+	r.GetRevision("foo-00001", &revisions.Items[0], nil)
+	_, err = executeServiceCommand(client, "promote", "foo", "--tag", "current", "--swap")
+	assert.ErrorContains(t, err, "there is no revision to swap tags with")
+
+	r.Validate()
+}
+
+func TestPromoteTraffic(t *testing.T) {
+	service := createServiceForPromote("foo")
+	// The candidate tag follows the latest revision, the current revision has no tag
+	service.Spec.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: "foo-00001", Percent: ptr.Int64(100)},
+		{Tag: "candidate", LatestRevision: ptr.Bool(true), Percent: ptr.Int64(0)},
+	}
+	service.Status.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: "foo-00001", Percent: ptr.Int64(100)},
+		{Tag: "candidate", RevisionName: "foo-00002", LatestRevision: ptr.Bool(true), Percent: ptr.Int64(0)},
+	}
+
+	assert.DeepEqual(t, promoteTraffic(service, "candidate", "foo-00002", "foo-00001", false), []servingv1.TrafficTarget{
+		{Tag: "candidate", RevisionName: "foo-00002", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)},
+	})
+	assert.DeepEqual(t, promoteTraffic(service, "candidate", "foo-00002", "foo-00001", true), []servingv1.TrafficTarget{
+		{RevisionName: "foo-00002", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)},
+		{Tag: "candidate", RevisionName: "foo-00001", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(0)},
+	})
+}
+
+// createServiceForPromote creates a service with revision 'foo-00001' tagged with 'current' receiving all
+// traffic and revision 'foo-00002' tagged with 'candidate'
+func createServiceForPromote(name string) *servingv1.Service {
+	service := createServiceWithImage(name, "gcr.io/foo/bar:latest")
+	service.Spec.Traffic = []servingv1.TrafficTarget{
+		{Tag: "current", RevisionName: name + "-00001", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)},
+		{Tag: "candidate", RevisionName: name + "-00002", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(0)},
+	}
+	service.Status.Traffic = service.Spec.Traffic
+	return service
+}
@@ -0,0 +1,404 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+	"knative.dev/serving/pkg/activator"
+	"knative.dev/serving/pkg/apis/serving"
+	servingnetworking "knative.dev/serving/pkg/networking"
+
+	"knative.dev/client/pkg/commands"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+const (
+	// Default namespace and label selector of the Knative activator pods
+	defaultActivatorNamespace = "knative-serving"
+	activatorSelector         = "app=" + activator.Name
+)
+
+var proxyExample = `
+  # Make the cluster-local service 'svc' available at http://localhost:8080
+  kn service proxy svc --port 8080
+
+  # Listen on all interfaces instead of localhost only
+  kn service proxy svc --port 8080 --address 0.0.0.0`
+
+// dialFunc opens a connection to a port of a pod
+type dialFunc func(ctx context.Context, namespace, pod string, port int) (net.Conn, error)
+
+// NewServiceProxyCommand represents 'kn service proxy' command
+func NewServiceProxyCommand(p *commands.KnParams) *cobra.Command {
+	var port int
+	var address string
+	var activatorNamespace string
+
+	command := &cobra.Command{
+		Use:   "proxy NAME",
+		Short: "Make a service available on a local port",
+		Long: `Make a service available on a local port
+
+Requests to the local port are forwarded through the Kubernetes API to a ready pod of a
+revision which receives traffic. If the service has been scaled to zero, the requests are
+forwarded to the Knative activator, which scales the service up again. The activator is
+looked up in the namespace given with --activator-namespace. Pods are selected
+for every request, so that the proxy keeps working while the service scales up and down.
+This allows to access cluster-local services without exposing them.`,
+		Example:           proxyExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service proxy' requires the service name given as single argument")
+			}
+			if port < 0 || port > 65535 {
+				return fmt.Errorf("invalid value for --port: %d, must be between 0 and 65535", port)
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+			kubeClient, err := p.NewKubeClient()
+			if err != nil {
+				return err
+			}
+			restConfig, err := p.RestConfig()
+			if err != nil {
+				return err
+			}
+
+			listener, err := net.Listen("tcp", net.JoinHostPort(address, strconv.Itoa(port)))
+			if err != nil {
+				return err
+			}
+			proxy := newServiceProxy(client, kubeClient, args[0], activatorNamespace, newPortForwardDial(restConfig, kubeClient), cmd.OutOrStdout())
+			fmt.Fprintf(cmd.OutOrStdout(), "Proxying service '%s' in namespace '%s' at http://%s\n", args[0], namespace, listener.Addr())
+			fmt.Fprintln(cmd.OutOrStdout(), "Press Ctrl-C to stop.")
+			return proxy.serve(cmd.Context(), listener)
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.IntVar(&port, "port", 8080, "Local port to listen on. Use 0 for a random port.")
+	flags.StringVar(&address, "address", "localhost", "Local address to listen on.")
+	flags.StringVar(&activatorNamespace, "activator-namespace", defaultActivatorNamespace,
+		"Namespace of the Knative activator, which receives the requests while the service is scaled to zero.")
+	return command
+}
+
+// serviceProxy is an HTTP reverse proxy which forwards requests to a pod of a service
+type serviceProxy struct {
+	client     clientservingv1.KnServingClient
+	kubeClient kubernetes.Interface
+	name       string
+	transport  http.RoundTripper
+	// namespace of the activator pods
+	activatorNamespace string
+
+	// guards out and lastTarget
+	mu         sync.Mutex
+	out        io.Writer
+	lastTarget string
+}
+
+// proxyTarget is a pod to forward requests to
+type proxyTarget struct {
+	namespace string
+	pod       string
+	// Revision which handles the request. Only set if the pod is an activator pod.
+	activatorRevision string
+}
+
+func newServiceProxy(client clientservingv1.KnServingClient, kubeClient kubernetes.Interface, name, activatorNamespace string, dial dialFunc, out io.Writer) *serviceProxy {
+	return &serviceProxy{
+		client:             client,
+		kubeClient:         kubeClient,
+		name:               name,
+		transport:          &http.Transport{DialContext: dialProxyTarget(dial)},
+		activatorNamespace: activatorNamespace,
+		out:                out,
+	}
+}
+
+// serve handles requests on the given listener until the context is done
+func (p *serviceProxy) serve(ctx context.Context, listener net.Listener) error {
+	server := &http.Server{Handler: p, ReadHeaderTimeout: 30 * time.Second}
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+	err := server.Serve(listener)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+func (p *serviceProxy) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	service, err := p.client.GetService(req.Context(), p.name)
+	if err != nil {
+		p.proxyError(w, err)
+		return
+	}
+	target, err := p.selectTarget(req.Context(), routedRevisions(service))
+	if err != nil {
+		p.proxyError(w, err)
+		return
+	}
+	p.logTarget(target)
+
+	host := ""
+	if service.Status.URL != nil {
+		host = service.Status.URL.Host
+	}
+	reverseProxy := &httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			pr.SetXForwarded()
+			pr.Out.URL.Scheme = "http"
+			pr.Out.URL.Host = net.JoinHostPort(target.namespace+"."+target.pod, strconv.Itoa(servingnetworking.BackendHTTPPort))
+			if host != "" {
+				pr.Out.Host = host
+			}
+			if target.activatorRevision != "" {
+				pr.Out.Header.Set(activator.RevisionHeaderName, target.activatorRevision)
+				pr.Out.Header.Set(activator.RevisionHeaderNamespace, p.client.Namespace())
+			}
+		},
+		Transport: p.transport,
+		ErrorHandler: func(w http.ResponseWriter, _ *http.Request, err error) {
+			p.proxyError(w, err)
+		},
+	}
+	reverseProxy.ServeHTTP(w, req)
+}
+
+// selectTarget returns a ready pod of one of the given revisions, or an activator
+// pod if the revisions have been scaled to zero
+func (p *serviceProxy) selectTarget(ctx context.Context, revisions []string) (*proxyTarget, error) {
+	if len(revisions) == 0 {
+		return nil, fmt.Errorf("no revision of service '%s' receives traffic", p.name)
+	}
+	routed := make(map[string]bool)
+	for _, revision := range revisions {
+		routed[revision] = true
+	}
+
+	namespace := p.client.Namespace()
+	selector := labels.Set{serving.ServiceLabelKey: p.name}.String()
+	pods, err := p.kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	sortPods(pods.Items)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if routed[pod.Labels[serving.RevisionLabelKey]] && isPodReady(pod) {
+			return &proxyTarget{namespace: namespace, pod: pod.Name}, nil
+		}
+	}
+
+	activators, err := p.kubeClient.CoreV1().Pods(p.activatorNamespace).List(ctx, metav1.ListOptions{LabelSelector: activatorSelector})
+	if err != nil {
+		return nil, fmt.Errorf("no ready pod found for service '%s' and cannot list activator pods in namespace '%s': %w", p.name, p.activatorNamespace, err)
+	}
+	sortPods(activators.Items)
+	for i := range activators.Items {
+		pod := &activators.Items[i]
+		if isPodReady(pod) {
+			return &proxyTarget{namespace: p.activatorNamespace, pod: pod.Name, activatorRevision: revisions[0]}, nil
+		}
+	}
+	return nil, fmt.Errorf("no ready pod found for service '%s', neither for revision(s) %s nor for the activator in namespace '%s' "+
+		"(use --activator-namespace if Knative Serving is installed in another namespace)",
+		p.name, strings.Join(revisions, ", "), p.activatorNamespace)
+}
+
+// logTarget prints the selected pod whenever it changes
+func (p *serviceProxy) logTarget(target *proxyTarget) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	description := fmt.Sprintf("pod '%s'", target.pod)
+	if target.activatorRevision != "" {
+		description = fmt.Sprintf("activator pod '%s' for revision '%s'", target.pod, target.activatorRevision)
+	}
+	if description != p.lastTarget {
+		fmt.Fprintf(p.out, "Forwarding requests to %s\n", description)
+		p.lastTarget = description
+	}
+}
+
+func (p *serviceProxy) proxyError(w http.ResponseWriter, err error) {
+	p.mu.Lock()
+	fmt.Fprintf(p.out, "Error: %v\n", err)
+	p.mu.Unlock()
+	http.Error(w, err.Error(), http.StatusBadGateway)
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// dialProxyTarget returns a dial function for an HTTP transport which connects to
+// addresses of the form "namespace.pod:port"
+func dialProxyTarget(dial dialFunc) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, _, addr string) (net.Conn, error) {
+		host, portString, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		namespace, pod, found := strings.Cut(host, ".")
+		if !found {
+			return nil, fmt.Errorf("invalid proxy target address '%s'", addr)
+		}
+		port, err := strconv.Atoi(portString)
+		if err != nil {
+			return nil, err
+		}
+		return dial(ctx, namespace, pod, port)
+	}
+}
+
+// newPortForwardDial returns a dial function which opens a port-forward connection through
+// the Kubernetes API, using websockets if supported and SPDY otherwise
+func newPortForwardDial(config *rest.Config, kubeClient kubernetes.Interface) dialFunc {
+	return func(ctx context.Context, namespace, pod string, port int) (net.Conn, error) {
+		url := kubeClient.CoreV1().RESTClient().Post().
+			Resource("pods").Namespace(namespace).Name(pod).SubResource("portforward").URL()
+		transport, upgrader, err := spdy.RoundTripperFor(config)
+		if err != nil {
+			return nil, err
+		}
+		dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url)
+		tunnelingDialer, err := portforward.NewSPDYOverWebsocketDialer(url, config)
+		if err != nil {
+			return nil, err
+		}
+		dialer = portforward.NewFallbackDialer(tunnelingDialer, dialer, func(err error) bool {
+			return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+		})
+
+		conn, _, err := dialer.Dial(portforward.PortForwardProtocolV1Name)
+		if err != nil {
+			return nil, fmt.Errorf("cannot port-forward to pod '%s' in namespace '%s': %w", pod, namespace, err)
+		}
+		return newPortForwardConn(conn, port)
+	}
+}
+
+// portForwardConn adapts the data stream of a port-forward connection to net.Conn
+type portForwardConn struct {
+	httpstream.Stream
+	conn httpstream.Connection
+}
+
+var _ net.Conn = &portForwardConn{}
+
+func newPortForwardConn(conn httpstream.Connection, port int) (net.Conn, error) {
+	headers := http.Header{}
+	headers.Set(corev1.StreamType, corev1.StreamTypeError)
+	headers.Set(corev1.PortHeader, strconv.Itoa(port))
+	headers.Set(corev1.PortForwardRequestIDHeader, "0")
+	errorStream, err := conn.CreateStream(headers)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	// The error stream is only read
+	errorStream.Close()
+
+	headers.Set(corev1.StreamType, corev1.StreamTypeData)
+	dataStream, err := conn.CreateStream(headers)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	// Close the connection if the remote side reports an error so that pending reads fail
+	go func() {
+		message, _ := io.ReadAll(errorStream)
+		if len(message) > 0 {
+			conn.Close()
+		}
+	}()
+	return &portForwardConn{Stream: dataStream, conn: conn}, nil
+}
+
+func (c *portForwardConn) Close() error {
+	c.Stream.Close()
+	return c.conn.Close()
+}
+
+func (c *portForwardConn) LocalAddr() net.Addr {
+	return portForwardAddr{}
+}
+
+func (c *portForwardConn) RemoteAddr() net.Addr {
+	return portForwardAddr{}
+}
+
+func (c *portForwardConn) SetDeadline(time.Time) error {
+	return nil
+}
+
+func (c *portForwardConn) SetReadDeadline(time.Time) error {
+	return nil
+}
+
+func (c *portForwardConn) SetWriteDeadline(time.Time) error {
+	return nil
+}
+
+type portForwardAddr struct{}
+
+func (portForwardAddr) Network() string {
+	return "portforward"
+}
+
+func (portForwardAddr) String() string {
+	return "portforward"
+}
@@ -0,0 +1,183 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+// startTestProxy starts a service proxy whose connections all end up at a test server,
+// which echoes the Knative headers. The dialed pods are sent to the returned channel.
+func startTestProxy(t *testing.T, client knclient.KnServingClient, activatorNamespace string, pods ...*corev1.Pod) (string, *syncBuffer, chan string) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintf(w, "host=%s revision=%s namespace=%s path=%s",
+			req.Host, req.Header.Get("Knative-Serving-Revision"), req.Header.Get("Knative-Serving-Namespace"), req.URL.Path)
+	}))
+	t.Cleanup(server.Close)
+
+	var objects []runtime.Object
+	for _, pod := range pods {
+		objects = append(objects, pod)
+	}
+	kubeClient := fake.NewSimpleClientset(objects...)
+
+	dialed := make(chan string, 10)
+	dial := func(ctx context.Context, namespace, pod string, port int) (net.Conn, error) {
+		dialed <- fmt.Sprintf("%s/%s:%d", namespace, pod, port)
+		return (&net.Dialer{}).DialContext(ctx, "tcp", server.Listener.Addr().String())
+	}
+
+	out := &syncBuffer{}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NilError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go newServiceProxy(client, kubeClient, "foo", activatorNamespace, dial, out).serve(ctx, listener)
+	return "http://" + listener.Addr().String(), out, dialed
+}
+
+func TestServiceProxyToPod(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", createServiceForProxy("foo", "foo-00002"), nil)
+
+	readyPod := createReadyTestPod("foo", "foo-00002", "foo-00002-deployment-b")
+	otherRevisionPod := createReadyTestPod("foo", "foo-00001", "foo-00001-deployment-a")
+	notReadyPod := createTestPod("foo", "foo-00002", "foo-00002-deployment-a", corev1.PodRunning, "user-container")
+	url, out, dialed := startTestProxy(t, client, defaultActivatorNamespace, readyPod, otherRevisionPod, notReadyPod)
+
+	body := proxyGet(t, url+"/hello", http.StatusOK)
+	assert.Equal(t, body, "host=foo.default.example.com revision= namespace= path=/hello")
+	assert.Equal(t, <-dialed, "default/foo-00002-deployment-b:8012")
+	assert.Assert(t, util.ContainsAll(out.String(), "Forwarding requests to pod 'foo-00002-deployment-b'"))
+
+	r.Validate()
+}
+
+func TestServiceProxyToActivator(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", createServiceForProxy("foo", "foo-00002"), nil)
+
+	activatorPod := createReadyTestPod("", "", "activator-1234")
+	activatorPod.Namespace = defaultActivatorNamespace
+	activatorPod.Labels = map[string]string{"app": "activator"}
+	url, out, dialed := startTestProxy(t, client, defaultActivatorNamespace, activatorPod)
+
+	body := proxyGet(t, url+"/", http.StatusOK)
+	assert.Equal(t, body, "host=foo.default.example.com revision=foo-00002 namespace=default path=/")
+	assert.Equal(t, <-dialed, "knative-serving/activator-1234:8012")
+	assert.Assert(t, util.ContainsAll(out.String(), "activator pod 'activator-1234' for revision 'foo-00002'"))
+
+	r.Validate()
+}
+
+func TestServiceProxyToActivatorInNamespace(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", createServiceForProxy("foo", "foo-00002"), nil)
+
+	activatorPod := createReadyTestPod("", "", "activator-5678")
+	activatorPod.Namespace = "serving"
+	activatorPod.Labels = map[string]string{"app": "activator"}
+	url, _, dialed := startTestProxy(t, client, "serving", activatorPod)
+
+	body := proxyGet(t, url+"/", http.StatusOK)
+	assert.Equal(t, body, "host=foo.default.example.com revision=foo-00002 namespace=default path=/")
+	assert.Equal(t, <-dialed, "serving/activator-5678:8012")
+
+	r.Validate()
+}
+
+func TestServiceProxyNoTarget(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", createServiceForProxy("foo", "foo-00002"), nil)
+	r.GetService("foo", createServiceForProxy("foo", ""), nil)
+
+	activatorPod := createReadyTestPod("", "", "activator-1234")
+	activatorPod.Namespace = defaultActivatorNamespace
+	activatorPod.Labels = map[string]string{"app": "activator"}
+	url, out, _ := startTestProxy(t, client, "serving", activatorPod)
+
+	body := proxyGet(t, url+"/", http.StatusBadGateway)
+	assert.Assert(t, util.ContainsAll(body, "no ready pod found for service 'foo'", "activator in namespace 'serving'", "--activator-namespace"))
+	body = proxyGet(t, url+"/", http.StatusBadGateway)
+	assert.Assert(t, util.ContainsAll(body, "no revision of service 'foo' receives traffic"))
+	assert.Assert(t, util.ContainsAll(out.String(), "Error:"))
+
+	r.Validate()
+}
+
+func TestServiceProxyInvalidPort(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	_, err := executeServiceCommand(client, "proxy", "foo", "--port", "70000")
+	assert.ErrorContains(t, err, "invalid value for --port")
+}
+
+func TestRoutedRevisions(t *testing.T) {
+	service := &servingv1.Service{}
+	service.Status.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: "foo-00001", Percent: ptr.Int64(20)},
+		{RevisionName: "foo-00002", Percent: ptr.Int64(40)},
+		{RevisionName: "foo-00003", Percent: ptr.Int64(40)},
+		{RevisionName: "foo-00004", Percent: ptr.Int64(0), Tag: "next"},
+	}
+	assert.DeepEqual(t, routedRevisions(service), []string{"foo-00003", "foo-00002", "foo-00001"})
+	assert.Equal(t, mostRoutedRevision(service, "foo-00003"), "foo-00002")
+}
+
+func proxyGet(t *testing.T, url string, expectedStatus int) string {
+	resp, err := http.Get(url)
+	assert.NilError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	assert.NilError(t, err)
+	assert.Equal(t, resp.StatusCode, expectedStatus, string(body))
+	return string(body)
+}
+
+func createServiceForProxy(name, routedRevision string) *servingv1.Service {
+	service := &servingv1.Service{}
+	service.Name = name
+	service.Status.URL = &apis.URL{Scheme: "http", Host: name + ".default.example.com"}
+	if routedRevision != "" {
+		service.Status.Traffic = []servingv1.TrafficTarget{{RevisionName: routedRevision, Percent: ptr.Int64(100)}}
+	}
+	return service
+}
+
+func createReadyTestPod(service, revision, name string) *corev1.Pod {
+	pod := createTestPod(service, revision, name, corev1.PodRunning, "user-container")
+	pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+	return pod
+}
@@ -0,0 +1,68 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/spf13/cobra"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/oci"
+	servinglib "knative.dev/client/pkg/serving"
+)
+
+// resolveImageDigests pins the images of all containers of the template to the digests
+// their tags currently refer to, if requested with --resolve-digest. The registries are
+// queried directly, so that this works without the Knative Serving controller, e.g. in
+// gitops mode. The tag of the main container is kept in the user image annotation.
+func resolveImageDigests(cmd *cobra.Command, p *commands.KnParams, editFlags ConfigurationEditFlags, namespace string, template *servingv1.RevisionTemplateSpec) error {
+	if !editFlags.ResolveDigest {
+		return nil
+	}
+	keychain, err := imageKeychain(cmd, p, namespace, template)
+	if err != nil {
+		return err
+	}
+	resolver := oci.NewResolver(keychain)
+
+	if main := servinglib.ContainerOfRevisionSpec(&template.Spec); main != nil && !strings.Contains(main.Image, "@") {
+		servinglib.UpdateUserImageAnnotation(template)
+	}
+	containers := template.Spec.Containers
+	for i := range containers {
+		digest, err := resolver.Resolve(cmd.Context(), containers[i].Image)
+		if err != nil {
+			return err
+		}
+		containers[i].Image = digest
+	}
+	return nil
+}
+
+// imageKeychain returns the credentials for the registries. In gitops mode only the local
+// Docker credentials are used, otherwise also the image pull secrets of the namespace.
+func imageKeychain(cmd *cobra.Command, p *commands.KnParams, namespace string, template *servingv1.RevisionTemplateSpec) (authn.Keychain, error) {
+	if target := cmd.Flags().Lookup("target"); target != nil && target.Value.String() != "" {
+		return authn.DefaultKeychain, nil
+	}
+	kubeClient, err := p.NewKubeClient()
+	if err != nil {
+		return nil, err
+	}
+	return oci.NewNamespaceKeychain(cmd.Context(), kubeClient, namespace, template.Spec.ServiceAccountName, template.Spec.ImagePullSecrets)
+}
@@ -0,0 +1,127 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	knflags "knative.dev/client/pkg/flags"
+	"knative.dev/client/pkg/oci/ocitest"
+	clientserving "knative.dev/client/pkg/serving"
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func executeServiceResolveDigestCommand(client knclient.KnServingClient, kubeClient kubernetes.Interface, args ...string) (string, error) {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+	knParams.NewServingClient = func(namespace string) (knclient.KnServingClient, error) {
+		return client, nil
+	}
+	knParams.NewGitopsServingClient = func(namespace string, dir string) (knclient.KnServingClient, error) {
+		return knclient.NewKnServingGitOpsClient(namespace, dir), nil
+	}
+	knParams.NewKubeClient = func() (kubernetes.Interface, error) {
+		return kubeClient, nil
+	}
+	output := &bytes.Buffer{}
+	cmd := NewServiceCommand(knParams)
+	cmd.SetArgs(args)
+	cmd.SetOut(output)
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		return knflags.ReconcileBoolFlags(cmd.Flags())
+	}
+	err := cmd.Execute()
+	return output.String(), err
+}
+
+func TestServiceCreateResolveDigestMock(t *testing.T) {
+	server, host := ocitest.NewTestRegistry("user", "secret")
+	defer server.Close()
+	registryRef := host + "/foo:v1"
+	digest, err := ocitest.PushTestImage(registryRef, &authn.Basic{Username: "user", Password: "secret"})
+	assert.NilError(t, err)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry", Namespace: "default"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: []byte(`{"auths":{"` + host + `":{"username":"user","password":"secret"}}}`),
+		},
+	}
+
+	kubeClient := fake.NewSimpleClientset(secret, &corev1.ServiceAccount{
+		ObjectMeta:       metav1.ObjectMeta{Name: "default", Namespace: "default"},
+		ImagePullSecrets: []corev1.LocalObjectReference{{Name: "registry"}},
+	})
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	service := getService("foo")
+	template := &service.Spec.Template
+	template.Spec.Containers[0].Image = host + "/foo@" + digest
+	template.Annotations = map[string]string{clientserving.UserImageAnnotationKey: registryRef}
+	r.CreateService(verifyService(service, true), nil)
+
+	output, err := executeServiceResolveDigestCommand(client, kubeClient, "create", "foo", "--image", registryRef, "--resolve-digest", "--no-wait", "--revision-name=")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "created", "foo", "default"))
+	r.Validate()
+}
+
+func TestServiceCreateResolveDigestGitOps(t *testing.T) {
+	server, host := ocitest.NewTestRegistry("", "")
+	defer server.Close()
+	digest, err := ocitest.PushTestImage(host+"/foo:v1", nil)
+	assert.NilError(t, err)
+
+	dir := t.TempDir()
+	// No cluster access is needed in gitops mode
+	output, err := executeServiceResolveDigestCommand(nil, nil, "create", "foo", "--image", host+"/foo:v1", "--resolve-digest", "--target", dir, "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "created", "foo"))
+
+	content, err := os.ReadFile(filepath.Join(dir, "default", "ksvc", "foo.yaml"))
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(string(content), "image: "+host+"/foo@"+digest, clientserving.UserImageAnnotationKey+": "+host+"/foo:v1"))
+}
+
+func TestServiceCreateResolveDigestErrors(t *testing.T) {
+	server, host := ocitest.NewTestRegistry("", "")
+	defer server.Close()
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	_, err := executeServiceResolveDigestCommand(client, fake.NewSimpleClientset(), "create", "foo", "--image", host+"/foo:v1", "--resolve-digest", "--no-wait")
+	assert.ErrorContains(t, err, "cannot resolve digest of image '"+host+"/foo:v1'")
+
+	_, err = executeServiceResolveDigestCommand(client, nil, "create", "foo", "--image", host+"/foo:v1", "--resolve-digest", "--no-lock-to-digest", "--no-wait")
+	assert.ErrorContains(t, err, "--resolve-digest can't be combined with --no-lock-to-digest")
+	r.Validate()
+}
@@ -0,0 +1,209 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/config"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+var rollbackExample = `
+  # Roll back service 'svc' to the revision which was routed before the current one
+  kn service rollback svc
+
+  # Roll back service 'svc' to revision 'svc-00002'
+  kn service rollback svc --to-revision svc-00002`
+
+// NewServiceRollbackCommand represents 'kn service rollback' command
+func NewServiceRollbackCommand(p *commands.KnParams) *cobra.Command {
+	var waitFlags commands.WaitFlags
+	var toRevision string
+
+	command := &cobra.Command{
+		Use:   "rollback NAME",
+		Short: "Roll back a service to a previous revision",
+		Long: `Roll back a service to a previous revision
+
+The service template is restored from the given revision and all traffic is routed to
+this revision. Traffic tags are kept but do not receive any traffic anymore. If no
+revision is given, the newest ready revision which is older than the currently routed
+revisions is chosen.`,
+		Example:           rollbackExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service rollback' requires the service name given as single argument")
+			}
+			name := args[0]
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			service, err := client.GetService(cmd.Context(), name)
+			if err != nil {
+				return err
+			}
+			revision, err := findRollbackRevision(cmd.Context(), client, service, toRevision)
+			if err != nil {
+				return err
+			}
+
+			updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
+				rolledBack := constructServiceFromRevision(service, revision.DeepCopy())
+				service.Spec.Template = rolledBack.Spec.Template
+				service.Spec.Traffic = rollbackTraffic(service.Spec.Traffic, revision.Name)
+				return service, nil
+			}
+			changed, err := client.UpdateServiceWithRetry(cmd.Context(), name, updateFunc, config.DefaultRetry.Steps)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if !changed {
+				fmt.Fprintf(out, "Service '%s' in namespace '%s' is already serving revision '%s'.\n", name, namespace, revision.Name)
+				return nil
+			}
+			if !waitFlags.Wait {
+				fmt.Fprintf(out, "Service '%s' rolled back to revision '%s' in namespace '%s'.\n", name, revision.Name, namespace)
+				return nil
+			}
+
+			fmt.Fprintf(out, "Rolling back Service '%s' in namespace '%s' to revision '%s':\n", name, namespace, revision.Name)
+			fmt.Fprintln(out, "")
+			wconfig := clientservingv1.WaitConfig{
+				Timeout:     time.Duration(waitFlags.TimeoutInSeconds) * time.Second,
+				ErrorWindow: time.Duration(waitFlags.ErrorWindowInSeconds) * time.Second,
+			}
+			if err := waitForService(cmd.Context(), client, name, out, wconfig); err != nil {
+				return err
+			}
+			fmt.Fprintln(out, "")
+
+			service, err = client.GetService(cmd.Context(), name)
+			if err != nil {
+				return fmt.Errorf("cannot fetch service '%s' in namespace '%s' for extracting the URL: %w", name, namespace, err)
+			}
+			fmt.Fprintf(out, "Service '%s' rolled back to revision '%s' is available at URL:\n%s\n", name, revision.Name, service.Status.URL.String())
+			return nil
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.StringVar(&toRevision, "to-revision", "",
+		"Name of the revision to roll back to. Defaults to the newest ready revision older than the currently routed revisions.")
+	waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "rollback", "service", "ready")
+	return command
+}
+
+// findRollbackRevision returns the revision to roll back to, which is either the given
+// revision or the newest ready revision created before the currently routed revisions
+func findRollbackRevision(ctx context.Context, client clientservingv1.KnServingClient, service *servingv1.Service, revisionName string) (*servingv1.Revision, error) {
+	if revisionName != "" {
+		revision, err := client.GetRevision(ctx, revisionName)
+		if err != nil {
+			return nil, err
+		}
+		if revision.Labels[serving.ServiceLabelKey] != service.Name {
+			return nil, fmt.Errorf("revision '%s' does not belong to service '%s'", revisionName, service.Name)
+		}
+		return revision, nil
+	}
+
+	revisionList, err := client.ListRevisions(ctx, clientservingv1.WithService(service.Name))
+	if err != nil {
+		return nil, err
+	}
+	sortRevisions(revisionList)
+
+	// Only revisions older than the oldest routed revision are candidates
+	oldestRouted := -1
+	routed := make(map[string]bool)
+	for _, target := range service.Status.Traffic {
+		if target.RevisionName != "" && target.Percent != nil && *target.Percent > 0 {
+			routed[target.RevisionName] = true
+		}
+	}
+	for i := range revisionList.Items {
+		if routed[revisionList.Items[i].Name] {
+			oldestRouted = revisionGeneration(&revisionList.Items[i])
+			break
+		}
+	}
+	// Revisions are sorted by generation in ascending order
+	for i := len(revisionList.Items) - 1; i >= 0; i-- {
+		revision := &revisionList.Items[i]
+		if routed[revision.Name] || !revision.IsReady() {
+			continue
+		}
+		if oldestRouted < 0 || revisionGeneration(revision) < oldestRouted {
+			return revision, nil
+		}
+	}
+	return nil, fmt.Errorf("no previous revision found for service '%s' in namespace '%s' to roll back to", service.Name, client.Namespace())
+}
+
+func revisionGeneration(revision *servingv1.Revision) int {
+	generation, err := strconv.Atoi(revision.Labels[serving.ConfigurationGenerationLabelKey])
+	if err != nil {
+		return 0
+	}
+	return generation
+}
+
+// rollbackTraffic routes all traffic to the given revision. Tagged targets are
+// kept without traffic so that their URLs stay available.
+func rollbackTraffic(current []servingv1.TrafficTarget, revisionName string) []servingv1.TrafficTarget {
+	var traffic []servingv1.TrafficTarget
+	assigned := false
+	for _, target := range current {
+		if target.Tag == "" {
+			continue
+		}
+		target = *target.DeepCopy()
+		target.Percent = ptr.Int64(0)
+		if !assigned && target.RevisionName == revisionName {
+			target.Percent = ptr.Int64(100)
+			assigned = true
+		}
+		traffic = append(traffic, target)
+	}
+	if !assigned {
+		traffic = append([]servingv1.TrafficTarget{{
+			RevisionName:   revisionName,
+			LatestRevision: ptr.Bool(false),
+			Percent:        ptr.Int64(100),
+		}}, traffic...)
+	}
+	return traffic
+}
@@ -0,0 +1,149 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+	"knative.dev/client/pkg/wait"
+)
+
+func TestServiceRollbackToPreviousRevisionMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := createServiceForRollback("foo", "foo-00003")
+	r.GetService("foo", service, nil)
+	revisions := createRevisionsForRollback("foo", 3)
+	// Revisions are not guaranteed to be returned in order
+	revisions.Items[0], revisions.Items[2] = revisions.Items[2], revisions.Items[0]
+	r.ListRevisions(mock.Any(), revisions, nil)
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, svc *servingv1.Service) {
+		assert.Equal(t, svc.Spec.Template.Name, "foo-00002")
+		assert.Equal(t, svc.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar:2")
+		assert.DeepEqual(t, svc.Spec.Traffic, []servingv1.TrafficTarget{
+			{RevisionName: "foo-00002", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)},
+		})
+	}, true, nil)
+
+	output, err := executeServiceCommand(client, "rollback", "foo", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "rolled back", "foo-00002", "default"))
+
+	r.Validate()
+}
+
+func TestServiceRollbackToRevisionWithWaitMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := createServiceForRollback("foo", "foo-00003")
+	revisions := createRevisionsForRollback("foo", 3)
+	r.GetService("foo", service, nil)
+	r.GetRevision("foo-00001", &revisions.Items[0], nil)
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, svc *servingv1.Service) {
+		assert.Equal(t, svc.Spec.Template.Name, "foo-00001")
+		assert.Equal(t, svc.Spec.Template.Spec.Containers[0].Image, "gcr.io/foo/bar:1")
+	}, true, nil)
+	r.WaitForService("foo", mock.Any(), wait.NoopMessageCallback(), nil, time.Second)
+	r.GetService("foo", getServiceWithUrl("foo", "http://foo.example.com"), nil)
+
+	output, err := executeServiceCommand(client, "rollback", "foo", "--to-revision", "foo-00001")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Rolling back", "Ready to serve", "rolled back to revision 'foo-00001'", "http://foo.example.com"))
+
+	r.Validate()
+}
+
+func TestServiceRollbackRevisionOfOtherServiceMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	revisions := createRevisionsForRollback("bar", 1)
+	r.GetService("foo", createServiceForRollback("foo", "foo-00002"), nil)
+	r.GetRevision("bar-00001", &revisions.Items[0], nil)
+
+	_, err := executeServiceCommand(client, "rollback", "foo", "--to-revision", "bar-00001")
+	assert.ErrorContains(t, err, "does not belong to service 'foo'")
+
+	r.Validate()
+}
+
+func TestServiceRollbackNoPreviousRevisionMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.GetService("foo", createServiceForRollback("foo", "foo-00001"), nil)
+	r.ListRevisions(mock.Any(), createRevisionsForRollback("foo", 1), nil)
+
+	_, err := executeServiceCommand(client, "rollback", "foo")
+	assert.ErrorContains(t, err, "no previous revision found")
+
+	r.Validate()
+}
+
+func TestServiceRollbackNoName(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	_, err := executeServiceCommand(client, "rollback")
+	assert.ErrorContains(t, err, "requires the service name")
+}
+
+func TestRollbackTraffic(t *testing.T) {
+	current := []servingv1.TrafficTarget{
+		{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(80)},
+		{RevisionName: "foo-00001", Tag: "stable", Percent: ptr.Int64(20)},
+		{RevisionName: "foo-00002", Tag: "old", Percent: ptr.Int64(0)},
+	}
+	assert.DeepEqual(t, rollbackTraffic(current, "foo-00002"), []servingv1.TrafficTarget{
+		{RevisionName: "foo-00001", Tag: "stable", Percent: ptr.Int64(0)},
+		{RevisionName: "foo-00002", Tag: "old", Percent: ptr.Int64(100)},
+	})
+	assert.DeepEqual(t, rollbackTraffic(current, "foo-00003"), []servingv1.TrafficTarget{
+		{RevisionName: "foo-00003", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)},
+		{RevisionName: "foo-00001", Tag: "stable", Percent: ptr.Int64(0)},
+		{RevisionName: "foo-00002", Tag: "old", Percent: ptr.Int64(0)},
+	})
+}
+
+func createServiceForRollback(name, routedRevision string) *servingv1.Service {
+	service := createServiceWithImage(name, "gcr.io/foo/bar:latest")
+	service.Spec.Traffic = []servingv1.TrafficTarget{{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)}}
+	service.Status.Traffic = []servingv1.TrafficTarget{{RevisionName: routedRevision, LatestRevision: ptr.Bool(true), Percent: ptr.Int64(100)}}
+	return service
+}
+
+func createRevisionsForRollback(service string, count int) *servingv1.RevisionList {
+	revisionList := &servingv1.RevisionList{}
+	for i := 1; i <= count; i++ {
+		revision := createTestRevision(fmt.Sprintf("%s-%05d", service, i), int64(i), goodConditions())
+		revision.Labels[serving.ServiceLabelKey] = service
+		revision.Status.ObservedGeneration = revision.Generation
+		revision.Spec.Containers[0].Image = fmt.Sprintf("gcr.io/foo/bar:%d", i)
+		revisionList.Items = append(revisionList.Items, revision)
+	}
+	return revisionList
+}
@@ -0,0 +1,432 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/spf13/cobra"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/config"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/traffic"
+)
+
+var rolloutExample = `
+  # Shift traffic of service 'svc' to its latest ready revision in four steps, two minutes apart
+  kn service rollout svc --steps 5,25,50,100 --interval 2m
+
+  # Abort and revert the rollout if the error rate of the new revision exceeds 1%
+  kn service rollout svc --metrics-url http://prometheus.monitoring:9090 \
+    --metrics-query 'sum(rate(revision_app_request_count{revision_name="{{.Revision}}",response_code_class="5xx"}[1m])) / sum(rate(revision_app_request_count{revision_name="{{.Revision}}"}[1m]))' \
+    --metrics-max 0.01`
+
+// rolloutFlags holds the flags for 'kn service rollout'
+type rolloutFlags struct {
+	steps        []int
+	interval     time.Duration
+	metricsURL   string
+	metricsQuery string
+	metricsMax   float64
+}
+
+// NewServiceRolloutCommand represents 'kn service rollout' command
+func NewServiceRolloutCommand(p *commands.KnParams) *cobra.Command {
+	var rolloutFlags rolloutFlags
+	var waitFlags commands.WaitFlags
+
+	command := &cobra.Command{
+		Use:   "rollout NAME",
+		Short: "Shift traffic to the latest ready revision step by step",
+		Long: `Shift traffic to the latest ready revision step by step
+
+Traffic is moved from the revision which currently receives most of the traffic to the
+latest ready revision of the service. After each step the command waits for the service
+to become ready and for the given interval, and then checks that the new revision is
+still ready. This applies to the final step, too. If a Prometheus compatible endpoint and
+a query are given, the query must not return a value greater than --metrics-max. A query
+returning NaN or an infinite value, e.g. a ratio of requests while the new revision didn't
+receive any requests yet, fails the gate as well. The query can refer to the new revision,
+the service and the namespace with {{.Revision}}, {{.Service}} and {{.Namespace}}.
+
+If any of these gates fail, the traffic of the service is reverted to its state before
+the rollout and the command exits with an error.`,
+		Example:           rolloutExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service rollout' requires the service name given as single argument")
+			}
+			if err := rolloutFlags.validate(); err != nil {
+				return err
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			wconfig := clientservingv1.WaitConfig{
+				Timeout:     time.Duration(waitFlags.TimeoutInSeconds) * time.Second,
+				ErrorWindow: time.Duration(waitFlags.ErrorWindowInSeconds) * time.Second,
+			}
+			r := &rollout{
+				client:  client,
+				name:    args[0],
+				flags:   rolloutFlags,
+				wconfig: wconfig,
+				out:     cmd.OutOrStdout(),
+			}
+			if rolloutFlags.metricsQuery != "" {
+				r.metricsGate = &metricsGate{
+					url:        rolloutFlags.metricsURL,
+					query:      rolloutFlags.metricsQuery,
+					max:        rolloutFlags.metricsMax,
+					httpClient: &http.Client{Timeout: metricsQueryTimeout},
+				}
+			}
+			return r.run(cmd.Context())
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.IntSliceVar(&rolloutFlags.steps, "steps", []int{5, 25, 50, 100},
+		"Comma separated list of ascending traffic percentages for the latest ready revision, one for each step.")
+	flags.DurationVar(&rolloutFlags.interval, "interval", time.Minute,
+		"Time to wait after each step before checking the gates and moving on to the next step.")
+	flags.StringVar(&rolloutFlags.metricsURL, "metrics-url", "",
+		"Base URL of a Prometheus compatible API which is used for checking the query given with --metrics-query after each step.")
+	flags.StringVar(&rolloutFlags.metricsQuery, "metrics-query", "",
+		"Query which is evaluated after each step. The rollout is aborted if the query returns a value greater than --metrics-max.")
+	flags.Float64Var(&rolloutFlags.metricsMax, "metrics-max", 0,
+		"Maximum value the query given with --metrics-query may return for the rollout to continue.")
+	// The rollout always waits for the service after each step, so only the timeouts can be tuned
+	waitFlags.AddWaitTimeoutFlags(command, commands.WaitDefaultTimeout, "service", "ready")
+	return command
+}
+
+func (f *rolloutFlags) validate() error {
+	if len(f.steps) == 0 {
+		return errors.New("at least one step has to be given with --steps")
+	}
+	last := 0
+	for _, step := range f.steps {
+		if step <= last || step > 100 {
+			return fmt.Errorf("invalid value for --steps: %v, percentages must be ascending and between 1 and 100", f.steps)
+		}
+		last = step
+	}
+	if f.interval < 0 {
+		return fmt.Errorf("invalid value for --interval: %s, must not be negative", f.interval)
+	}
+	if (f.metricsURL == "") != (f.metricsQuery == "") {
+		return errors.New("--metrics-url and --metrics-query must be given together")
+	}
+	return nil
+}
+
+// rollout shifts traffic from a stable revision to the latest ready revision
+type rollout struct {
+	client      clientservingv1.KnServingClient
+	name        string
+	flags       rolloutFlags
+	wconfig     clientservingv1.WaitConfig
+	metricsGate *metricsGate
+	out         io.Writer
+}
+
+func (r *rollout) run(ctx context.Context) error {
+	service, err := r.client.GetService(ctx, r.name)
+	if err != nil {
+		return err
+	}
+	candidate := service.Status.LatestReadyRevisionName
+	if candidate == "" {
+		return fmt.Errorf("service '%s' in namespace '%s' has no ready revision to roll out", r.name, r.client.Namespace())
+	}
+	stable := mostRoutedRevision(service, candidate)
+	if stable == "" {
+		return fmt.Errorf("latest ready revision '%s' of service '%s' already receives all traffic", candidate, r.name)
+	}
+	originalTraffic := service.DeepCopy().Spec.Traffic
+
+	fmt.Fprintf(r.out, "Rolling out revision '%s' of service '%s' in namespace '%s', shifting traffic from revision '%s':\n",
+		candidate, r.name, r.client.Namespace(), stable)
+	for i, step := range r.flags.steps {
+		fmt.Fprintln(r.out, "")
+		fmt.Fprintf(r.out, "Step %d/%d: Routing %d%% of traffic to revision '%s'\n", i+1, len(r.flags.steps), step, candidate)
+		if err := r.shiftTraffic(ctx, candidate, stable, step); err != nil {
+			return r.abort(ctx, originalTraffic, err)
+		}
+		// Also the final step is soaked before checking the gates, so that they see the full traffic
+		if r.flags.interval > 0 {
+			fmt.Fprintf(r.out, "Waiting %s before checking revision '%s'\n", r.flags.interval, candidate)
+			select {
+			case <-ctx.Done():
+				return r.abort(ctx, originalTraffic, ctx.Err())
+			case <-time.After(r.flags.interval):
+			}
+		}
+		if err := r.checkGates(ctx, candidate); err != nil {
+			return r.abort(ctx, originalTraffic, err)
+		}
+	}
+	fmt.Fprintln(r.out, "")
+	fmt.Fprintf(r.out, "Service '%s' rolled out to revision '%s'.\n", r.name, candidate)
+	return nil
+}
+
+// shiftTraffic routes the given percentage to the candidate revision and the rest to the
+// stable revision and waits for the service to become ready
+func (r *rollout) shiftTraffic(ctx context.Context, candidate, stable string, percent int) error {
+	percentages := []string{
+		fmt.Sprintf("%s=%d", candidate, percent),
+		fmt.Sprintf("%s=%d", stable, 100-percent),
+	}
+	updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
+		targets, err := traffic.ComputePercentages(service, percentages, nil)
+		if err != nil {
+			return nil, err
+		}
+		service.Spec.Traffic = targets
+		return service, nil
+	}
+	if _, err := r.client.UpdateServiceWithRetry(ctx, r.name, updateFunc, config.DefaultRetry.Steps); err != nil {
+		return err
+	}
+	return waitForService(ctx, r.client, r.name, r.out, r.wconfig)
+}
+
+// checkGates verifies that the candidate revision is still healthy
+func (r *rollout) checkGates(ctx context.Context, candidate string) error {
+	revision, err := r.client.GetRevision(ctx, candidate)
+	if err != nil {
+		return err
+	}
+	if !revision.IsReady() {
+		return fmt.Errorf("revision '%s' is not ready anymore", candidate)
+	}
+	if r.metricsGate == nil {
+		return nil
+	}
+	value, err := r.metricsGate.check(ctx, metricsQueryData{
+		Revision:  candidate,
+		Service:   r.name,
+		Namespace: r.client.Namespace(),
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(r.out, "Metrics gate passed with value %g (max %g)\n", value, r.metricsGate.max)
+	return nil
+}
+
+// abort reverts the traffic of the service to the given targets and returns
+// an error which includes the reason for the abort
+func (r *rollout) abort(ctx context.Context, originalTraffic []servingv1.TrafficTarget, reason error) error {
+	fmt.Fprintln(r.out, "")
+	fmt.Fprintf(r.out, "Rollout failed: %v\n", reason)
+	fmt.Fprintf(r.out, "Reverting traffic of service '%s' ...\n", r.name)
+	return revertTraffic(ctx, r.client, r.name, originalTraffic, "rollout", reason, r.out, r.wconfig)
+}
+
+// revertTraffic restores the given traffic targets of a service after the named operation
+// failed and returns an error which includes the reason for the failure
+func revertTraffic(ctx context.Context, client clientservingv1.KnServingClient, name string, originalTraffic []servingv1.TrafficTarget,
+	operation string, reason error, out io.Writer, wconfig clientservingv1.WaitConfig) error {
+	// Use a fresh context so that the traffic is reverted also when the operation has been interrupted
+	revertCtx := context.WithoutCancel(ctx)
+	updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
+		service.Spec.Traffic = originalTraffic
+		return service, nil
+	}
+	if _, err := client.UpdateServiceWithRetry(revertCtx, name, updateFunc, config.DefaultRetry.Steps); err != nil {
+		return fmt.Errorf("%s of service '%s' failed: %w, and reverting the traffic failed, too: %v", operation, name, reason, err)
+	}
+	if err := waitForService(revertCtx, client, name, out, wconfig); err != nil {
+		return fmt.Errorf("%s of service '%s' failed: %w, and waiting for the reverted traffic failed: %v", operation, name, reason, err)
+	}
+	return fmt.Errorf("%s of service '%s' failed and traffic has been reverted: %w", operation, name, reason)
+}
+
+// mostRoutedRevision returns the revision other than the excluded one which currently
+// receives most of the traffic of the service
+func mostRoutedRevision(service *servingv1.Service, exclude string) string {
+	for _, revision := range routedRevisions(service) {
+		if revision != exclude {
+			return revision
+		}
+	}
+	return ""
+}
+
+// routedRevisions returns all revisions which currently receive traffic, ordered
+// by their traffic percentage and name in descending order
+func routedRevisions(service *servingv1.Service) []string {
+	percentages := make(map[string]int64)
+	for _, target := range service.Status.Traffic {
+		if target.RevisionName != "" && target.Percent != nil && *target.Percent > 0 {
+			percentages[target.RevisionName] += *target.Percent
+		}
+	}
+	revisions := make([]string, 0, len(percentages))
+	for revision := range percentages {
+		revisions = append(revisions, revision)
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		a, b := revisions[i], revisions[j]
+		if percentages[a] != percentages[b] {
+			return percentages[a] > percentages[b]
+		}
+		return a > b
+	})
+	return revisions
+}
+
+// metricsQueryData is available as template data in a metrics query
+type metricsQueryData struct {
+	Revision  string
+	Service   string
+	Namespace string
+}
+
+// metricsQueryTimeout limits the time a single query of the metrics gate may take
+const metricsQueryTimeout = 30 * time.Second
+
+// metricsGate evaluates an instant query against the HTTP API of a Prometheus
+// compatible server and compares the result with a threshold
+type metricsGate struct {
+	url        string
+	query      string
+	max        float64
+	httpClient *http.Client
+}
+
+// prometheusResponse is the envelope of a Prometheus HTTP API response
+type prometheusResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// check runs the query and returns the highest value of the result. An empty
+// result passes the gate, NaN and infinite values fail it.
+func (g *metricsGate) check(ctx context.Context, data metricsQueryData) (float64, error) {
+	tmpl, err := template.New("query").Parse(g.query)
+	if err != nil {
+		return 0, fmt.Errorf("cannot parse metrics query: %w", err)
+	}
+	query := &strings.Builder{}
+	if err := tmpl.Execute(query, data); err != nil {
+		return 0, fmt.Errorf("cannot render metrics query: %w", err)
+	}
+
+	queryURL := strings.TrimSuffix(g.url, "/") + "/api/v1/query?" + url.Values{"query": {query.String()}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, queryURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("cannot query metrics: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var promResp prometheusResponse
+	if err := json.NewDecoder(resp.Body).Decode(&promResp); err != nil {
+		return 0, fmt.Errorf("cannot decode metrics response (HTTP status %d): %w", resp.StatusCode, err)
+	}
+	if promResp.Status != "success" {
+		return 0, fmt.Errorf("metrics query failed: %s", promResp.Error)
+	}
+
+	values, err := prometheusValues(promResp.Data.ResultType, promResp.Data.Result)
+	if err != nil {
+		return 0, err
+	}
+	var highest float64
+	for i, value := range values {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return value, fmt.Errorf("metrics query returned %g which is not a finite number", value)
+		}
+		if i == 0 || value > highest {
+			highest = value
+		}
+	}
+	if len(values) > 0 && highest > g.max {
+		return highest, fmt.Errorf("metrics query returned %g which is greater than the allowed maximum of %g", highest, g.max)
+	}
+	return highest, nil
+}
+
+// prometheusValues extracts the sample values from a scalar or vector result
+func prometheusValues(resultType string, result json.RawMessage) ([]float64, error) {
+	var samples [][2]interface{}
+	switch resultType {
+	case "scalar":
+		var sample [2]interface{}
+		if err := json.Unmarshal(result, &sample); err != nil {
+			return nil, err
+		}
+		samples = append(samples, sample)
+	case "vector":
+		var vector []struct {
+			Value [2]interface{} `json:"value"`
+		}
+		if err := json.Unmarshal(result, &vector); err != nil {
+			return nil, err
+		}
+		for _, entry := range vector {
+			samples = append(samples, entry.Value)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported metrics query result type '%s', expected 'scalar' or 'vector'", resultType)
+	}
+
+	values := make([]float64, 0, len(samples))
+	for _, sample := range samples {
+		raw, ok := sample[1].(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid sample value %v in metrics query result", sample[1])
+		}
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sample value '%s' in metrics query result: %w", raw, err)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
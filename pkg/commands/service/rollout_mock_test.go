@@ -0,0 +1,201 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+	"knative.dev/client/pkg/wait"
+)
+
+func TestServiceRolloutMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := createServiceForRollout("foo", "foo-00001", "foo-00002")
+	revisions := createRevisionsForRollback("foo", 2)
+	r.GetService("foo", service, nil)
+	for _, percent := range []int64{50, 100} {
+		r.GetService("foo", service, nil)
+		r.UpdateService(verifyRolloutTraffic("foo-00001", 100-percent, "foo-00002", percent), true, nil)
+		r.WaitForService("foo", mock.Any(), wait.NoopMessageCallback(), nil, time.Second)
+		r.GetRevision("foo-00002", &revisions.Items[1], nil)
+	}
+
+	output, err := executeServiceCommand(client, "rollout", "foo", "--steps", "50,100", "--interval", "1ms")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Step 1/2", "Step 2/2", "50%", "100%", "rolled out to revision 'foo-00002'"))
+	// The gates are checked after the interval also for the final step
+	assert.Equal(t, strings.Count(output, "Waiting 1ms before checking revision 'foo-00002'"), 2)
+
+	r.Validate()
+}
+
+func TestServiceRolloutMetricsGateMock(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		query = req.URL.Query().Get("query")
+		fmt.Fprint(w, `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"0.5"]}]}}`)
+	}))
+	defer server.Close()
+
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := createServiceForRollout("foo", "foo-00001", "foo-00002")
+	revisions := createRevisionsForRollback("foo", 2)
+	r.GetService("foo", service, nil)
+	r.GetService("foo", service, nil)
+	r.UpdateService(verifyRolloutTraffic("foo-00001", 90, "foo-00002", 10), true, nil)
+	r.WaitForService("foo", mock.Any(), wait.NoopMessageCallback(), nil, time.Second)
+	r.GetRevision("foo-00002", &revisions.Items[1], nil)
+	// Revert
+	r.GetService("foo", service, nil)
+	r.UpdateService(func(t *testing.T, svc *servingv1.Service) {
+		assert.DeepEqual(t, svc.Spec.Traffic, service.Spec.Traffic)
+	}, true, nil)
+	r.WaitForService("foo", mock.Any(), wait.NoopMessageCallback(), nil, time.Second)
+
+	output, err := executeServiceCommand(client, "rollout", "foo", "--steps", "10,100", "--interval", "0s",
+		"--metrics-url", server.URL, "--metrics-query", `errors{revision="{{.Revision}}",namespace="{{.Namespace}}"}`, "--metrics-max", "0.1")
+	assert.ErrorContains(t, err, "traffic has been reverted")
+	assert.ErrorContains(t, err, "greater than the allowed maximum of 0.1")
+	assert.Assert(t, util.ContainsAll(output, "Rollout failed", "Reverting traffic"))
+	assert.Equal(t, query, `errors{revision="foo-00002",namespace="default"}`)
+
+	r.Validate()
+}
+
+func TestMetricsGateNotANumber(t *testing.T) {
+	var result string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"0"]},{"metric":{},"value":[1700000000,"%s"]}]}}`, result)
+	}))
+	defer server.Close()
+
+	gate := &metricsGate{url: server.URL, query: "errors", max: 0.1, httpClient: server.Client()}
+	for _, value := range []string{"NaN", "+Inf", "-Inf"} {
+		result = value
+		_, err := gate.check(context.Background(), metricsQueryData{Revision: "foo-00002"})
+		assert.ErrorContains(t, err, "which is not a finite number")
+	}
+
+	result = "0.05"
+	value, err := gate.check(context.Background(), metricsQueryData{Revision: "foo-00002"})
+	assert.NilError(t, err)
+	assert.Equal(t, value, 0.05)
+}
+
+func TestServiceRolloutRevisionNotReadyMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := createServiceForRollout("foo", "foo-00001", "foo-00002")
+	revisions := createRevisionsForRollback("foo", 2)
+	revisions.Items[1].Status.Conditions = unknownConditions()
+	r.GetService("foo", service, nil)
+	r.GetService("foo", service, nil)
+	r.UpdateService(verifyRolloutTraffic("foo-00001", 50, "foo-00002", 50), true, nil)
+	r.WaitForService("foo", mock.Any(), wait.NoopMessageCallback(), nil, time.Second)
+	r.GetRevision("foo-00002", &revisions.Items[1], nil)
+	r.GetService("foo", service, nil)
+	r.UpdateService(mock.Any(), true, nil)
+	r.WaitForService("foo", mock.Any(), wait.NoopMessageCallback(), nil, time.Second)
+
+	_, err := executeServiceCommand(client, "rollout", "foo", "--steps", "50,100", "--interval", "0s")
+	assert.ErrorContains(t, err, "revision 'foo-00002' is not ready anymore")
+
+	r.Validate()
+}
+
+func TestServiceRolloutNothingToRollOutMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	r.GetService("foo", createServiceForRollout("foo", "foo-00002", "foo-00002"), nil)
+
+	_, err := executeServiceCommand(client, "rollout", "foo")
+	assert.ErrorContains(t, err, "already receives all traffic")
+
+	r.Validate()
+}
+
+func TestServiceRolloutInvalidFlags(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	for _, tc := range []struct {
+		args   []string
+		errMsg string
+	}{
+		{[]string{"--steps", "50,25"}, "must be ascending"},
+		{[]string{"--steps", "0,100"}, "must be ascending"},
+		{[]string{"--steps", "50,101"}, "between 1 and 100"},
+		{[]string{"--interval", "-1s"}, "must not be negative"},
+		{[]string{"--metrics-query", "up"}, "must be given together"},
+		{[]string{"--no-wait"}, "unknown flag: --no-wait"},
+	} {
+		_, err := executeServiceCommand(client, append([]string{"rollout", "foo"}, tc.args...)...)
+		assert.ErrorContains(t, err, tc.errMsg)
+	}
+}
+
+func TestPrometheusValues(t *testing.T) {
+	values, err := prometheusValues("scalar", []byte(`[1700000000,"1.5"]`))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, values, []float64{1.5})
+
+	values, err = prometheusValues("vector", []byte(`[]`))
+	assert.NilError(t, err)
+	assert.Equal(t, len(values), 0)
+
+	_, err = prometheusValues("matrix", []byte(`[]`))
+	assert.ErrorContains(t, err, "unsupported")
+
+	_, err = prometheusValues("scalar", []byte(`[1700000000,"NaN?"]`))
+	assert.ErrorContains(t, err, "invalid sample value")
+}
+
+func createServiceForRollout(name, routedRevision, latestReadyRevision string) *servingv1.Service {
+	service := createServiceWithImage(name, "gcr.io/foo/bar:latest")
+	service.Spec.Traffic = []servingv1.TrafficTarget{{RevisionName: routedRevision, LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)}}
+	service.Status.Traffic = []servingv1.TrafficTarget{{RevisionName: routedRevision, LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)}}
+	service.Status.LatestReadyRevisionName = latestReadyRevision
+	return service
+}
+
+func verifyRolloutTraffic(stable string, stablePercent int64, candidate string, candidatePercent int64) func(t *testing.T, svc *servingv1.Service) {
+	return func(t *testing.T, svc *servingv1.Service) {
+		expected := []servingv1.TrafficTarget{
+			{RevisionName: stable, LatestRevision: ptr.Bool(false), Percent: ptr.Int64(stablePercent)},
+			{RevisionName: candidate, LatestRevision: ptr.Bool(false), Percent: ptr.Int64(candidatePercent)},
+		}
+		if stablePercent == 0 {
+			expected = expected[1:]
+		}
+		assert.DeepEqual(t, svc.Spec.Traffic, expected)
+	}
+}
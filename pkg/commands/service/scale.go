@@ -0,0 +1,352 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/retry"
+	"knative.dev/pkg/kmap"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	autoscalingv1alpha1 "knative.dev/serving/pkg/apis/autoscaling/v1alpha1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	clientdynamic "knative.dev/client/pkg/dynamic"
+	"knative.dev/client/pkg/output/term"
+	"knative.dev/client/pkg/printers"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+)
+
+// Interval for refreshing the autoscaler status with --watch
+const scaleWatchInterval = 2 * time.Second
+
+var (
+	podAutoscalerGVR = autoscalingv1alpha1.SchemeGroupVersion.WithResource("podautoscalers")
+	deploymentGVR    = appsv1.SchemeGroupVersion.WithResource("deployments")
+)
+
+var scaleExample = `
+  # Show the autoscaler status of all revisions of service 'svc' which receive traffic
+  kn service scale svc
+
+  # Refresh the autoscaler status continuously
+  kn service scale svc --watch
+
+  # Keep at least one and at most five pods for the revisions receiving traffic, without creating a new revision
+  kn service scale svc --scale-min 1 --scale-max 5`
+
+// scaleFlags holds the flags for 'kn service scale'
+type scaleFlags struct {
+	minScale int
+	maxScale int
+	watch    bool
+}
+
+// NewServiceScaleCommand represents 'kn service scale' command
+func NewServiceScaleCommand(p *commands.KnParams) *cobra.Command {
+	var scaleFlags scaleFlags
+
+	command := &cobra.Command{
+		Use:   "scale NAME",
+		Short: "Show and adjust the autoscaling of a service",
+		Long: `Show and adjust the autoscaling of a service
+
+For every revision which receives traffic, the state of its PodAutoscaler and Deployment
+is shown: the number of desired, actual and ready pods, the scale bounds, the metric and
+target the autoscaler scales on and the panic threshold (in percent of the target) and
+panic window (in percent of the stable window), if configured.
+
+With --scale-min and --scale-max, the scale bounds of these revisions are updated in place,
+so that no new revision is created. New revisions still use the scale bounds of the
+service template, which can be changed with 'kn service update'.`,
+		Example:           scaleExample,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service scale' requires the service name given as single argument")
+			}
+			if scaleFlags.minScale < 0 || scaleFlags.maxScale < 0 {
+				return errors.New("--scale-min and --scale-max must not be negative")
+			}
+			if scaleFlags.maxScale > 0 && scaleFlags.minScale > scaleFlags.maxScale {
+				return fmt.Errorf("--scale-min (%d) must not be greater than --scale-max (%d)", scaleFlags.minScale, scaleFlags.maxScale)
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+			dynamicClient, err := p.NewDynamicClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+			out := cmd.OutOrStdout()
+			name := args[0]
+			if cmd.Flags().Changed("scale-min") || cmd.Flags().Changed("scale-max") {
+				bounds := map[string]int{}
+				if cmd.Flags().Changed("scale-min") {
+					bounds[autoscaling.MinScaleAnnotationKey] = scaleFlags.minScale
+				}
+				if cmd.Flags().Changed("scale-max") {
+					bounds[autoscaling.MaxScaleAnnotationKey] = scaleFlags.maxScale
+				}
+				if err := updateScaleBounds(ctx, client, name, bounds, out); err != nil {
+					return err
+				}
+			}
+
+			if !scaleFlags.watch {
+				return printScaleStatus(ctx, client, dynamicClient, name, out)
+			}
+			fancy := term.IsFancy(out)
+			for {
+				if fancy {
+					// Move the cursor to the top left corner and clear the screen
+					fmt.Fprint(out, "\033[H\033[2J")
+				} else {
+					fmt.Fprintln(out, time.Now().Format(time.RFC3339))
+				}
+				if err := printScaleStatus(ctx, client, dynamicClient, name, out); err != nil {
+					return err
+				}
+				if !fancy {
+					fmt.Fprintln(out, "")
+				}
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-time.After(scaleWatchInterval):
+				}
+			}
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.IntVar(&scaleFlags.minScale, "scale-min", 0, "Set the minimum number of replicas of the revisions receiving traffic.")
+	flags.IntVar(&scaleFlags.maxScale, "scale-max", 0, "Set the maximum number of replicas of the revisions receiving traffic. 0 means unlimited.")
+	flags.BoolVarP(&scaleFlags.watch, "watch", "w", false,
+		fmt.Sprintf("Refresh the autoscaler status every %s until interrupted.", scaleWatchInterval))
+	return command
+}
+
+// revisionScaleStatus holds the autoscaling related state of a single revision
+type revisionScaleStatus struct {
+	revision   string
+	percent    int64
+	pa         *autoscalingv1alpha1.PodAutoscaler
+	deployment *appsv1.Deployment
+}
+
+// printScaleStatus prints the autoscaler status of all revisions of the service which receive traffic
+func printScaleStatus(ctx context.Context, client clientservingv1.KnServingClient, dynamicClient clientdynamic.KnDynamicClient, name string, out io.Writer) error {
+	statuses, err := getScaleStatuses(ctx, client, dynamicClient, name)
+	if err != nil {
+		return err
+	}
+
+	dw := printers.NewPrefixWriter(out)
+	dw.WriteColsLn("REVISION", "TRAFFIC", "STATE", "DESIRED", "ACTUAL", "READY", "MIN", "MAX", "METRIC", "TARGET", "PANIC")
+	for _, status := range statuses {
+		dw.WriteColsLn(status.columns()...)
+	}
+	return dw.Flush()
+}
+
+func getScaleStatuses(ctx context.Context, client clientservingv1.KnServingClient, dynamicClient clientdynamic.KnDynamicClient, name string) ([]revisionScaleStatus, error) {
+	service, err := client.GetService(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	percentages := make(map[string]int64)
+	for _, target := range service.Status.Traffic {
+		if target.Percent != nil {
+			percentages[target.RevisionName] += *target.Percent
+		}
+	}
+	revisions := routedRevisions(service)
+	if len(revisions) == 0 {
+		return nil, fmt.Errorf("no revision of service '%s' in namespace '%s' receives traffic", name, client.Namespace())
+	}
+
+	var statuses []revisionScaleStatus
+	for _, revision := range revisions {
+		status := revisionScaleStatus{revision: revision, percent: percentages[revision]}
+		pa := &autoscalingv1alpha1.PodAutoscaler{}
+		found, err := getDynamicObject(ctx, dynamicClient, podAutoscalerGVR, revision, pa)
+		if err != nil {
+			return nil, err
+		}
+		deploymentName := revision + "-deployment"
+		if found {
+			status.pa = pa
+			if pa.Spec.ScaleTargetRef.Name != "" {
+				deploymentName = pa.Spec.ScaleTargetRef.Name
+			}
+		}
+		deployment := &appsv1.Deployment{}
+		found, err = getDynamicObject(ctx, dynamicClient, deploymentGVR, deploymentName, deployment)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			status.deployment = deployment
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// getDynamicObject fetches an object with the dynamic client and converts it into the given
+// typed object. It returns false if the object does not exist.
+func getDynamicObject(ctx context.Context, dynamicClient clientdynamic.KnDynamicClient, gvr schema.GroupVersionResource, name string, into interface{}) (bool, error) {
+	obj, err := dynamicClient.RawClient().Resource(gvr).Namespace(dynamicClient.Namespace()).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), into); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s revisionScaleStatus) columns() []string {
+	columns := []string{s.revision, fmt.Sprintf("%d%%", s.percent)}
+	if s.pa == nil {
+		columns = append(columns, "Unknown", "-", "-")
+	} else {
+		columns = append(columns, paState(s.pa), scaleValue(s.pa.Status.GetDesiredScale()), scaleValue(s.pa.Status.GetActualScale()))
+	}
+	if s.deployment == nil {
+		columns = append(columns, "-")
+	} else {
+		columns = append(columns, strconv.Itoa(int(s.deployment.Status.ReadyReplicas)))
+	}
+	if s.pa == nil {
+		return append(columns, "-", "-", "-", "-", "-")
+	}
+
+	annotations := s.pa.Annotations
+	target := "default"
+	if value, ok := s.pa.Target(); ok {
+		target = strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	panicSettings := "-"
+	threshold, window := annotationOrDefault(annotations, autoscaling.PanicThresholdPercentageAnnotation, "default"),
+		annotationOrDefault(annotations, autoscaling.PanicWindowPercentageAnnotation, "default")
+	if threshold != "default" || window != "default" {
+		panicSettings = threshold + "/" + window
+	}
+	return append(columns,
+		annotationOrDefault(annotations, autoscaling.MinScaleAnnotation, "0"),
+		annotationOrDefault(annotations, autoscaling.MaxScaleAnnotation, "-"),
+		s.pa.Metric(),
+		target,
+		panicSettings)
+}
+
+// paState returns whether the revision is active (has pods), activating or scaled to zero
+func paState(pa *autoscalingv1alpha1.PodAutoscaler) string {
+	switch {
+	case pa.Status.IsActive():
+		return "Active"
+	case pa.Status.IsActivating():
+		return "Activating"
+	case pa.Status.IsInactive():
+		return "Inactive"
+	}
+	return "Unknown"
+}
+
+func scaleValue(value int32) string {
+	if value < 0 {
+		return "-"
+	}
+	return strconv.Itoa(int(value))
+}
+
+func annotationOrDefault(annotations map[string]string, key kmap.KeyPriority, defaultValue string) string {
+	if _, value, ok := key.Get(annotations); ok && value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// updateScaleBounds sets the given scale annotations on all revisions which receive
+// traffic. Revision annotations can be changed without creating a new revision, and are
+// picked up by the PodAutoscaler of the revision.
+func updateScaleBounds(ctx context.Context, client clientservingv1.KnServingClient, name string, bounds map[string]int, out io.Writer) error {
+	service, err := client.GetService(ctx, name)
+	if err != nil {
+		return err
+	}
+	revisions := routedRevisions(service)
+	if len(revisions) == 0 {
+		return fmt.Errorf("no revision of service '%s' in namespace '%s' receives traffic", name, client.Namespace())
+	}
+	for _, revisionName := range revisions {
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			revision, err := client.GetRevision(ctx, revisionName)
+			if err != nil {
+				return err
+			}
+			setScaleAnnotations(revision, bounds)
+			return client.UpdateRevision(ctx, revision)
+		})
+		if err != nil {
+			return fmt.Errorf("cannot update scale bounds of revision '%s': %w", revisionName, err)
+		}
+		fmt.Fprintf(out, "Scale bounds of revision '%s' updated.\n", revisionName)
+	}
+	fmt.Fprintln(out, "")
+	return nil
+}
+
+func setScaleAnnotations(revision *servingv1.Revision, bounds map[string]int) {
+	if revision.Annotations == nil {
+		revision.Annotations = make(map[string]string)
+	}
+	for key, value := range bounds {
+		// Remove deprecated variants of the annotation which would take precedence otherwise
+		for _, priority := range []kmap.KeyPriority{autoscaling.MinScaleAnnotation, autoscaling.MaxScaleAnnotation} {
+			if priority.Key() == key {
+				for _, alias := range priority[1:] {
+					delete(revision.Annotations, alias)
+				}
+			}
+		}
+		revision.Annotations[key] = strconv.Itoa(value)
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	autoscalingv1alpha1 "knative.dev/serving/pkg/apis/autoscaling/v1alpha1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	clientdynamic "knative.dev/client/pkg/dynamic"
+	dynamicfake "knative.dev/client/pkg/dynamic/fake"
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+)
+
+func executeServiceScaleCommand(ctx context.Context, client knclient.KnServingClient, objects []runtime.Object, output *syncBuffer, args ...string) error {
+	knParams := &commands.KnParams{}
+	knParams.ClientConfig = blankConfig
+	knParams.NewServingClient = func(namespace string) (knclient.KnServingClient, error) {
+		return client, nil
+	}
+	knParams.NewDynamicClient = func(namespace string) (clientdynamic.KnDynamicClient, error) {
+		return dynamicfake.CreateFakeKnDynamicClient(namespace, objects...), nil
+	}
+	cmd := NewServiceCommand(knParams)
+	cmd.SetArgs(append([]string{"scale"}, args...))
+	cmd.SetOut(output)
+	return cmd.ExecuteContext(ctx)
+}
+
+func TestServiceScale(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", createServiceForScale("foo", map[string]int64{"foo-00001": 20, "foo-00002": 80}), nil)
+
+	pa := createTestPodAutoscaler("foo-00002", 3, 2, map[string]string{
+		autoscaling.MinScaleAnnotationKey:                 "1",
+		autoscaling.MaxScaleAnnotationKey:                 "10",
+		autoscaling.TargetAnnotationKey:                   "50",
+		autoscaling.MetricAnnotationKey:                   autoscaling.RPS,
+		autoscaling.PanicThresholdPercentageAnnotationKey: "300",
+	})
+	objects := []runtime.Object{
+		pa,
+		createTestDeployment("foo-00002-deployment", 2),
+		createTestPodAutoscaler("foo-00001", 0, 0, nil),
+	}
+
+	output := &syncBuffer{}
+	err := executeServiceScaleCommand(context.Background(), client, objects, output, "foo")
+	assert.NilError(t, err)
+	lines := strings.Split(output.String(), "\n")
+	assert.Assert(t, util.ContainsAll(lines[0], "REVISION", "TRAFFIC", "STATE", "DESIRED", "ACTUAL", "READY", "MIN", "MAX", "METRIC", "TARGET", "PANIC"))
+	assert.Assert(t, util.ContainsAll(lines[1], "foo-00002", "80%", "Active", "3", "2", "1", "10", "rps", "50", "300/default"))
+	assert.Assert(t, util.ContainsAll(lines[2], "foo-00001", "20%", "Inactive", "0", "-", "concurrency", "default"))
+
+	r.Validate()
+}
+
+func TestServiceScaleNoPodAutoscaler(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", createServiceForScale("foo", map[string]int64{"foo-00001": 100}), nil)
+	r.GetService("foo", createServiceForScale("foo", nil), nil)
+
+	output := &syncBuffer{}
+	err := executeServiceScaleCommand(context.Background(), client, nil, output, "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output.String(), "foo-00001", "100%", "Unknown"))
+
+	err = executeServiceScaleCommand(context.Background(), client, nil, output, "foo")
+	assert.ErrorContains(t, err, "no revision of service 'foo'")
+
+	r.Validate()
+}
+
+func TestServiceScaleBounds(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	service := createServiceForScale("foo", map[string]int64{"foo-00001": 100})
+	r.GetService("foo", service, nil)
+	revision := createTestRevision("foo-00001", 1, goodConditions())
+	revision.Annotations = map[string]string{"autoscaling.knative.dev/minScale": "2"}
+	r.GetRevision("foo-00001", &revision, nil)
+	r.UpdateRevision(func(t *testing.T, rev *servingv1.Revision) {
+		assert.DeepEqual(t, rev.Annotations, map[string]string{
+			autoscaling.MinScaleAnnotationKey: "1",
+			autoscaling.MaxScaleAnnotationKey: "5",
+		})
+	}, nil)
+	r.GetService("foo", service, nil)
+
+	output := &syncBuffer{}
+	err := executeServiceScaleCommand(context.Background(), client, nil, output, "foo", "--scale-min", "1", "--scale-max", "5")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output.String(), "Scale bounds of revision 'foo-00001' updated", "REVISION"))
+
+	r.Validate()
+}
+
+func TestServiceScaleErrors(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	output := &syncBuffer{}
+
+	err := executeServiceScaleCommand(context.Background(), client, nil, output, "foo", "--scale-min", "5", "--scale-max", "2")
+	assert.ErrorContains(t, err, "must not be greater than --scale-max")
+
+	err = executeServiceScaleCommand(context.Background(), client, nil, output, "foo", "--scale-min", "-1")
+	assert.ErrorContains(t, err, "must not be negative")
+
+	err = executeServiceScaleCommand(context.Background(), client, nil, output)
+	assert.ErrorContains(t, err, "requires the service name")
+}
+
+func TestServiceScaleWatch(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	// The watch refreshes until the context is done, so provide enough recordings
+	for i := 0; i < 3; i++ {
+		r.GetService("foo", createServiceForScale("foo", map[string]int64{"foo-00001": 100}), nil)
+	}
+	objects := []runtime.Object{createTestPodAutoscaler("foo-00001", 1, 1, nil)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), scaleWatchInterval+time.Second)
+	defer cancel()
+	output := &syncBuffer{}
+	err := executeServiceScaleCommand(ctx, client, objects, output, "foo", "--watch")
+	assert.NilError(t, err)
+	assert.Equal(t, strings.Count(output.String(), "REVISION"), 2)
+}
+
+func createServiceForScale(name string, traffic map[string]int64) *servingv1.Service {
+	service := &servingv1.Service{}
+	service.Name = name
+	service.Status.URL = &apis.URL{Scheme: "http", Host: name + ".default.example.com"}
+	for revision, percent := range traffic {
+		service.Status.Traffic = append(service.Status.Traffic, servingv1.TrafficTarget{RevisionName: revision, Percent: ptr.Int64(percent)})
+	}
+	return service
+}
+
+func createTestPodAutoscaler(revision string, desired, actual int32, annotations map[string]string) *autoscalingv1alpha1.PodAutoscaler {
+	pa := &autoscalingv1alpha1.PodAutoscaler{
+		TypeMeta: metav1.TypeMeta{APIVersion: autoscalingv1alpha1.SchemeGroupVersion.String(), Kind: "PodAutoscaler"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        revision,
+			Namespace:   "default",
+			Annotations: annotations,
+		},
+		Spec: autoscalingv1alpha1.PodAutoscalerSpec{
+			ScaleTargetRef: corev1.ObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: revision + "-deployment"},
+		},
+	}
+	pa.Status.DesiredScale = ptr.Int32(desired)
+	pa.Status.ActualScale = ptr.Int32(actual)
+	status := corev1.ConditionTrue
+	if desired == 0 {
+		status = corev1.ConditionFalse
+	}
+	pa.Status.Conditions = duckv1.Conditions{{Type: autoscalingv1alpha1.PodAutoscalerConditionActive, Status: status}}
+	return pa
+}
+
+func createTestDeployment(name string, ready int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Status:     appsv1.DeploymentStatus{ReadyReplicas: ready},
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"io"
 	"time"
 
+	"k8s.io/apimachinery/pkg/runtime"
+
 	"knative.dev/client/pkg/commands"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
 	"knative.dev/client/pkg/wait"
@@ -38,10 +40,21 @@ func NewServiceCommand(p *commands.KnParams) *cobra.Command {
 	serviceCmd.AddCommand(NewServiceCreateCommand(p))
 	serviceCmd.AddCommand(NewServiceDeleteCommand(p))
 	serviceCmd.AddCommand(NewServiceUpdateCommand(p))
+	serviceCmd.AddCommand(NewServiceRollbackCommand(p))
+	serviceCmd.AddCommand(NewServicePromoteCommand(p))
+	serviceCmd.AddCommand(NewServiceRolloutCommand(p))
+	serviceCmd.AddCommand(NewServiceTrafficCommand(p))
 	serviceCmd.AddCommand(NewServiceApplyCommand(p))
+	serviceCmd.AddCommand(NewServiceDiffCommand(p))
 	serviceCmd.AddCommand(NewServiceExportCommand(p))
 	serviceCmd.AddCommand(NewServiceImportCommand(p))
 	serviceCmd.AddCommand(NewServiceWaitCommand(p))
+	serviceCmd.AddCommand(NewServiceLogsCommand(p))
+	serviceCmd.AddCommand(NewServiceInvokeCommand(p))
+	serviceCmd.AddCommand(NewServiceProxyCommand(p))
+	serviceCmd.AddCommand(NewServiceScaleCommand(p))
+	serviceCmd.AddCommand(NewServiceHistoryCommand(p))
+	serviceCmd.AddCommand(NewServiceLintCommand(p))
 	return serviceCmd
 }
 
@@ -54,6 +67,30 @@ func waitForService(ctx context.Context, client clientservingv1.KnServingClient,
 	return nil
 }
 
+// serviceWaitTask creates a task waiting for a service to become ready, for waiting
+// for multiple services at the same time
+func serviceWaitTask(client clientservingv1.KnServingClient, name string, wconfig clientservingv1.WaitConfig) wait.Task {
+	return wait.Task{
+		Name: name,
+		Wait: func(ctx context.Context, msgCallback wait.MessageCallback) error {
+			err, _ := client.WaitForService(ctx, name, wconfig, msgCallback)
+			return err
+		},
+	}
+}
+
+// selectServices returns the names of the services matching the given label selector
+func selectServices(ctx context.Context, client clientservingv1.KnServingClient, selector string) ([]string, error) {
+	parsed, err := commands.ParseSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	list := func(ctx context.Context) (runtime.Object, error) {
+		return client.ListServices(ctx)
+	}
+	return commands.ListSelectedNames(ctx, list, parsed, "service", client.Namespace())
+}
+
 func showUrl(ctx context.Context, client clientservingv1.KnServingClient, serviceName string, originalRevision string, what string, out io.Writer) error {
 	service, err := client.GetService(ctx, serviceName)
 	if err != nil {
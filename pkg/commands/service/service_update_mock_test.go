@@ -16,18 +16,21 @@ package service
 
 import (
 	"testing"
+	"time"
 
 	"knative.dev/serving/pkg/apis/autoscaling"
 
 	"gotest.tools/v3/assert"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	clientserving "knative.dev/client/pkg/serving"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
 	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
 	"knative.dev/pkg/ptr"
 )
 
@@ -1533,3 +1536,143 @@ func TestServiceUpdateInitialScaleMock(t *testing.T) {
 
 	r.Validate()
 }
+
+func TestServiceUpdateMultipleServicesMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	for _, name := range []string{"foo", "bar"} {
+		r.GetService(name, getService(name), nil)
+		r.UpdateService(func(t *testing.T, service *servingv1.Service) {
+			assert.DeepEqual(t, service.Spec.Template.Spec.Containers[0].Env, []corev1.EnvVar{{Name: "a", Value: "b"}})
+		}, true, nil)
+	}
+	r.WaitForService(mock.Any(), mock.Any(), mock.Any(), nil, time.Second)
+	r.WaitForService(mock.Any(), mock.Any(), mock.Any(), errors.NewTimeoutError("not ready", 1), time.Second)
+
+	output, err := executeServiceCommand(client, "update", "foo", "bar", "--env", "a=b", "--wait-concurrency", "1")
+	assert.ErrorContains(t, err, "waiting failed for 1 of 2 services")
+	assert.Assert(t, util.ContainsAll(output,
+		"Service 'foo' updated in namespace 'default'.", "Service 'bar' updated in namespace 'default'.",
+		"Waiting for 2 services in namespace 'default':", "NAME", "RESULT", "ELAPSED", "Succeeded", "Failed", "not ready"))
+
+	r.Validate()
+}
+
+func TestServiceUpdateMultipleServicesFailureMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	r.GetService("foo", nil, errors.NewNotFound(servingv1.Resource("service"), "foo"))
+	r.GetService("bar", getService("bar"), nil)
+	r.UpdateService(mock.Any(), true, nil)
+	r.WaitForService("bar", mock.Any(), mock.Any(), nil, time.Second)
+
+	output, err := executeServiceCommand(client, "update", "foo", "bar", "--env", "a=b")
+	assert.ErrorContains(t, err, "update failed for 1 of 2 services:\nfoo: ")
+	assert.ErrorContains(t, err, "not found")
+	assert.Assert(t, util.ContainsAll(output,
+		"Service 'foo' in namespace 'default' not updated:", "Service 'bar' updated in namespace 'default'.",
+		"Waiting for 1 service in namespace 'default':", "Succeeded", "Updated 1 of 2 services in namespace 'default'."))
+
+	r.Validate()
+}
+
+func TestServiceUpdateSelectorMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+	shop := getService("shop")
+	shop.Labels = map[string]string{"app": "shop"}
+	r.ListServices(mock.Any(), &servingv1.ServiceList{Items: []servingv1.Service{*shop, *getService("other")}}, nil)
+	r.GetService("shop", shop, nil)
+	r.UpdateService(mock.Any(), false, nil)
+
+	output, err := executeServiceCommand(client, "update", "--selector", "app=shop", "--env", "a=b")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Service 'shop' updated in namespace 'default' (unchanged)."))
+	assert.Assert(t, util.ContainsNone(output, "other", "Waiting"))
+
+	r.ListServices(mock.Any(), &servingv1.ServiceList{Items: []servingv1.Service{*getService("other")}}, nil)
+	_, err = executeServiceCommand(client, "update", "--selector", "app=shop", "--env", "a=b")
+	assert.ErrorContains(t, err, "no services found matching selector 'app=shop' in namespace 'default'")
+
+	r.Validate()
+}
+
+func TestServiceUpdateContainerNameMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := getService("foo")
+	template := &service.Spec.Template
+	template.Annotations = map[string]string{clientserving.UserImageAnnotationKey: "gcr.io/foo/app:v1"}
+	template.Spec.Containers = []corev1.Container{
+		{Name: "sidecar", Image: "gcr.io/foo/sidecar:v1"},
+		{Name: "app", Image: "gcr.io/foo/app:v1", Ports: []corev1.ContainerPort{{ContainerPort: 8080}}},
+	}
+	service.Status.LatestCreatedRevisionName = "foo-00001"
+	revision := &servingv1.Revision{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-00001", Namespace: "default"},
+		Spec:       *template.Spec.DeepCopy(),
+		Status: servingv1.RevisionStatus{ContainerStatuses: []servingv1.ContainerStatus{
+			{Name: "sidecar", ImageDigest: "gcr.io/foo/sidecar@sha256:1"},
+			{Name: "app", ImageDigest: "gcr.io/foo/app@sha256:2"},
+		}},
+	}
+
+	updated := service.DeepCopy()
+	containers := updated.Spec.Template.Spec.Containers
+	containers[0].Image = "gcr.io/foo/sidecar:v2"
+	containers[0].Env = []corev1.EnvVar{{Name: "a", Value: "b"}}
+	containers[0].Resources = corev1.ResourceRequirements{Limits: corev1.ResourceList{}, Requests: corev1.ResourceList{}}
+	// The image of the main container is pinned as no image is given for it
+	containers[1].Image = "gcr.io/foo/app@sha256:2"
+
+	r.GetService("foo", service, nil)
+	r.GetRevision("foo-00001", revision, nil)
+	r.UpdateService(verifyService(updated, true), true, nil)
+	output, err := executeServiceCommand(client, "update", "foo", "--container-name", "sidecar", "--image", "gcr.io/foo/sidecar:v2", "--env", "a=b", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "updated", "foo"))
+
+	// Adding a container requires an image
+	r.GetService("foo", service, nil)
+	r.GetRevision("foo-00001", revision, nil)
+	_, err = executeServiceCommand(client, "update", "foo", "--container-name", "proxy", "--env", "a=b", "--no-wait")
+	assert.ErrorContains(t, err, "container 'proxy' doesn't exist, an image is required to add it")
+
+	r.Validate()
+}
+
+func TestServiceUpdateContainerNameAddSidecarMock(t *testing.T) {
+	client := clientservingv1.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	// A service created with --image only has a single container without a port
+	service := getService("foo")
+	template := &service.Spec.Template
+	template.Annotations = map[string]string{clientserving.UserImageAnnotationKey: "gcr.io/foo/app:v1"}
+	template.Spec.Containers = []corev1.Container{{Image: "gcr.io/foo/app:v1"}}
+	service.Status.LatestCreatedRevisionName = "foo-00001"
+	revision := &servingv1.Revision{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-00001", Namespace: "default"},
+		Spec:       *template.Spec.DeepCopy(),
+		Status: servingv1.RevisionStatus{ContainerStatuses: []servingv1.ContainerStatus{
+			{ImageDigest: "gcr.io/foo/app@sha256:1"},
+		}},
+	}
+
+	updated := service.DeepCopy()
+	updated.Spec.Template.Spec.Containers = []corev1.Container{
+		{Image: "gcr.io/foo/app@sha256:1", Ports: []corev1.ContainerPort{{ContainerPort: 8080}}},
+		{Name: "sidecar", Image: "gcr.io/foo/sidecar:v1",
+			Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{}, Requests: corev1.ResourceList{}}},
+	}
+
+	r.GetService("foo", service, nil)
+	r.GetRevision("foo-00001", revision, nil)
+	r.UpdateService(verifyService(updated, true), true, nil)
+	output, err := executeServiceCommand(client, "update", "foo", "--container-name", "sidecar", "--image", "gcr.io/foo/sidecar:v1", "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "updated", "foo"))
+
+	r.Validate()
+}
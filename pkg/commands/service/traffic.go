@@ -0,0 +1,124 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/printers"
+	"knative.dev/client/pkg/traffic"
+)
+
+// NewServiceTrafficCommand represents 'kn service traffic' command group
+func NewServiceTrafficCommand(p *commands.KnParams) *cobra.Command {
+	trafficCmd := &cobra.Command{
+		Use:   "traffic",
+		Short: "Manage the traffic of a service with traffic plans",
+		Long: `Manage the traffic of a service with traffic plans
+
+A traffic plan describes the complete traffic of a service in YAML or JSON:
+
+  service: mysvc
+  targets:
+  # The latest ready revision with tag 'current'
+  - revision: "@latest"
+    percent: 80
+    tags: [current]
+  # A revision given by its name
+  - revision: mysvc-00001
+    percent: 20
+    tags: [stable, v1]
+  # The revision which currently has the tag 'candidate', keeping the tag
+  - tag: candidate
+    percent: 0
+
+The percentages have to sum to 100. Traffic targets of the service which are not part of
+the plan are removed when the plan is applied.`,
+	}
+	trafficCmd.AddCommand(NewServiceTrafficGetCommand(p))
+	trafficCmd.AddCommand(NewServiceTrafficApplyCommand(p))
+	return trafficCmd
+}
+
+// NewServiceTrafficGetCommand represents 'kn service traffic get' command
+func NewServiceTrafficGetCommand(p *commands.KnParams) *cobra.Command {
+	machineReadablePrintFlags := genericclioptions.NewPrintFlags("")
+	command := &cobra.Command{
+		Use:   "get NAME",
+		Short: "Show the traffic of a service as traffic plan",
+		Example: `
+  # Show the traffic of service 'mysvc'
+  kn service traffic get mysvc
+
+  # Save the traffic of service 'mysvc' as traffic plan, which can be applied again
+  kn service traffic get mysvc -o yaml > plan.yaml`,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("'service traffic get' requires the service name given as single argument")
+			}
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+			service, err := client.GetService(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			plan := traffic.PlanFromService(service)
+			if machineReadablePrintFlags.OutputFlagSpecified() {
+				printer, err := machineReadablePrintFlags.ToPrinter()
+				if err != nil {
+					return err
+				}
+				obj, err := printers.RawObject(plan)
+				if err != nil {
+					return err
+				}
+				return printer.PrintObj(obj, cmd.OutOrStdout())
+			}
+			return printTrafficPlan(cmd.OutOrStdout(), service, plan)
+		},
+	}
+	commands.AddNamespaceFlags(command.Flags(), false)
+	machineReadablePrintFlags.AddFlags(command)
+	return command
+}
+
+// printTrafficPlan prints the plan as a table
+func printTrafficPlan(out io.Writer, service *servingv1.Service, plan *traffic.Plan) error {
+	dw := printers.NewPrefixWriter(out)
+	dw.WriteColsLn("REVISION", "PERCENT", "TAGS")
+	for _, target := range plan.Targets {
+		revision := target.Revision
+		if revision == "@latest" && service.Status.LatestReadyRevisionName != "" {
+			revision = fmt.Sprintf("@latest (%s)", service.Status.LatestReadyRevisionName)
+		}
+		dw.WriteColsLn(revision, fmt.Sprintf("%d%%", target.Percent), strings.Join(target.Tags, ","))
+	}
+	return dw.Flush()
+}
@@ -0,0 +1,151 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"knative.dev/client/pkg/commands"
+	"knative.dev/client/pkg/config"
+	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/traffic"
+)
+
+// NewServiceTrafficApplyCommand represents 'kn service traffic apply' command
+func NewServiceTrafficApplyCommand(p *commands.KnParams) *cobra.Command {
+	var waitFlags commands.WaitFlags
+	var filename string
+	command := &cobra.Command{
+		Use:   "apply [NAME] -f FILENAME",
+		Short: "Apply a traffic plan to a service",
+		Long: `Apply a traffic plan to a service
+
+The service is given as argument or with the 'service' field of the plan. The traffic of
+the service is replaced with the traffic described by the plan in a single update.`,
+		Example: `
+  # Apply the traffic plan in 'plan.yaml' to service 'mysvc'
+  kn service traffic apply mysvc -f plan.yaml
+
+  # Save the traffic of service 'mysvc', edit the plan and apply it again
+  kn service traffic get mysvc -o yaml > plan.yaml
+  kn service traffic apply -f plan.yaml
+
+  # Apply a traffic plan read from stdin, which splits the traffic between two revisions
+  printf 'targets:\n- revision: mysvc-00001\n  percent: 50\n- revision: "@latest"\n  percent: 50\n' | kn service traffic apply mysvc -f -`,
+		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 1 {
+				return errors.New("'service traffic apply' accepts the service name as single argument only")
+			}
+			if filename == "" {
+				return errors.New("'service traffic apply' requires the traffic plan given with --filename")
+			}
+			plan, err := readTrafficPlan(filename, cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+			name := plan.Service
+			if len(args) == 1 {
+				if name != "" && name != args[0] {
+					return fmt.Errorf("traffic plan is meant for service '%s', not for service '%s'", name, args[0])
+				}
+				name = args[0]
+			}
+			if name == "" {
+				return errors.New("'service traffic apply' requires the service name given as argument or with the 'service' field of the traffic plan")
+			}
+
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			client, err := p.NewServingClient(namespace)
+			if err != nil {
+				return err
+			}
+
+			revisionList, err := client.ListRevisions(cmd.Context(), clientservingv1.WithService(name))
+			if err != nil {
+				return err
+			}
+			existing := make(map[string]bool, len(revisionList.Items))
+			for _, revision := range revisionList.Items {
+				existing[revision.Name] = true
+			}
+			updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
+				targets, err := traffic.ComputePlan(service, plan)
+				if err != nil {
+					return nil, err
+				}
+				for _, target := range targets {
+					if target.RevisionName != "" && !existing[target.RevisionName] {
+						return nil, fmt.Errorf("revision '%s' of traffic plan not found for service '%s' in namespace '%s'", target.RevisionName, name, namespace)
+					}
+				}
+				service.Spec.Traffic = targets
+				return service, nil
+			}
+			changed, err := client.UpdateServiceWithRetry(cmd.Context(), name, updateFunc, config.DefaultRetry.Steps)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if !changed {
+				fmt.Fprintf(out, "Traffic of service '%s' in namespace '%s' already matches the traffic plan.\n", name, namespace)
+				return nil
+			}
+			if !waitFlags.Wait {
+				fmt.Fprintf(out, "Traffic plan applied to service '%s' in namespace '%s'.\n", name, namespace)
+				return nil
+			}
+			fmt.Fprintf(out, "Applying traffic plan to service '%s' in namespace '%s':\n", name, namespace)
+			fmt.Fprintln(out, "")
+			wconfig := clientservingv1.WaitConfig{
+				Timeout:     time.Duration(waitFlags.TimeoutInSeconds) * time.Second,
+				ErrorWindow: time.Duration(waitFlags.ErrorWindowInSeconds) * time.Second,
+			}
+			return waitForService(cmd.Context(), client, name, out, wconfig)
+		},
+	}
+	flags := command.Flags()
+	commands.AddNamespaceFlags(flags, false)
+	flags.StringVarP(&filename, "filename", "f", "", "Path to the traffic plan in YAML or JSON format, or '-' for reading it from stdin.")
+	command.MarkFlagFilename("filename")
+	waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "apply", "service", "ready")
+	return command
+}
+
+// readTrafficPlan reads a traffic plan from the given file or from stdin if the filename is '-'
+func readTrafficPlan(filename string, stdin io.Reader) (*traffic.Plan, error) {
+	var data []byte
+	var err error
+	if filename == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(filename)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read traffic plan: %w", err)
+	}
+	return traffic.ParsePlan(data)
+}
@@ -0,0 +1,179 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	"knative.dev/pkg/ptr"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	knclient "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/util/mock"
+	"knative.dev/client/pkg/wait"
+)
+
+func TestServiceTrafficGetMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	service := createServiceForTrafficPlan("foo")
+	r.GetService("foo", service, nil)
+	r.GetService("foo", service, nil)
+	r.GetService("foo", service, nil)
+
+	output, err := executeServiceCommand(client, "traffic", "get", "foo")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "REVISION", "PERCENT", "TAGS"))
+	assert.Assert(t, util.ContainsAll(output, "@latest (foo-00002)", "80%", "current"))
+	assert.Assert(t, util.ContainsAll(output, "foo-00001", "20%", "stable,v1"))
+
+	output, err = executeServiceCommand(client, "traffic", "get", "foo", "-o", "yaml")
+	assert.NilError(t, err)
+	assert.Equal(t, output, `service: foo
+targets:
+- percent: 80
+  revision: '@latest'
+  tags:
+  - current
+- percent: 20
+  revision: foo-00001
+  tags:
+  - stable
+  - v1
+`)
+
+	output, err = executeServiceCommand(client, "traffic", "get", "foo", "-o", "json")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, `"service": "foo"`, `"revision": "@latest"`, `"percent": 80`))
+
+	r.GetService("foo", service, nil)
+	output, err = executeServiceCommand(client, "traffic", "get", "foo", "-o", "jsonpath={.targets[1].revision}")
+	assert.NilError(t, err)
+	assert.Equal(t, output, "foo-00001")
+
+	r.GetService("foo", service, nil)
+	_, err = executeServiceCommand(client, "traffic", "get", "foo", "-o", "table")
+	assert.ErrorContains(t, err, "unable to match a printer")
+
+	r.Validate()
+}
+
+func TestServiceTrafficApplyMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	planFile := writeTrafficPlan(t, `
+service: foo
+targets:
+- revision: foo-00001
+  percent: 50
+  tags: [stable]
+- tag: current
+  percent: 50
+`)
+	r.ListRevisions(mock.Any(), createRevisionsForRollback("foo", 2), nil)
+	r.GetService("foo", createServiceForTrafficPlan("foo"), nil)
+	r.UpdateService(func(t *testing.T, svc *servingv1.Service) {
+		assert.DeepEqual(t, svc.Spec.Traffic, []servingv1.TrafficTarget{
+			{Tag: "stable", RevisionName: "foo-00001", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(50)},
+			{Tag: "current", LatestRevision: ptr.Bool(true), Percent: ptr.Int64(50)},
+		})
+	}, true, nil)
+	r.WaitForService("foo", mock.Any(), wait.NoopMessageCallback(), nil, time.Second)
+
+	output, err := executeServiceCommand(client, "traffic", "apply", "-f", planFile)
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Applying traffic plan to service 'foo'", "Ready to serve"))
+
+	r.Validate()
+}
+
+func TestServiceTrafficApplyNoWaitMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	planFile := writeTrafficPlan(t, "targets:\n- revision: foo-00002\n  percent: 100\n")
+	r.ListRevisions(mock.Any(), createRevisionsForRollback("foo", 2), nil)
+	r.GetService("foo", createServiceForTrafficPlan("foo"), nil)
+	r.UpdateService(func(t *testing.T, svc *servingv1.Service) {
+		assert.DeepEqual(t, svc.Spec.Traffic, []servingv1.TrafficTarget{
+			{RevisionName: "foo-00002", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(100)},
+		})
+	}, true, nil)
+
+	output, err := executeServiceCommand(client, "traffic", "apply", "foo", "-f", planFile, "--no-wait")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(output, "Traffic plan applied to service 'foo'", "default"))
+
+	r.Validate()
+}
+
+func TestServiceTrafficApplyErrorsMock(t *testing.T) {
+	client := knclient.NewMockKnServiceClient(t)
+	r := client.Recorder()
+
+	_, err := executeServiceCommand(client, "traffic", "apply", "foo")
+	assert.ErrorContains(t, err, "requires the traffic plan given with --filename")
+
+	_, err = executeServiceCommand(client, "traffic", "apply", "foo", "-f", filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorContains(t, err, "cannot read traffic plan")
+
+	planFile := writeTrafficPlan(t, "targets:\n- revision: foo-00001\n  percent: 100\n")
+	_, err = executeServiceCommand(client, "traffic", "apply", "-f", planFile)
+	assert.ErrorContains(t, err, "requires the service name given as argument or with the 'service' field")
+
+	planFile = writeTrafficPlan(t, "service: bar\ntargets:\n- revision: foo-00001\n  percent: 100\n")
+	_, err = executeServiceCommand(client, "traffic", "apply", "foo", "-f", planFile)
+	assert.ErrorContains(t, err, "traffic plan is meant for service 'bar'")
+
+	planFile = writeTrafficPlan(t, "targets:\n- revision: foo-00001\n  percent: 60\n")
+	r.ListRevisions(mock.Any(), createRevisionsForRollback("foo", 2), nil)
+	r.GetService("foo", createServiceForTrafficPlan("foo"), nil)
+	_, err = executeServiceCommand(client, "traffic", "apply", "foo", "-f", planFile)
+	assert.ErrorContains(t, err, "traffic plan percents sum to 60, want 100")
+
+	planFile = writeTrafficPlan(t, "targets:\n- revision: foo-00009\n  percent: 100\n")
+	r.ListRevisions(mock.Any(), createRevisionsForRollback("foo", 2), nil)
+	r.GetService("foo", createServiceForTrafficPlan("foo"), nil)
+	_, err = executeServiceCommand(client, "traffic", "apply", "foo", "-f", planFile)
+	assert.ErrorContains(t, err, "revision 'foo-00009' of traffic plan not found for service 'foo'")
+
+	r.Validate()
+}
+
+// createServiceForTrafficPlan creates a service whose latest revision 'foo-00002' with tag 'current' receives
+// 80% of the traffic and whose revision 'foo-00001' with tags 'stable' and 'v1' receives 20%
+func createServiceForTrafficPlan(name string) *servingv1.Service {
+	service := createServiceWithImage(name, "gcr.io/foo/bar:latest")
+	service.Spec.Traffic = []servingv1.TrafficTarget{
+		{Tag: "current", LatestRevision: ptr.Bool(true), Percent: ptr.Int64(80)},
+		{Tag: "stable", RevisionName: name + "-00001", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(20)},
+		{Tag: "v1", RevisionName: name + "-00001", LatestRevision: ptr.Bool(false), Percent: ptr.Int64(0)},
+	}
+	service.Status.LatestReadyRevisionName = name + "-00002"
+	return service
+}
+
+func writeTrafficPlan(t *testing.T, content string) string {
+	planFile := filepath.Join(t.TempDir(), "plan.yaml")
+	assert.NilError(t, os.WriteFile(planFile, []byte(content), 0600))
+	return planFile
+}
@@ -17,6 +17,7 @@ package service
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -29,6 +30,7 @@ import (
 
 	"knative.dev/client/pkg/commands"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
+	"knative.dev/client/pkg/wait"
 )
 
 var updateExample = `
@@ -56,6 +58,12 @@ var updateExample = `
   # rest will automatically be directed to echo-v3 (the remaining revision)
   kn service update svc --traffic stable=50,staging=40
 
+  # Update the services 'frontend' and 'backend' and wait for both of them at the same time
+  kn service update frontend backend --env LOG_LEVEL=debug
+
+  # Update all services with the label 'app=shop'
+  kn service update --selector app=shop --env LOG_LEVEL=debug
+
   # Update the service in offline mode instead of kubernetes cluster (Beta)
   kn service update gitopstest -n test-ns --env KEY1=VALUE1 --target=/user/knfiles
   kn service update gitopstest --env KEY1=VALUE1 --target=/user/knfiles/test.yaml
@@ -65,14 +73,18 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 	var editFlags ConfigurationEditFlags
 	var waitFlags commands.WaitFlags
 	var trafficFlags flags.Traffic
+	var selector string
 	serviceUpdateCommand := &cobra.Command{
-		Use:               "update NAME",
+		Use:               "update NAME [NAME ...]",
 		Short:             "Update a service",
 		Example:           updateExample,
 		ValidArgsFunction: commands.ResourceNameCompletionFunc(p),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			if len(args) != 1 {
-				return errors.New("'service update' requires the service name given as single argument")
+			if len(args) == 0 && selector == "" {
+				return errors.New("'service update' requires the service name given as argument or a --selector")
+			}
+			if len(args) > 0 && selector != "" {
+				return errors.New("'service update' doesn't support service names together with --selector")
 			}
 
 			namespace, err := p.GetNamespace(cmd)
@@ -85,61 +97,105 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 				return err
 			}
 
-			// Use to store the latest revision name
-			var latestRevisionBeforeUpdate string
-			name := args[0]
-
-			updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
-				latestRevisionBeforeUpdate = service.Status.LatestReadyRevisionName
-				var baseRevision *servingv1.Revision
-				if isImagePinned(cmd, editFlags) {
-					baseRevision, err = client.GetBaseRevision(cmd.Context(), service)
-					var errNoBaseRevision clientservingv1.NoBaseRevisionError
-					if errors.As(err, &errNoBaseRevision) {
-						fmt.Fprintf(cmd.OutOrStdout(), "Warning: No revision found to update image digest")
+			// Update a single service and return the latest ready revision before the update
+			updateService := func(name string) (changed bool, latestRevisionBeforeUpdate string, err error) {
+				updateFunc := func(service *servingv1.Service) (*servingv1.Service, error) {
+					latestRevisionBeforeUpdate = service.Status.LatestReadyRevisionName
+					var baseRevision *servingv1.Revision
+					if isImagePinned(cmd, editFlags, &service.Spec.Template.Spec) {
+						baseRevision, err = client.GetBaseRevision(cmd.Context(), service)
+						var errNoBaseRevision clientservingv1.NoBaseRevisionError
+						if errors.As(err, &errNoBaseRevision) {
+							fmt.Fprintf(cmd.OutOrStdout(), "Warning: No revision found to update image digest")
+						}
 					}
-				}
-				err = editFlags.Apply(service, baseRevision, cmd)
-				if err != nil {
-					return nil, err
-				}
-
-				if trafficFlags.Changed(cmd) {
-					revisions, err := client.ListRevisions(cmd.Context(), clientservingv1.WithService(service.Name))
+					err = editFlags.Apply(service, baseRevision, cmd)
 					if err != nil {
 						return nil, err
 					}
-					traffic, err := traffic.Compute(cmd, service, &trafficFlags, revisions.Items, editFlags.AnyMutation(cmd))
-					if err != nil {
+					if err := resolveImageDigests(cmd, p, editFlags, namespace, &service.Spec.Template); err != nil {
 						return nil, err
 					}
 
-					service.Spec.Traffic = traffic
+					if trafficFlags.Changed(cmd) {
+						revisions, err := client.ListRevisions(cmd.Context(), clientservingv1.WithService(service.Name))
+						if err != nil {
+							return nil, err
+						}
+						traffic, err := traffic.Compute(cmd, service, &trafficFlags, revisions.Items, editFlags.AnyMutation(cmd))
+						if err != nil {
+							return nil, err
+						}
+
+						service.Spec.Traffic = traffic
+					}
+					return service, nil
+				}
+
+				// Do the actual update with retry in case of conflicts
+				changed, err = client.UpdateServiceWithRetry(cmd.Context(), name, updateFunc, config.DefaultRetry.Steps)
+				return changed, latestRevisionBeforeUpdate, err
+			}
+
+			out := cmd.OutOrStdout()
+			wconfig := clientservingv1.WaitConfig{
+				Timeout:     time.Duration(waitFlags.TimeoutInSeconds) * time.Second,
+				ErrorWindow: time.Duration(waitFlags.ErrorWindowInSeconds) * time.Second,
+			}
+			if selector != "" || len(args) > 1 {
+				names := args
+				if selector != "" {
+					if names, err = selectServices(cmd.Context(), client, selector); err != nil {
+						return err
+					}
+				}
+				// Failed updates don't stop the remaining services from being updated and waited for
+				var tasks []wait.Task
+				var updateErrs []string
+				for _, name := range names {
+					changed, _, err := updateService(name)
+					if err != nil {
+						fmt.Fprintf(out, "Service '%s' in namespace '%s' not updated: %v\n", name, namespace, err)
+						updateErrs = append(updateErrs, fmt.Sprintf("%s: %v", name, err))
+						continue
+					}
+					if !changed {
+						fmt.Fprintf(out, "Service '%s' updated in namespace '%s' (unchanged).\n", name, namespace)
+						continue
+					}
+					fmt.Fprintf(out, "Service '%s' updated in namespace '%s'.\n", name, namespace)
+					tasks = append(tasks, serviceWaitTask(client, name, wconfig))
+				}
+				var waitErr error
+				if waitFlags.Wait && targetFlag == "" && len(tasks) > 0 {
+					fmt.Fprintln(out, "")
+					waitErr = commands.WaitForAll(cmd, "Service", namespace, tasks, waitFlags.Concurrency)
 				}
-				return service, nil
+				if len(updateErrs) == 0 {
+					return waitErr
+				}
+				fmt.Fprintln(out, "")
+				fmt.Fprintf(out, "Updated %d of %d services in namespace '%s'.\n", len(names)-len(updateErrs), len(names), namespace)
+				updateErr := fmt.Errorf("update failed for %d of %d services:\n%s", len(updateErrs), len(names), strings.Join(updateErrs, "\n"))
+				return errors.Join(updateErr, waitErr)
 			}
 
-			// Do the actual update with retry in case of conflicts
-			changed, err := client.UpdateServiceWithRetry(cmd.Context(), name, updateFunc, config.DefaultRetry.Steps)
+			name := args[0]
+			changed, latestRevisionBeforeUpdate, err := updateService(name)
 			if err != nil {
 				return err
 			}
-			out := cmd.OutOrStdout()
 
 			// No need to wait if not changed
 			if !changed {
-				fmt.Fprintf(out, "Service '%s' updated in namespace '%s'.\n", args[0], namespace)
+				fmt.Fprintf(out, "Service '%s' updated in namespace '%s'.\n", name, namespace)
 				fmt.Fprintln(out, "No new revision has been created.")
 				return nil
 			}
 
 			if waitFlags.Wait && targetFlag == "" {
-				fmt.Fprintf(out, "Updating Service '%s' in namespace '%s':\n", args[0], namespace)
+				fmt.Fprintf(out, "Updating Service '%s' in namespace '%s':\n", name, namespace)
 				fmt.Fprintln(out, "")
-				wconfig := clientservingv1.WaitConfig{
-					Timeout:     time.Duration(waitFlags.TimeoutInSeconds) * time.Second,
-					ErrorWindow: time.Duration(waitFlags.ErrorWindowInSeconds) * time.Second,
-				}
 				err := waitForService(cmd.Context(), client, name, out, wconfig)
 				if err != nil {
 					return err
@@ -147,7 +203,7 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 				fmt.Fprintln(out, "")
 				return showUrl(cmd.Context(), client, name, latestRevisionBeforeUpdate, "updated", out)
 			} else {
-				fmt.Fprintf(out, "Service '%s' updated in namespace '%s'.\n", args[0], namespace)
+				fmt.Fprintf(out, "Service '%s' updated in namespace '%s'.\n", name, namespace)
 			}
 
 			return nil
@@ -162,12 +218,15 @@ func NewServiceUpdateCommand(p *commands.KnParams) *cobra.Command {
 	commands.AddGitOpsFlags(serviceUpdateCommand.Flags())
 	editFlags.AddUpdateFlags(serviceUpdateCommand)
 	waitFlags.AddConditionWaitFlags(serviceUpdateCommand, commands.WaitDefaultTimeout, "update", "service", "ready")
+	waitFlags.AddConcurrencyFlag(serviceUpdateCommand, "service")
+	serviceUpdateCommand.Flags().StringVar(&selector, "selector", "",
+		"Update all services matching the given label selector, e.g. 'app=shop'.")
 	trafficFlags.Add(serviceUpdateCommand)
 	return serviceUpdateCommand
 }
 
-func isImagePinned(cmd *cobra.Command, editFlags ConfigurationEditFlags) bool {
-	return !cmd.Flags().Changed("image") && editFlags.LockToDigest
+func isImagePinned(cmd *cobra.Command, editFlags ConfigurationEditFlags, spec *servingv1.RevisionSpec) bool {
+	return !editFlags.mainImageChanged(cmd, spec) && editFlags.LockToDigest
 }
 
 func preCheck(cmd *cobra.Command) error {
@@ -239,12 +239,12 @@ func TestServiceUpdateWithMultipleImages(t *testing.T) {
 	assert.Assert(t, util.ContainsAll(err.Error(), "\"--image\"", "\"gcr.io/bar/foo:baz\"", "flag", "once"))
 }
 
-func TestServiceUpdateWithMultipleNames(t *testing.T) {
+func TestServiceUpdateWithNamesAndSelector(t *testing.T) {
 	orig := newEmptyService()
 	_, _, _, err := fakeServiceUpdate(orig, []string{
-		"service", "update", "foo", "foo1", "--image", "gcr.io/foo/bar:baz", "--no-wait"})
+		"service", "update", "foo", "foo1", "--selector", "app=shop", "--image", "gcr.io/foo/bar:baz", "--no-wait"})
 
-	assert.Assert(t, util.ContainsAll(err.Error(), "'service update' requires the service name given as single argument"))
+	assert.Assert(t, util.ContainsAll(err.Error(), "'service update' doesn't support service names together with --selector"))
 }
 
 func TestServiceUpdateCommand(t *testing.T) {
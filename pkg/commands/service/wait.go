@@ -15,11 +15,12 @@
 package service
 
 import (
-	"errors"
-	"fmt"
-	"time"
+	"context"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"knative.dev/client/pkg/commands"
 	clientservingv1 "knative.dev/client/pkg/serving/v1"
@@ -33,50 +34,50 @@ var waitExample = `
   kn service wait svc --wait-timeout 10
 
   # Waits on a service 'svc' with a timeout and wait window
-  kn service wait svc --wait-timeout 10 --wait-window 1`
+  kn service wait svc --wait-timeout 10 --wait-window 1
+
+  # Waits until the routes of service 'svc' are ready
+  kn service wait svc --for condition=RoutesReady
+
+  # Waits until service 'svc' has been deleted
+  kn service wait svc --for delete
+
+  # Waits until the latest change of service 'svc' has been reconciled
+  kn service wait svc --for generation
+
+  # Waits until service 'svc' has got a URL assigned
+  kn service wait svc --for url
+
+  # Waits on the services 'frontend' and 'backend' at the same time
+  kn service wait frontend backend
+
+  # Waits on all services with the label 'app=shop', at most 5 at the same time
+  kn service wait --selector app=shop --wait-concurrency 5`
 
 // NewServiceWaitCommand represents 'kn service wait' command
 func NewServiceWaitCommand(p *commands.KnParams) *cobra.Command {
-	var waitFlags commands.WaitFlags
-	command := &cobra.Command{
-		Use:     "wait NAME",
-		Short:   "Wait for a service to be ready",
+	return commands.NewWaitCommand(p, commands.WaitCommandConfig{
+		Group:   "service",
+		Kind:    "Service",
 		Example: waitExample,
-		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			if len(args) != 1 {
-				return errors.New("'service wait' requires the service name given as single argument")
-			}
-
-			namespace, err := p.GetNamespace(cmd)
-			if err != nil {
-				return err
-			}
+		NewResource: func(cmd *cobra.Command, namespace string) (*commands.WaitableResource, error) {
 			client, err := newServingClient(p, namespace, "")
 			if err != nil {
-				return err
-			}
-
-			name := args[0]
-			out := cmd.OutOrStdout()
-
-			fmt.Fprintf(out, "Waiting for Service '%s' in namespace '%s':\n", args[0], namespace)
-			fmt.Fprintln(out, "")
-			wconfig := clientservingv1.WaitConfig{
-				Timeout:     time.Duration(waitFlags.TimeoutInSeconds) * time.Second,
-				ErrorWindow: time.Duration(waitFlags.ErrorWindowInSeconds) * time.Second,
-			}
-			err = waitForService(cmd.Context(), client, name, out, wconfig)
-			if err != nil {
-				return err
+				return nil, err
 			}
-			fmt.Fprintln(out, "")
-			fmt.Fprintf(out, "Service '%s' in namespace '%s' is ready.\n", name, namespace)
-
-			return nil
-
+			return &commands.WaitableResource{
+				Get: func(ctx context.Context, name string) (runtime.Object, error) {
+					return client.GetService(ctx, name)
+				},
+				List: func(ctx context.Context) (runtime.Object, error) {
+					return client.ListServices(ctx)
+				},
+				Watch:      client.WatchServiceWithVersion,
+				Conditions: clientservingv1.ServiceConditionExtractor,
+				URL: func(obj runtime.Object) *apis.URL {
+					return obj.(*servingv1.Service).Status.URL
+				},
+			}, nil
 		},
-	}
-	commands.AddNamespaceFlags(command.Flags(), false)
-	waitFlags.AddConditionWaitFlags(command, commands.WaitDefaultTimeout, "wait", "service", "ready")
-	return command
+	})
 }
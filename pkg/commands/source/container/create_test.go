@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
@@ -46,6 +47,27 @@ func TestCreateContainerSource(t *testing.T) {
 	containerRecorder.Validate()
 }
 
+func TestCreateContainerSourceWithInitContainer(t *testing.T) {
+	testsvc := &servingv1.Service{
+		TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "serving.knative.dev/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "testsvc", Namespace: "default"},
+	}
+	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default", testsvc)
+	containerClient := v1.NewMockKnContainerSourceClient(t)
+
+	expected := createContainerSource("testsource", "docker.io/test/testimg", createSinkv1("testsvc", "default"), nil, nil, nil)
+	expected.Spec.Template.Spec.InitContainers = []corev1.Container{{Name: "setup", Image: "docker.io/test/setup"}}
+	containerRecorder := containerClient.Recorder()
+	containerRecorder.CreateContainerSource(expected, nil)
+
+	out, err := executeContainerSourceCommand(containerClient, dynamicClient, "create", "testsource", "--image", "docker.io/test/testimg",
+		"--init-container", "name=setup,image=docker.io/test/setup", "--sink", "ksvc:testsvc")
+	assert.NilError(t, err, "Container source should be created")
+	assert.Assert(t, util.ContainsAll(out, "created", "default", "testsource"))
+
+	containerRecorder.Validate()
+}
+
 func TestSinkNotFoundError(t *testing.T) {
 	dynamicClient := dynamicfake.CreateFakeKnDynamicClient("default")
 	containerClient := v1.NewMockKnContainerSourceClient(t)
@@ -101,6 +101,7 @@ func NewContainerDescribeCommand(p *commands.KnParams) *cobra.Command {
 func writeContainerSource(dw printers.PrefixWriter, source *v1.ContainerSource, printDetails bool) {
 	commands.WriteMetadata(dw, &source.ObjectMeta, printDetails)
 	writeContainer(dw, &source.Spec.Template.Spec.Containers[0])
+	writeInitContainers(dw, source.Spec.Template.Spec.InitContainers)
 }
 
 func writeCeOverrides(dw printers.PrefixWriter, ceOverrides map[string]string) {
@@ -115,6 +116,16 @@ func writeCeOverrides(dw printers.PrefixWriter, ceOverrides map[string]string) {
 	}
 }
 
+func writeInitContainers(dw printers.PrefixWriter, initContainers []corev1.Container) {
+	if len(initContainers) == 0 {
+		return
+	}
+	subDw := dw.WriteAttribute("Init Containers", "")
+	for _, container := range initContainers {
+		subDw.WriteAttribute(container.Name, container.Image)
+	}
+}
+
 func writeContainer(dw printers.PrefixWriter, container *corev1.Container) {
 	subDw := dw.WriteAttribute("Container", "")
 	subDw.WriteAttribute("Image", container.Image)
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
 	v1 "knative.dev/client/pkg/sources/v1"
 	"knative.dev/client/pkg/util"
 )
@@ -50,11 +51,13 @@ func TestSimpleDescribe(t *testing.T) {
 		[]string{"baz"},
 	)
 	sampleSource.Namespace = "mynamespace"
+	sampleSource.Spec.Template.Spec.InitContainers = []corev1.Container{{Name: "setup", Image: "docker.io/test/setup"}}
 	containerRecorder.GetContainerSource("testsource", sampleSource, nil)
 
 	out, err := executeContainerSourceCommand(containerClient, nil, "describe", "testsource")
 	assert.NilError(t, err)
 	assert.Assert(t, util.ContainsAll(out, "testsource", "docker.io/test/testimg", "testsvc", "bla", "foo", "env1", "baz"))
+	assert.Assert(t, util.ContainsAll(out, "Init Containers", "setup", "docker.io/test/setup"))
 	assert.Assert(t, util.ContainsNone(out, "URI"))
 
 	containerRecorder.Validate()
@@ -35,6 +35,7 @@ func NewPingCommand(p *commands.KnParams) *cobra.Command {
 	pingImporterCmd.AddCommand(NewPingDescribeCommand(p))
 	pingImporterCmd.AddCommand(NewPingUpdateCommand(p))
 	pingImporterCmd.AddCommand(NewPingListCommand(p))
+	pingImporterCmd.AddCommand(NewPingWaitCommand(p))
 	return pingImporterCmd
 }
 
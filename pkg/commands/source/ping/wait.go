@@ -0,0 +1,55 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ping
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"knative.dev/client/pkg/commands"
+	clientv1 "knative.dev/client/pkg/sources/v1"
+)
+
+// NewPingWaitCommand is for waiting for a Ping source
+func NewPingWaitCommand(p *commands.KnParams) *cobra.Command {
+	return commands.NewWaitCommand(p, commands.WaitCommandConfig{
+		Group: "source ping",
+		Kind:  "Ping source",
+		Example: `
+  # Wait for a Ping source 'my-ping' to be ready
+  kn source ping wait my-ping
+
+  # Wait for the sink of Ping source 'my-ping' to be resolved
+  kn source ping wait my-ping --for condition=SinkProvided`,
+		NewResource: func(cmd *cobra.Command, namespace string) (*commands.WaitableResource, error) {
+			client, err := newPingSourceClient(p, cmd)
+			if err != nil {
+				return nil, err
+			}
+			return &commands.WaitableResource{
+				Get: func(ctx context.Context, name string) (runtime.Object, error) {
+					return client.GetPingSource(ctx, name)
+				},
+				List: func(ctx context.Context) (runtime.Object, error) {
+					return client.ListPingSource(ctx)
+				},
+				Watch:      client.WatchPingSource,
+				Conditions: clientv1.PingSourceConditionExtractor,
+			}, nil
+		},
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ping
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/watch"
+
+	clientv1 "knative.dev/client/pkg/sources/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/wait"
+)
+
+func TestPingSourceWait(t *testing.T) {
+	pingClient := clientv1.NewMockKnPingSourceClient(t)
+	recorder := pingClient.Recorder()
+
+	source := createPingSource("testsource", "* * * * */2", "maxwell", "", "mysvc", nil)
+	source.Generation = 2
+	source.Status.ObservedGeneration = 1
+	reconciled := source.DeepCopy()
+	reconciled.Status.ObservedGeneration = 2
+
+	recorder.GetPingSource("testsource", source, nil)
+	watcher := wait.NewFakeWatch([]watch.Event{{Type: watch.Modified, Object: reconciled}})
+	watcher.Start()
+	recorder.WatchPingSource("testsource", "", watcher, nil)
+
+	out, err := executePingSourceCommand(pingClient, nil, "wait", "testsource", "--for", "generation", "--wait-timeout", "5")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Ping source 'testsource' in namespace 'default' has reconciled its latest generation."))
+
+	_, err = executePingSourceCommand(pingClient, nil, "wait", "testsource", "--for", "url")
+	assert.ErrorContains(t, err, "'source ping wait' doesn't support --for url as a ping source has no URL")
+
+	recorder.Validate()
+}
@@ -32,5 +32,6 @@ func NewTriggerCommand(p *commands.KnParams) *cobra.Command {
 	triggerCmd.AddCommand(NewTriggerDescribeCommand(p))
 	triggerCmd.AddCommand(NewTriggerListCommand(p))
 	triggerCmd.AddCommand(NewTriggerDeleteCommand(p))
+	triggerCmd.AddCommand(NewTriggerWaitCommand(p))
 	return triggerCmd
 }
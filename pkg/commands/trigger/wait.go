@@ -0,0 +1,63 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/pkg/apis"
+
+	"knative.dev/client/pkg/commands"
+	clienteventingv1 "knative.dev/client/pkg/eventing/v1"
+)
+
+// NewTriggerWaitCommand represents command to wait for a trigger
+func NewTriggerWaitCommand(p *commands.KnParams) *cobra.Command {
+	return commands.NewWaitCommand(p, commands.WaitCommandConfig{
+		Group: "trigger",
+		Kind:  "Trigger",
+		Example: `
+  # Wait for a trigger 'mytrigger' to be ready
+  kn trigger wait mytrigger
+
+  # Wait for the subscriber of trigger 'mytrigger' to be resolved
+  kn trigger wait mytrigger --for condition=SubscriberResolved
+
+  # Wait for a trigger 'mytrigger' to get the URL of its subscriber resolved
+  kn trigger wait mytrigger --for url`,
+		NewResource: func(cmd *cobra.Command, namespace string) (*commands.WaitableResource, error) {
+			client, err := p.NewEventingClient(namespace)
+			if err != nil {
+				return nil, err
+			}
+			return &commands.WaitableResource{
+				Get: func(ctx context.Context, name string) (runtime.Object, error) {
+					return client.GetTrigger(ctx, name)
+				},
+				List: func(ctx context.Context) (runtime.Object, error) {
+					return client.ListTriggers(ctx)
+				},
+				Watch:      client.WatchTrigger,
+				Conditions: clienteventingv1.TriggerConditionExtractor,
+				URL: func(obj runtime.Object) *apis.URL {
+					return obj.(*eventingv1.Trigger).Status.SubscriberURI
+				},
+			}, nil
+		},
+	})
+}
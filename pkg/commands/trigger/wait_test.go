@@ -0,0 +1,56 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/watch"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	clientv1 "knative.dev/client/pkg/eventing/v1"
+	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/wait"
+)
+
+func TestTriggerWait(t *testing.T) {
+	client := clientv1.NewMockKnEventingClient(t)
+	recorder := client.Recorder()
+
+	pending := createTriggerWithStatusAndGvk("default", triggerName, map[string]string{}, "mybroker", "mysvc")
+	pending.Generation = 1
+	pending.Status.ObservedGeneration = 1
+	pending.Status.Conditions = duckv1.Conditions{{Type: "SubscriberResolved", Status: "Unknown"}}
+	resolved := pending.DeepCopy()
+	resolved.Status.Conditions = duckv1.Conditions{{Type: "SubscriberResolved", Status: "True"}}
+
+	recorder.GetTrigger(triggerName, pending, nil)
+	watcher := wait.NewFakeWatch([]watch.Event{{Type: watch.Modified, Object: resolved}})
+	watcher.Start()
+	recorder.WatchTrigger(triggerName, "", watcher, nil)
+
+	out, err := executeTriggerCommand(client, nil, "wait", triggerName, "--for", "condition=SubscriberResolved", "--wait-timeout", "5")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "Trigger '"+triggerName+"' in namespace 'default' has condition 'SubscriberResolved' set to True."))
+
+	recorder.GetTrigger(triggerName, resolved, nil)
+	recorder.GetTrigger(triggerName, resolved, nil)
+	out, err = executeTriggerCommand(client, nil, "wait", triggerName, "--for", "url")
+	assert.NilError(t, err)
+	assert.Assert(t, util.ContainsAll(out, "is available at URL:", "http://mysvc"))
+
+	recorder.Validate()
+}
@@ -0,0 +1,267 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"knative.dev/pkg/apis"
+
+	"knative.dev/client/pkg/wait"
+)
+
+// Modes of the --for flag of wait commands
+const (
+	WaitForCondition  = "condition"
+	WaitForDelete     = "delete"
+	WaitForGeneration = "generation"
+	WaitForURL        = "url"
+)
+
+// WaitFor is the state a wait command waits for, as given with --for
+type WaitFor struct {
+	// Mode is one of WaitForCondition, WaitForDelete, WaitForGeneration or WaitForURL
+	Mode string
+	// Condition is the type of the condition to become true for WaitForCondition
+	Condition apis.ConditionType
+}
+
+// ParseWaitFor parses the value of --for, which is either 'condition=TYPE', 'delete',
+// 'generation' or 'url'
+func ParseWaitFor(value string) (WaitFor, error) {
+	switch value {
+	case WaitForDelete, WaitForGeneration, WaitForURL:
+		return WaitFor{Mode: value}, nil
+	}
+	if condition, found := strings.CutPrefix(value, WaitForCondition+"="); found && condition != "" {
+		return WaitFor{Mode: WaitForCondition, Condition: apis.ConditionType(condition)}, nil
+	}
+	return WaitFor{}, fmt.Errorf("invalid value '%s' for --for, must be one of 'condition=TYPE', '%s', '%s' or '%s'",
+		value, WaitForDelete, WaitForGeneration, WaitForURL)
+}
+
+// WaitableResource gives the generic wait command access to the resources of a type
+type WaitableResource struct {
+	// Get retrieves the resource with the given name
+	Get func(ctx context.Context, name string) (runtime.Object, error)
+	// List retrieves the list of all resources, for selecting resources with --selector
+	List func(ctx context.Context) (runtime.Object, error)
+	// Watch creates a watch on the resource with the given name
+	Watch wait.WatchMaker
+	// Conditions extracts the status conditions of a resource
+	Conditions wait.ConditionsExtractor
+	// URL extracts the URL of a resource. It is nil for resource types without URL.
+	URL func(obj runtime.Object) *apis.URL
+}
+
+// WaitCommandConfig describes the resource type of a wait command
+type WaitCommandConfig struct {
+	// Group is the command group of the resource type, e.g. "source ping"
+	Group string
+	// Kind is the name of the resource type used in messages, e.g. "Ping source"
+	Kind string
+	// Example of the command
+	Example string
+	// NewResource gives access to the resources in the given namespace
+	NewResource func(cmd *cobra.Command, namespace string) (*WaitableResource, error)
+}
+
+// NewWaitCommand creates a wait command for the resource type described by config,
+// which waits for a condition, the deletion, the reconciliation or the URL of one or
+// more resources
+func NewWaitCommand(p *KnParams, config WaitCommandConfig) *cobra.Command {
+	var waitFlags WaitFlags
+	var waitForValue string
+	var selector string
+	lowerKind := strings.ToLower(config.Kind)
+	command := &cobra.Command{
+		Use:               "wait NAME [NAME ...]",
+		Short:             fmt.Sprintf("Wait for %ss to be ready or to reach another state", lowerKind),
+		Example:           config.Example,
+		ValidArgsFunction: ResourceNameCompletionFunc(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && selector == "" {
+				return fmt.Errorf("'%s wait' requires the %s name given as argument or a --selector", config.Group, lowerKind)
+			}
+			if len(args) > 0 && selector != "" {
+				return fmt.Errorf("'%s wait' doesn't support %s names together with --selector", config.Group, lowerKind)
+			}
+			waitFor, err := ParseWaitFor(waitForValue)
+			if err != nil {
+				return err
+			}
+			var parsedSelector labels.Selector
+			if selector != "" {
+				if parsedSelector, err = ParseSelector(selector); err != nil {
+					return err
+				}
+			}
+			namespace, err := p.GetNamespace(cmd)
+			if err != nil {
+				return err
+			}
+			resource, err := config.NewResource(cmd, namespace)
+			if err != nil {
+				return err
+			}
+			if waitFor.Mode == WaitForURL && resource.URL == nil {
+				return fmt.Errorf("'%s wait' doesn't support --for %s as a %s has no URL", config.Group, WaitForURL, lowerKind)
+			}
+
+			names := args
+			if selector != "" {
+				if names, err = ListSelectedNames(cmd.Context(), resource.List, parsedSelector, lowerKind, namespace); err != nil {
+					return err
+				}
+			}
+			if len(names) > 1 || selector != "" {
+				tasks := make([]wait.Task, len(names))
+				for i, name := range names {
+					tasks[i] = waitTask(resource, lowerKind, name, waitFor, waitFlags.Options())
+				}
+				return WaitForAll(cmd, config.Kind, namespace, tasks, waitFlags.Concurrency)
+			}
+
+			name := names[0]
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Waiting for %s '%s' in namespace '%s':\n", config.Kind, name, namespace)
+			fmt.Fprintln(out, "")
+			if err := waitForResource(cmd.Context(), resource, lowerKind, name, waitFor, waitFlags.Options(), wait.SimpleMessageCallback(out)); err != nil {
+				return err
+			}
+
+			fmt.Fprintln(out, "")
+			return printWaitResult(cmd.Context(), resource, config.Kind, name, namespace, waitFor, out)
+		},
+	}
+	AddNamespaceFlags(command.Flags(), false)
+	command.Flags().StringVar(&waitForValue, "for", WaitForCondition+"="+string(apis.ConditionReady),
+		"State to wait for: 'condition=TYPE' waits for the status condition TYPE to become True, "+
+			"'delete' for the deletion, 'generation' for the reconciliation of the latest change and 'url' for the URL to be assigned.")
+	command.Flags().StringVarP(&selector, "selector", "l", "",
+		fmt.Sprintf("Wait for all %ss matching the given label selector, e.g. 'app=web,tier!=cache'.", lowerKind))
+	waitFlags.AddConditionWaitFlags(command, WaitDefaultTimeout, "wait", lowerKind, "ready")
+	waitFlags.AddConcurrencyFlag(command, lowerKind)
+	return command
+}
+
+// waitForResource returns immediately if the resource is already in the requested state
+// and starts watching from the current version of the resource otherwise
+func waitForResource(ctx context.Context, resource *WaitableResource, kind, name string, waitFor WaitFor, options wait.Options, msgCallback wait.MessageCallback) error {
+	initialVersion := ""
+	obj, err := resource.Get(ctx, name)
+	switch {
+	case apierrors.IsNotFound(err):
+		if waitFor.Mode == WaitForDelete {
+			return nil
+		}
+	case err != nil:
+		return err
+	default:
+		done, err := waitDone(obj, resource, waitFor)
+		if err != nil || done {
+			return err
+		}
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return err
+		}
+		initialVersion = accessor.GetResourceVersion()
+	}
+
+	var waiter wait.Wait
+	switch waitFor.Mode {
+	case WaitForCondition:
+		waiter = wait.NewWaitForCondition(kind, resource.Watch, resource.Conditions, waitFor.Condition)
+	case WaitForDelete:
+		waiter = wait.NewWaitForEventWithState(kind, resource.Watch, func(ev *watch.Event) bool { return ev.Type == watch.Deleted }, "deleted")
+	case WaitForGeneration:
+		waiter = wait.NewWaitForGeneration(kind, resource.Watch)
+	case WaitForURL:
+		waiter = wait.NewWaitForEventWithState(kind, resource.Watch, func(ev *watch.Event) bool {
+			return (ev.Type == watch.Added || ev.Type == watch.Modified) && hasURL(resource, ev.Object)
+		}, "assigned a URL")
+	}
+	err, _ = waiter.Wait(ctx, name, initialVersion, options, msgCallback)
+	return err
+}
+
+// waitDone checks whether an existing resource has reached the requested state
+func waitDone(obj runtime.Object, resource *WaitableResource, waitFor WaitFor) (bool, error) {
+	switch waitFor.Mode {
+	case WaitForCondition:
+		observed, err := wait.GenerationObserved(obj)
+		if err != nil || !observed {
+			return false, err
+		}
+		conditions, err := resource.Conditions(obj)
+		if err != nil {
+			return false, err
+		}
+		for _, cond := range conditions {
+			if cond.Type == waitFor.Condition {
+				return cond.Status == corev1.ConditionTrue, nil
+			}
+		}
+		return false, nil
+	case WaitForGeneration:
+		return wait.GenerationObserved(obj)
+	case WaitForURL:
+		return hasURL(resource, obj), nil
+	}
+	return false, nil
+}
+
+func hasURL(resource *WaitableResource, obj runtime.Object) bool {
+	url := resource.URL(obj)
+	return url != nil && url.String() != ""
+}
+
+func printWaitResult(ctx context.Context, resource *WaitableResource, kind, name, namespace string, waitFor WaitFor, out io.Writer) error {
+	switch waitFor.Mode {
+	case WaitForCondition:
+		if waitFor.Condition == apis.ConditionReady {
+			fmt.Fprintf(out, "%s '%s' in namespace '%s' is ready.\n", kind, name, namespace)
+		} else {
+			fmt.Fprintf(out, "%s '%s' in namespace '%s' has condition '%s' set to True.\n", kind, name, namespace, waitFor.Condition)
+		}
+	case WaitForDelete:
+		fmt.Fprintf(out, "%s '%s' in namespace '%s' is deleted.\n", kind, name, namespace)
+	case WaitForGeneration:
+		fmt.Fprintf(out, "%s '%s' in namespace '%s' has reconciled its latest generation.\n", kind, name, namespace)
+	case WaitForURL:
+		obj, err := resource.Get(ctx, name)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "%s '%s' in namespace '%s' is available at URL:\n%s\n", kind, name, namespace, resource.URL(obj))
+	}
+	return nil
+}
+
+func durationPtr(duration time.Duration) *time.Duration {
+	return &duration
+}
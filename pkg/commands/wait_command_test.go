@@ -0,0 +1,73 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestParseWaitFor(t *testing.T) {
+	for _, tc := range []struct {
+		value    string
+		expected WaitFor
+		err      string
+	}{
+		{value: "condition=Ready", expected: WaitFor{Mode: WaitForCondition, Condition: apis.ConditionReady}},
+		{value: "condition=RoutesReady", expected: WaitFor{Mode: WaitForCondition, Condition: "RoutesReady"}},
+		{value: "delete", expected: WaitFor{Mode: WaitForDelete}},
+		{value: "generation", expected: WaitFor{Mode: WaitForGeneration}},
+		{value: "url", expected: WaitFor{Mode: WaitForURL}},
+		{value: "condition=", err: "invalid value 'condition=' for --for"},
+		{value: "ready", err: "must be one of 'condition=TYPE', 'delete', 'generation' or 'url'"},
+	} {
+		t.Run(tc.value, func(t *testing.T) {
+			waitFor, err := ParseWaitFor(tc.value)
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, waitFor, tc.expected)
+		})
+	}
+}
+
+func TestSelectNames(t *testing.T) {
+	list := &servingv1.ServiceList{Items: []servingv1.Service{
+		{ObjectMeta: metav1.ObjectMeta{Name: "web", Labels: map[string]string{"app": "shop", "tier": "web"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "cache", Labels: map[string]string{"app": "shop", "tier": "cache"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "blog", Labels: map[string]string{"app": "blog"}}},
+	}}
+
+	selector, err := ParseSelector("app=shop,tier!=cache")
+	assert.NilError(t, err)
+	names, err := SelectNames(list, selector)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, names, []string{"web"})
+
+	selector, err = ParseSelector("app in (shop,blog)")
+	assert.NilError(t, err)
+	names, err = SelectNames(list, selector)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, names, []string{"web", "cache", "blog"})
+
+	_, err = ParseSelector("app==(")
+	assert.ErrorContains(t, err, "invalid label selector 'app==('")
+}
@@ -35,6 +35,8 @@ type WaitFlags struct {
 	Wait bool
 	// Duration in seconds for waiting between intermediate false ready conditions
 	ErrorWindowInSeconds int
+	// Maximum number of resources to wait for at the same time
+	Concurrency int
 }
 
 // Add flags which influence the wait/no-wait behaviour when creating, updating, waiting for
@@ -51,6 +53,12 @@ func (p *WaitFlags) AddConditionWaitFlags(command *cobra.Command, waitTimeoutDef
 
 		knflags.AddBothBoolFlagsUnhidden(command.Flags(), &p.Wait, "wait", "", waitDefault, waitUsage)
 	}
+	p.AddWaitTimeoutFlags(command, waitTimeoutDefault, what, until)
+}
+
+// AddWaitTimeoutFlags adds only the flags for tuning how long to wait, for commands which always
+// wait for resources. Use `what` for describing what is waited for.
+func (p *WaitFlags) AddWaitTimeoutFlags(command *cobra.Command, waitTimeoutDefault int, what, until string) {
 	timeoutUsage := fmt.Sprintf("Seconds to wait before giving up on waiting for %s to be %s.", what, until)
 	command.Flags().IntVar(&p.TimeoutInSeconds, "wait-timeout", waitTimeoutDefault, timeoutUsage)
 
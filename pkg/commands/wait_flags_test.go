@@ -150,3 +150,18 @@ func TestAddWaitUsageWait(t *testing.T) {
 		t.Error("wrong until message")
 	}
 }
+
+func TestAddWaitTimeoutFlags(t *testing.T) {
+	flags := &WaitFlags{}
+	cmd := cobra.Command{}
+	flags.AddWaitTimeoutFlags(&cmd, 60, "blub", "ready")
+	if cmd.Flags().Lookup("wait") != nil || cmd.Flags().Lookup("no-wait") != nil {
+		t.Error("wait flags must not be added")
+	}
+	if err := cmd.ParseFlags([]string{"--wait-timeout", "20", "--wait-window", "5"}); err != nil {
+		t.Fatal(err)
+	}
+	if flags.TimeoutInSeconds != 20 || flags.ErrorWindowInSeconds != 5 {
+		t.Errorf("invalid timeouts set: %d, %d", flags.TimeoutInSeconds, flags.ErrorWindowInSeconds)
+	}
+}
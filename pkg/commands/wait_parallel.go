@@ -0,0 +1,194 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"knative.dev/client/pkg/output"
+	"knative.dev/client/pkg/output/term"
+	"knative.dev/client/pkg/output/tui"
+	"knative.dev/client/pkg/printers"
+	"knative.dev/client/pkg/wait"
+)
+
+// WaitDefaultConcurrency is the default number of resources which are waited for at the same time
+const WaitDefaultConcurrency = 10
+
+// AddConcurrencyFlag adds the flag for the number of resources which are waited for at the same time
+func (p *WaitFlags) AddConcurrencyFlag(command *cobra.Command, what string) {
+	command.Flags().IntVar(&p.Concurrency, "wait-concurrency", WaitDefaultConcurrency,
+		fmt.Sprintf("Maximum number of %ss to wait for at the same time when waiting for multiple %ss.", what, what))
+}
+
+// Options of wait.Options for the given wait flags
+func (p *WaitFlags) Options() wait.Options {
+	return wait.Options{
+		Timeout:     durationPtr(time.Duration(p.TimeoutInSeconds) * time.Second),
+		ErrorWindow: durationPtr(time.Duration(p.ErrorWindowInSeconds) * time.Second),
+	}
+}
+
+// ParseSelector parses the label selector given with --selector
+func ParseSelector(selector string) (labels.Selector, error) {
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector '%s': %w", selector, err)
+	}
+	return parsed, nil
+}
+
+// SelectNames returns the names of the items of a list whose labels match the given selector
+func SelectNames(list runtime.Object, selector labels.Selector) ([]string, error) {
+	objects, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, obj := range objects {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, err
+		}
+		if selector.Matches(labels.Set(accessor.GetLabels())) {
+			names = append(names, accessor.GetName())
+		}
+	}
+	return names, nil
+}
+
+// ListSelectedNames retrieves the list of resources and returns the names of the resources
+// whose labels match the given selector. It fails if no resource matches.
+func ListSelectedNames(ctx context.Context, list func(ctx context.Context) (runtime.Object, error), selector labels.Selector, lowerKind, namespace string) ([]string, error) {
+	objects, err := list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names, err := SelectNames(objects, selector)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no %ss found matching selector '%s' in namespace '%s'", lowerKind, selector.String(), namespace)
+	}
+	return names, nil
+}
+
+// WaitForAll runs the given wait tasks with at most concurrency tasks running at the same
+// time. The progress is shown as a live status list when writing to a terminal and with a
+// line per finished resource otherwise. A summary table with the result of every resource
+// is printed at the end and an error is returned if any of the tasks failed.
+func WaitForAll(cmd *cobra.Command, kind, namespace string, tasks []wait.Task, concurrency int) error {
+	out := cmd.OutOrStdout()
+	lowerKind := strings.ToLower(kind)
+	if len(tasks) == 1 {
+		fmt.Fprintf(out, "Waiting for 1 %s in namespace '%s':\n", lowerKind, namespace)
+	} else {
+		fmt.Fprintf(out, "Waiting for %d %ss in namespace '%s':\n", len(tasks), lowerKind, namespace)
+	}
+	fmt.Fprintln(out, "")
+
+	var results []wait.TaskResult
+	if term.IsWriterTerminal(out) {
+		names := make([]string, len(tasks))
+		for i, task := range tasks {
+			names[i] = task.Name
+		}
+		ctx := output.WithContext(cmd.Context(), output.NewPrinter(cmd))
+		err := tui.NewWidgets(ctx).NewStatusList(names).With(func(control tui.StatusListControl) error {
+			results = wait.WaitInParallel(ctx, tasks, concurrency, statusListProgress(control))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		results = wait.WaitInParallel(cmd.Context(), tasks, concurrency, lineProgress(out, kind))
+	}
+
+	fmt.Fprintln(out, "")
+	return printWaitSummary(out, lowerKind, results)
+}
+
+func statusListProgress(control tui.StatusListControl) wait.ProgressCallback {
+	return func(name string, state wait.TaskState, message string) {
+		switch state {
+		case wait.TaskPending:
+			control.UpdateStatus(name, tui.ItemPending, message)
+		case wait.TaskWaiting:
+			control.UpdateStatus(name, tui.ItemInProgress, message)
+		case wait.TaskSucceeded:
+			control.UpdateStatus(name, tui.ItemSucceeded, "done")
+		case wait.TaskFailed:
+			control.UpdateStatus(name, tui.ItemFailed, message)
+		}
+	}
+}
+
+// lineProgress prints a line for every finished task, for output which is not a terminal
+func lineProgress(out io.Writer, kind string) wait.ProgressCallback {
+	var mutex sync.Mutex
+	return func(name string, state wait.TaskState, message string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		switch state {
+		case wait.TaskSucceeded:
+			fmt.Fprintf(out, "%s '%s' done.\n", kind, name)
+		case wait.TaskFailed:
+			fmt.Fprintf(out, "%s '%s' failed: %s\n", kind, name, message)
+		}
+	}
+}
+
+func printWaitSummary(out io.Writer, lowerKind string, results []wait.TaskResult) error {
+	failed := 0
+	writer := printers.NewTabWriter(out)
+	fmt.Fprintln(writer, "NAME\tRESULT\tELAPSED\tMESSAGE")
+	for _, result := range results {
+		status, message := "Succeeded", ""
+		if result.Err != nil {
+			failed++
+			status, message = "Failed", result.Err.Error()
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%.1fs\t%s\n", result.Name, status, result.Elapsed.Seconds(), message)
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("waiting failed for %d of %d %ss", failed, len(results), lowerKind)
+	}
+	return nil
+}
+
+// waitTask creates a task waiting for a single resource with the given waiter
+func waitTask(resource *WaitableResource, kind, name string, waitFor WaitFor, options wait.Options) wait.Task {
+	return wait.Task{
+		Name: name,
+		Wait: func(ctx context.Context, msgCallback wait.MessageCallback) error {
+			return waitForResource(ctx, resource, kind, name, waitFor, options, msgCallback)
+		},
+	}
+}
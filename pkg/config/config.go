@@ -51,6 +51,9 @@ const configContentDefaults = `# Taken from https://github.com/knative/client/bl
 #    group: messaging.knative.dev
 #    version: v1alpha1
 #    kind: KafkaChannel
+#lint:
+#  disabled-rules:
+#  - readiness-probe
 `
 
 // config contains the variables for the Kn config
@@ -111,6 +114,10 @@ func (c *config) ChannelTypeMappings() []ChannelTypeMapping {
 	return c.channelTypeMappings
 }
 
+func (c *config) LintDisabledRules() []string {
+	return viper.GetStringSlice(keyLintDisabledRules)
+}
+
 // Config used for flag binding
 var globalConfig = config{}
 
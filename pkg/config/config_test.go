@@ -56,6 +56,10 @@ eventing:
     kind: KafkaChannel
     group: messaging.knative.dev
     version: v1alpha1
+lint:
+  disabled-rules:
+  - readiness-probe
+  - resource-requests
 `
 
 	configFile, cleanup := setupConfig(t, configYaml)
@@ -113,6 +117,7 @@ eventing:
 		Group:   "messaging.knative.dev",
 		Version: "v1alpha1",
 	})
+	assert.DeepEqual(t, GlobalConfig.LintDisabledRules(), []string{"readiness-probe", "resource-requests"})
 }
 
 func TestBootstrapConfigWithoutConfigFile(t *testing.T) {
@@ -25,6 +25,7 @@ type TestConfig struct {
 	TestSinkMappings        []SinkMapping
 	TestChannelTypeMappings []ChannelTypeMapping
 	TestProfiles            map[string]Profile
+	TestLintDisabledRules   []string
 }
 
 // Ensure that TestConfig implements the configuration interface
@@ -37,3 +38,4 @@ func (t TestConfig) LookupPluginsInPath() bool                 { return t.TestLo
 func (t TestConfig) SinkMappings() []SinkMapping               { return t.TestSinkMappings }
 func (t TestConfig) ChannelTypeMappings() []ChannelTypeMapping { return t.TestChannelTypeMappings }
 func (t TestConfig) Profile(profile string) Profile            { return t.TestProfiles[profile] }
+func (t TestConfig) LintDisabledRules() []string               { return t.TestLintDisabledRules }
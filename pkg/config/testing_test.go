@@ -29,6 +29,7 @@ func TestTestConfig(t *testing.T) {
 		TestLookupPluginsInPath: true,
 		TestSinkMappings:        nil,
 		TestChannelTypeMappings: nil,
+		TestLintDisabledRules:   []string{"timeout"},
 	}
 
 	assert.Equal(t, cfg.ContextSharing(), false)
@@ -37,4 +38,5 @@ func TestTestConfig(t *testing.T) {
 	assert.Assert(t, cfg.LookupPluginsInPath())
 	assert.Assert(t, cfg.SinkMappings() == nil)
 	assert.Assert(t, cfg.ChannelTypeMappings() == nil)
+	assert.DeepEqual(t, cfg.LintDisabledRules(), []string{"timeout"})
 }
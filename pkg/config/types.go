@@ -40,6 +40,9 @@ type Config interface {
 
 	// Profile returns a configured profile with this name or nil of no such profile is configured
 	Profile(profile string) Profile
+
+	// LintDisabledRules returns the rules which 'kn service lint' doesn't check
+	LintDisabledRules() []string
 }
 
 // SinkMappings is the struct of sink prefix config in kn config
@@ -93,6 +96,7 @@ const (
 	keySinkMappings           = "eventing.sink-mappings"
 	keyChannelTypeMappings    = "eventing.channel-type-mappings"
 	profiles                  = "profiles"
+	keyLintDisabledRules      = "lint.disabled-rules"
 )
 
 // legacy config keys, deprecated
@@ -18,6 +18,7 @@ import (
 	"context"
 	"testing"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -28,6 +29,7 @@ import (
 	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 	dynamicclientfake "knative.dev/pkg/injection/clients/dynamicclient/fake"
+	autoscalingv1alpha1 "knative.dev/serving/pkg/apis/autoscaling/v1alpha1"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 )
 
@@ -38,7 +40,9 @@ func CreateFakeKnDynamicClient(testNamespace string, objects ...runtime.Object)
 	}
 	scheme := runtime.NewScheme()
 	_ = corev1.AddToScheme(scheme)
+	_ = appsv1.AddToScheme(scheme)
 	_ = servingv1.AddToScheme(scheme)
+	_ = autoscalingv1alpha1.AddToScheme(scheme)
 	_ = eventingv1.AddToScheme(scheme)
 	_ = messagingv1.AddToScheme(scheme)
 	_ = sourcesv1.AddToScheme(scheme)
@@ -19,6 +19,8 @@ import (
 	"strings"
 	"unicode"
 	"unicode/utf8"
+
+	servingconfig "knative.dev/serving/pkg/apis/config"
 )
 
 func NewInvalidCRD(apiGroup string) *KNError {
@@ -37,6 +39,11 @@ func newNoRouteToHost(errString string) *KNError {
 	return NewKNError(fmt.Sprintf("error connecting to the cluster: %s", errString))
 }
 
+func newDisabledFeatures(errString string, features []string) *KNError {
+	return NewKNError(fmt.Sprintf("%s\nThe request requires the feature(s) '%s' to be enabled in the ConfigMap '%s' of Knative Serving, "+
+		"please ask your cluster administrator to enable them", errString, strings.Join(features, "', '"), servingconfig.FeaturesConfigName))
+}
+
 func newNoKubeConfig(errString string) *KNError {
 	return NewKNError("no kubeconfig has been provided, please use a valid configuration to connect to the cluster")
 }
@@ -21,8 +21,38 @@ import (
 
 	api_errors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	servingconfig "knative.dev/serving/pkg/apis/config"
 )
 
+// disallowedFieldsMessage is part of the validation error of Knative Serving for fields
+// which are only allowed when a feature flag is enabled
+const disallowedFieldsMessage = "must not set the field(s): "
+
+// initContainersDisabledMessage is part of the validation error of Knative Serving for init containers
+// when the feature flag for them is disabled
+const initContainersDisabledMessage = "pod spec support for init-containers is off"
+
+// featuresOfPodSpecFields maps the pod spec fields to the feature flags of Knative Serving
+// which need to be enabled to use them
+var featuresOfPodSpecFields = map[string]string{
+	"affinity":                  servingconfig.FeaturePodSpecAffinity,
+	"dnsConfig":                 servingconfig.FeaturePodSpecDNSConfig,
+	"dnsPolicy":                 servingconfig.FeaturePodSpecDNSPolicy,
+	"hostAliases":               servingconfig.FeaturePodSpecHostAliases,
+	"hostIPC":                   servingconfig.FeaturePodSpecHostIPC,
+	"hostNetwork":               servingconfig.FeaturePodSpecHostNetwork,
+	"hostPID":                   servingconfig.FeaturePodSpecHostPID,
+	"initContainers":            servingconfig.FeaturePodSpecInitContainers,
+	"nodeSelector":              servingconfig.FeaturePodSpecNodeSelector,
+	"persistentVolumeClaim":     servingconfig.FeaturePodSpecPVClaim,
+	"priorityClassName":         servingconfig.FeaturePodSpecPriorityClassName,
+	"runtimeClassName":          servingconfig.FeaturePodSpecRuntimeClassName,
+	"schedulerName":             servingconfig.FeaturePodSpecSchedulerName,
+	"shareProcessNamespace":     servingconfig.FeaturePodSpecShareProcessNamespace,
+	"tolerations":               servingconfig.FeaturePodSpecTolerations,
+	"topologySpreadConstraints": servingconfig.FeaturePodSpecTopologySpreadConstraints,
+}
+
 func isCRDError(status api_errors.APIStatus) bool {
 	for _, cause := range status.Status().Details.Causes {
 		if strings.HasPrefix(cause.Message, "404") && cause.Type == v1.CauseTypeUnexpectedServerResponse {
@@ -32,6 +62,39 @@ func isCRDError(status api_errors.APIStatus) bool {
 	return false
 }
 
+// disabledFeatures returns the feature flags of Knative Serving which need to be enabled
+// for the request that has been rejected with the given status
+func disabledFeatures(status api_errors.APIStatus) []string {
+	message := status.Status().Message
+	var features []string
+	addFeature := func(feature string) {
+		for _, f := range features {
+			if f == feature {
+				return
+			}
+		}
+		features = append(features, feature)
+	}
+	if strings.Contains(message, initContainersDisabledMessage) {
+		addFeature(servingconfig.FeaturePodSpecInitContainers)
+	}
+	for _, line := range strings.Split(message, "\n") {
+		_, fields, found := strings.Cut(line, disallowedFieldsMessage)
+		if !found {
+			continue
+		}
+		for _, field := range strings.Split(fields, ",") {
+			path := strings.Split(strings.TrimSpace(field), ".")
+			// Strip an index like in 'volumes[0].persistentVolumeClaim'
+			name, _, _ := strings.Cut(path[len(path)-1], "[")
+			if feature, ok := featuresOfPodSpecFields[name]; ok {
+				addFeature(feature)
+			}
+		}
+	}
+	return features
+}
+
 func isNoRouteToHostError(err error) bool {
 	return strings.Contains(err.Error(), "no route to host") || strings.Contains(err.Error(), "i/o timeout")
 }
@@ -49,6 +112,11 @@ func newStatusError(err error) error {
 	var errAPIStatus api_errors.APIStatus
 	errors.As(err, &errAPIStatus)
 
+	if features := disabledFeatures(errAPIStatus); len(features) > 0 {
+		knerr := newDisabledFeatures(errAPIStatus.Status().Message, features)
+		knerr.Status = errAPIStatus
+		return knerr
+	}
 	if errAPIStatus.Status().Details == nil {
 		return err
 	}
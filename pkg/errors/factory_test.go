@@ -107,6 +107,54 @@ func TestKnErrorsStatusErrors(t *testing.T) {
 	}
 }
 
+func TestDisabledFeatureErrors(t *testing.T) {
+	webhookError := func(message string) error {
+		return &api_errors.StatusError{ErrStatus: metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    400,
+			Reason:  metav1.StatusReasonBadRequest,
+			Message: "admission webhook \"validation.webhook.serving.knative.dev\" denied the request: validation failed: " + message,
+		}}
+	}
+	cases := []struct {
+		Name             string
+		Error            error
+		ExpectedFeatures string
+	}{
+		{
+			Name:             "init containers",
+			Error:            webhookError("pod spec support for init-containers is off, but found 1 init containers"),
+			ExpectedFeatures: "'kubernetes.podspec-init-containers'",
+		},
+		{
+			Name:             "disallowed fields",
+			Error:            webhookError("must not set the field(s): spec.template.spec.nodeSelector, spec.template.spec.tolerations"),
+			ExpectedFeatures: "'kubernetes.podspec-nodeselector', 'kubernetes.podspec-tolerations'",
+		},
+		{
+			Name:             "multiple errors",
+			Error:            webhookError("pod spec support for init-containers is off, but found 2 init containers\nmust not set the field(s): spec.template.spec.volumes[0].persistentVolumeClaim"),
+			ExpectedFeatures: "'kubernetes.podspec-init-containers', 'kubernetes.podspec-persistent-volume-claim'",
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			err := GetError(tc.Error)
+			var knerr *KNError
+			assert.Assert(t, errors.As(err, &knerr))
+			assert.Assert(t, knerr.Status != nil)
+			assert.ErrorContains(t, err, tc.Error.(*api_errors.StatusError).ErrStatus.Message)
+			assert.ErrorContains(t, err, "The request requires the feature(s) "+tc.ExpectedFeatures+" to be enabled in the ConfigMap 'config-features' of Knative Serving")
+		})
+	}
+
+	// Fields which aren't guarded by a feature keep the original error
+	err := webhookError("must not set the field(s): spec.template.spec.containers[0].stdin")
+	assert.Equal(t, GetError(err), err)
+}
+
 func TestKnErrors(t *testing.T) {
 	cases := []struct {
 		Name        string
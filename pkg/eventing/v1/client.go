@@ -29,6 +29,7 @@ import (
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/eventing/pkg/client/clientset/versioned/scheme"
 	clientv1 "knative.dev/eventing/pkg/client/clientset/versioned/typed/eventing/v1"
+	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	kn_errors "knative.dev/client/pkg/errors"
@@ -36,6 +37,24 @@ import (
 	"knative.dev/client/pkg/wait"
 )
 
+// BrokerConditionExtractor extracts the status conditions of a broker
+func BrokerConditionExtractor(obj runtime.Object) (apis.Conditions, error) {
+	broker, ok := obj.(*eventingv1.Broker)
+	if !ok {
+		return nil, fmt.Errorf("%v is not a broker", obj)
+	}
+	return apis.Conditions(broker.Status.Conditions), nil
+}
+
+// TriggerConditionExtractor extracts the status conditions of a trigger
+func TriggerConditionExtractor(obj runtime.Object) (apis.Conditions, error) {
+	trigger, ok := obj.(*eventingv1.Trigger)
+	if !ok {
+		return nil, fmt.Errorf("%v is not a trigger", obj)
+	}
+	return apis.Conditions(trigger.Status.Conditions), nil
+}
+
 type TriggerUpdateFunc func(origTrigger *eventingv1.Trigger) (*eventingv1.Trigger, error)
 type BrokerUpdateFunc func(origBroker *eventingv1.Broker) (*eventingv1.Broker, error)
 
@@ -56,10 +75,14 @@ type KnEventingClient interface {
 	UpdateTrigger(ctx context.Context, trigger *eventingv1.Trigger) error
 	// UpdateTriggerWithRetry is used to update an instance of trigger
 	UpdateTriggerWithRetry(ctx context.Context, name string, updateFunc TriggerUpdateFunc, nrRetries int) error
+	// WatchTrigger is used to watch an instance of trigger
+	WatchTrigger(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error)
 	// CreateBroker is used to create an instance of broker
 	CreateBroker(ctx context.Context, broker *eventingv1.Broker) error
 	// GetBroker is used to get an instance of broker
 	GetBroker(ctx context.Context, name string) (*eventingv1.Broker, error)
+	// WatchBroker is used to watch an instance of broker
+	WatchBroker(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error)
 	// DeleteBroker is used to delete an instance of broker
 	DeleteBroker(ctx context.Context, name string, timeout time.Duration) error
 	// ListBrokers returns list of broker CRDs
@@ -289,6 +312,11 @@ func (c *knEventingClient) GetBroker(ctx context.Context, name string) (*eventin
 	return broker, nil
 }
 
+// WatchTrigger is used to create watcher object
+func (c *knEventingClient) WatchTrigger(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
+	return wait.NewWatcherWithVersion(ctx, c.client.Triggers(c.namespace).Watch, c.client.RESTClient(), c.namespace, "triggers", name, initialVersion, timeout)
+}
+
 // WatchBroker is used to create watcher object
 func (c *knEventingClient) WatchBroker(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
 	return wait.NewWatcherWithVersion(ctx, c.client.Brokers(c.namespace).Watch, c.client.RESTClient(), c.namespace, "brokers", name, initialVersion, timeout)
@@ -19,6 +19,7 @@ import (
 	"testing"
 	"time"
 
+	"k8s.io/apimachinery/pkg/watch"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 
 	"knative.dev/client/pkg/util/mock"
@@ -141,6 +142,30 @@ func (c *MockKnEventingClient) GetBroker(ctx context.Context, name string) (*eve
 	return call.Result[0].(*eventingv1.Broker), mock.ErrorOrNil(call.Result[1])
 }
 
+// WatchBroker records a call for WatchBroker with the expected watch or error
+func (sr *EventingRecorder) WatchBroker(name, initialVersion interface{}, watcher watch.Interface, err error) {
+	sr.r.Add("WatchBroker", []interface{}{name, initialVersion}, []interface{}{watcher, err})
+}
+
+// WatchBroker performs a previously recorded action
+func (c *MockKnEventingClient) WatchBroker(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchBroker", name, initialVersion)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
+// WatchTrigger records a call for WatchTrigger with the expected watch or error
+func (sr *EventingRecorder) WatchTrigger(name, initialVersion interface{}, watcher watch.Interface, err error) {
+	sr.r.Add("WatchTrigger", []interface{}{name, initialVersion}, []interface{}{watcher, err})
+}
+
+// WatchTrigger performs a previously recorded action
+func (c *MockKnEventingClient) WatchTrigger(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchTrigger", name, initialVersion)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
 // DeleteBroker records a call for DeleteBroker with the expected error (nil if none)
 func (sr *EventingRecorder) DeleteBroker(name, timeout interface{}, err error) {
 	sr.r.Add("DeleteBroker", []interface{}{name, timeout}, []interface{}{err})
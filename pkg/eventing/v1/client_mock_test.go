@@ -36,6 +36,7 @@ func TestMockKnClient(t *testing.T) {
 	recorder.UpdateTrigger(&eventingv1.Trigger{}, nil)
 	recorder.GetTrigger("hello", &eventingv1.Trigger{}, nil)
 	recorder.UpdateTrigger(&eventingv1.Trigger{}, nil)
+	recorder.WatchTrigger("hello", "1", nil, nil)
 
 	recorder.CreateBroker(&eventingv1.Broker{}, nil)
 	recorder.GetBroker("foo", nil, nil)
@@ -44,6 +45,7 @@ func TestMockKnClient(t *testing.T) {
 	recorder.GetBroker("foo", &eventingv1.Broker{}, nil)
 	recorder.UpdateBroker(&eventingv1.Broker{}, nil)
 	recorder.UpdateBroker(&eventingv1.Broker{}, nil)
+	recorder.WatchBroker("foo", "1", nil, nil)
 
 	// Call all service
 	ctx := context.Background()
@@ -55,6 +57,7 @@ func TestMockKnClient(t *testing.T) {
 	client.UpdateTriggerWithRetry(ctx, "hello", func(origTrigger *eventingv1.Trigger) (*eventingv1.Trigger, error) {
 		return origTrigger, nil
 	}, 10)
+	client.WatchTrigger(ctx, "hello", "1", time.Duration(10)*time.Second)
 
 	client.CreateBroker(ctx, &eventingv1.Broker{})
 	client.GetBroker(ctx, "foo")
@@ -64,6 +67,7 @@ func TestMockKnClient(t *testing.T) {
 	client.UpdateBrokerWithRetry(ctx, "foo", func(origBroker *eventingv1.Broker) (*eventingv1.Broker, error) {
 		return origBroker, nil
 	}, 10)
+	client.WatchBroker(ctx, "foo", "1", time.Duration(10)*time.Second)
 
 	// Validate
 	recorder.Validate()
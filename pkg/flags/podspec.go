@@ -50,6 +50,9 @@ type PodSpecFlags struct {
 
 	ExtraContainers string
 
+	InitContainers     []string
+	InitContainersFile string
+
 	Resources          ResourceOptions
 	Port               string
 	ServiceAccountName string
@@ -189,6 +192,20 @@ func (p *PodSpecFlags) AddFlags(flagset *pflag.FlagSet) []string {
 			"Example: --containers ./containers.yaml or --containers -.")
 	flagNames = append(flagNames, "containers")
 
+	flagset.StringArrayVar(&p.InitContainers, "init-container", []string{},
+		"Add or update an init container, which runs to completion before the containers of the service are started. "+
+			"Format: name=NAME,image=IMAGE[,pull-policy=POLICY]. Only works if the feature gate is enabled in Knative Serving feature flags configuration. "+
+			"Example: --init-container name=setup,image=busybox. "+
+			"You can use this flag multiple times. "+
+			"To remove an init container, append \"-\" to its name, e.g. --init-container setup-.")
+	flagNames = append(flagNames, "init-container")
+
+	flagset.StringVar(&p.InitContainersFile, "init-container-file", "",
+		"Specify path to file including definition for init containers in a list of 'initContainers', alternatively use '-' to read from stdin. "+
+			"Init containers of the same name are replaced. Only works if the feature gate is enabled in Knative Serving feature flags configuration. "+
+			"Example: --init-container-file ./init-containers.yaml or --init-container-file -.")
+	flagNames = append(flagNames, "init-container-file")
+
 	// Probes
 	commonProbeDescription := "Supported probe types are HTTGet, Exec and TCPSocket. " +
 		"Format: [http,https]:host:port:path, exec:cmd[,cmd,...], tcp:host:port."
@@ -400,6 +417,21 @@ func (p *PodSpecFlags) ResolvePodSpec(podSpec *corev1.PodSpec, flags *pflag.Flag
 		UpdateContainers(podSpec, fromFile.Containers)
 	}
 
+	if flags.Changed("init-container-file") {
+		if p.InitContainersFile == "-" && p.ExtraContainers == "-" {
+			return errors.New("--init-container-file and --containers can't both be read from stdin")
+		}
+		if err := UpdateInitContainersFromFile(podSpec, p.InitContainersFile); err != nil {
+			return fmt.Errorf("Invalid --init-container-file: %w", err)
+		}
+	}
+
+	if flags.Changed("init-container") {
+		if err := UpdateInitContainers(podSpec, p.InitContainers); err != nil {
+			return fmt.Errorf("Invalid --init-container: %w", err)
+		}
+	}
+
 	if flags.Changed("probe-liveness") {
 		if err := UpdateLivenessProbe(podSpec, p.LivenessProbe); err != nil {
 			return err
@@ -459,3 +491,69 @@ func (p *PodSpecFlags) ResolvePodSpec(podSpec *corev1.PodSpec, flags *pflag.Flag
 
 	return nil
 }
+
+// DefaultContainerPort is the port of the serving container if no port is specified
+const DefaultContainerPort = 8080
+
+// ResolveContainer applies the flag inputs to the container with the given name, in the same way
+// as ResolvePodSpec does for the first container. Settings of the pod, like volumes or the service
+// account, are applied to the pod spec. The container is added if it doesn't exist yet, which
+// requires an image to be given. As Knative requires the serving container of a pod with multiple
+// containers to declare a port, a single existing container without a port gets the port given
+// with --port or the default port when another container is added.
+func (p *PodSpecFlags) ResolveContainer(podSpec *corev1.PodSpec, name string, flags *pflag.FlagSet, allArgs []string) error {
+	if flags.Changed("containers") || flags.Changed("extra-containers") {
+		return errors.New("--containers can't be combined with --container-name")
+	}
+	index := -1
+	for i := range podSpec.Containers {
+		if podSpec.Containers[i].Name == name {
+			index = i
+			break
+		}
+	}
+	if index < 0 && p.Image == "" {
+		return fmt.Errorf("container '%s' doesn't exist, an image is required to add it", name)
+	}
+
+	// Work on a copy of the pod spec which contains only the selected container
+	scoped := *podSpec
+	if index >= 0 {
+		scoped.Containers = []corev1.Container{podSpec.Containers[index]}
+	} else {
+		scoped.Containers = []corev1.Container{{Name: name}}
+	}
+	if err := p.ResolvePodSpec(&scoped, flags, allArgs); err != nil {
+		return err
+	}
+
+	container := scoped.Containers[0]
+	if index < 0 && len(podSpec.Containers) > 0 && !hasContainerPort(podSpec.Containers) {
+		if len(podSpec.Containers) > 1 {
+			return fmt.Errorf("cannot add container '%s': none of the existing containers declares a port, which is required for the container serving the requests", name)
+		}
+		ports := container.Ports
+		if len(ports) == 0 {
+			ports = []corev1.ContainerPort{{ContainerPort: DefaultContainerPort}}
+		}
+		container.Ports = nil
+		podSpec.Containers[0].Ports = ports
+	}
+	scoped.Containers = podSpec.Containers
+	if index >= 0 {
+		scoped.Containers[index] = container
+	} else {
+		scoped.Containers = append(scoped.Containers, container)
+	}
+	*podSpec = scoped
+	return nil
+}
+
+func hasContainerPort(containers []corev1.Container) bool {
+	for _, container := range containers {
+		if len(container.Ports) > 0 {
+			return true
+		}
+	}
+	return false
+}
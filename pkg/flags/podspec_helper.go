@@ -317,6 +317,89 @@ func UpdateContainers(spec *corev1.PodSpec, containers []corev1.Container) {
 	}
 }
 
+// UpdateInitContainers adds or updates init containers given in the format
+// 'name=NAME,image=IMAGE[,pull-policy=POLICY]'. Only the given fields of an existing init container
+// are updated. An init container is removed when its name is followed by a "-", e.g. 'setup-'.
+func UpdateInitContainers(spec *corev1.PodSpec, initContainers []string) error {
+	for _, initContainer := range initContainers {
+		if strings.HasSuffix(initContainer, "-") && !strings.Contains(initContainer, "=") {
+			name := strings.TrimSuffix(initContainer, "-")
+			if !removeInitContainer(spec, name) {
+				return fmt.Errorf("init container '%s' doesn't exist", name)
+			}
+			continue
+		}
+		options, err := util.MapFromArray(strings.Split(initContainer, ","), "=")
+		if err != nil {
+			return err
+		}
+		name := options["name"]
+		if name == "" {
+			return fmt.Errorf("'%s' doesn't contain the name of the init container, use the format 'name=NAME,image=IMAGE'", initContainer)
+		}
+		container := initContainerOfPodSpec(spec, name)
+		for key, value := range options {
+			switch strings.ToLower(key) {
+			case "name":
+			case "image":
+				container.Image = value
+			case "pull-policy":
+				if !isValidPullPolicy(value) {
+					return fmt.Errorf("invalid pull-policy %s. Valid arguments (case insensitive): Always | Never | IfNotPresent", value)
+				}
+				container.ImagePullPolicy = getPolicy(value)
+			default:
+				return fmt.Errorf("unknown key '%s' for init container '%s', supported keys are name, image and pull-policy", key, name)
+			}
+		}
+		if container.Image == "" {
+			return fmt.Errorf("init container '%s' requires an image", name)
+		}
+	}
+	return nil
+}
+
+// UpdateInitContainersFromFile replaces init containers with the ones of the same name provided
+// from file or os.Stdin and adds those which don't exist yet
+func UpdateInitContainersFromFile(spec *corev1.PodSpec, filename string) error {
+	fromFile, err := decodeContainersFromFile(filename)
+	if err != nil {
+		return err
+	}
+	if len(fromFile.InitContainers) == 0 {
+		return fmt.Errorf("no init containers found in '%s', expected a list of 'initContainers'", filename)
+	}
+	for _, container := range fromFile.InitContainers {
+		*initContainerOfPodSpec(spec, container.Name) = container
+	}
+	return nil
+}
+
+// initContainerOfPodSpec returns the init container with the given name, which is added
+// at the end of the init containers if it doesn't exist yet
+func initContainerOfPodSpec(spec *corev1.PodSpec, name string) *corev1.Container {
+	for i := range spec.InitContainers {
+		if spec.InitContainers[i].Name == name {
+			return &spec.InitContainers[i]
+		}
+	}
+	spec.InitContainers = append(spec.InitContainers, corev1.Container{Name: name})
+	return &spec.InitContainers[len(spec.InitContainers)-1]
+}
+
+func removeInitContainer(spec *corev1.PodSpec, name string) bool {
+	for i := range spec.InitContainers {
+		if spec.InitContainers[i].Name == name {
+			spec.InitContainers = append(spec.InitContainers[:i], spec.InitContainers[i+1:]...)
+			if len(spec.InitContainers) == 0 {
+				spec.InitContainers = nil
+			}
+			return true
+		}
+	}
+	return false
+}
+
 // UpdateLivenessProbe updates container liveness probe based on provided string
 func UpdateLivenessProbe(spec *corev1.PodSpec, probeString string) error {
 	c := containerOfPodSpec(spec)
@@ -1018,6 +1018,65 @@ func TestUpdateContainerWithName(t *testing.T) {
 	}
 }
 
+func TestUpdateInitContainers(t *testing.T) {
+	podSpec := &corev1.PodSpec{InitContainers: []corev1.Container{
+		{Name: "migrate", Image: "migrate:v1", Command: []string{"/migrate"}},
+	}}
+	err := UpdateInitContainers(podSpec, []string{"name=setup,image=busybox", "name=migrate,image=migrate:v2,pull-policy=Never"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, podSpec.InitContainers, []corev1.Container{
+		{Name: "migrate", Image: "migrate:v2", Command: []string{"/migrate"}, ImagePullPolicy: corev1.PullNever},
+		{Name: "setup", Image: "busybox"},
+	})
+
+	err = UpdateInitContainers(podSpec, []string{"migrate-"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, podSpec.InitContainers, []corev1.Container{{Name: "setup", Image: "busybox"}})
+	err = UpdateInitContainers(podSpec, []string{"setup-"})
+	assert.NilError(t, err)
+	assert.Assert(t, podSpec.InitContainers == nil)
+
+	for _, tc := range []struct {
+		arg           string
+		expectedError string
+	}{
+		{"setup-", "init container 'setup' doesn't exist"},
+		{"image=busybox", "doesn't contain the name of the init container"},
+		{"name=setup", "init container 'setup' requires an image"},
+		{"name=setup,image=busybox,cmd=true", "unknown key 'cmd' for init container 'setup'"},
+		{"name=setup,image=busybox,pull-policy=sometimes", "invalid pull-policy sometimes"},
+		{"name", "Argument requires a value"},
+	} {
+		err = UpdateInitContainers(&corev1.PodSpec{}, []string{tc.arg})
+		assert.ErrorContains(t, err, tc.expectedError)
+	}
+}
+
+func TestUpdateInitContainersFromFile(t *testing.T) {
+	rawInput := `
+initContainers:
+- image: migrate:v2
+  name: migrate
+- image: busybox
+  name: setup`
+	fileName := filepath.Join(t.TempDir(), "init.yaml")
+	assert.NilError(t, os.WriteFile(fileName, []byte(rawInput), test.FileModeReadWrite))
+
+	podSpec := &corev1.PodSpec{InitContainers: []corev1.Container{
+		{Name: "migrate", Image: "migrate:v1", Command: []string{"/migrate"}},
+	}}
+	assert.NilError(t, UpdateInitContainersFromFile(podSpec, fileName))
+	assert.DeepEqual(t, podSpec.InitContainers, []corev1.Container{
+		{Name: "migrate", Image: "migrate:v2"},
+		{Name: "setup", Image: "busybox"},
+	})
+
+	emptyFile := filepath.Join(t.TempDir(), "empty.yaml")
+	assert.NilError(t, os.WriteFile(emptyFile, []byte("containers: []"), test.FileModeReadWrite))
+	err := UpdateInitContainersFromFile(podSpec, emptyFile)
+	assert.ErrorContains(t, err, "no init containers found in '"+emptyFile+"'")
+}
+
 func TestParseContainers(t *testing.T) {
 	rawInput := `
 containers:
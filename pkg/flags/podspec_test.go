@@ -45,6 +45,7 @@ func TestPodSpecFlags(t *testing.T) {
 		NodeAffinity:    []string{},
 		Arg:             []string{},
 		Command:         []string{},
+		InitContainers:  []string{},
 		SecurityContext: "none",
 	}
 	flags := &PodSpecFlags{}
@@ -229,6 +230,197 @@ func TestPodSpecMountResolveSubPath(t *testing.T) {
 	}
 }
 
+func TestPodSpecResolveContainer(t *testing.T) {
+	podSpec := func() *corev1.PodSpec {
+		return &corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "app", Image: "app:v1", Ports: []corev1.ContainerPort{{ContainerPort: 8080}}},
+			{Name: "sidecar", Image: "sidecar:v1"},
+		}}
+	}
+	resources := corev1.ResourceRequirements{Limits: corev1.ResourceList{}, Requests: corev1.ResourceList{}}
+
+	testCases := []struct {
+		name          string
+		container     string
+		args          []string
+		expected      []corev1.Container
+		expectedError string
+	}{
+		{
+			"update sidecar",
+			"sidecar",
+			[]string{"--image", "sidecar:v2", "--env", "a=b", "--volume", "data=cm:data", "--mount", "/data=data"},
+			[]corev1.Container{
+				{Name: "app", Image: "app:v1", Ports: []corev1.ContainerPort{{ContainerPort: 8080}}},
+				{Name: "sidecar", Image: "sidecar:v2", Env: []corev1.EnvVar{{Name: "a", Value: "b"}},
+					VolumeMounts: []corev1.VolumeMount{{Name: "data", MountPath: "/data", ReadOnly: true}}, Resources: resources},
+			},
+			"",
+		},
+		{
+			"add container",
+			"proxy",
+			[]string{"--image", "proxy:v1", "--probe-readiness", "tcp::9000"},
+			[]corev1.Container{
+				{Name: "app", Image: "app:v1", Ports: []corev1.ContainerPort{{ContainerPort: 8080}}},
+				{Name: "sidecar", Image: "sidecar:v1"},
+				{Name: "proxy", Image: "proxy:v1", Resources: resources, ReadinessProbe: &corev1.Probe{
+					ProbeHandler: corev1.ProbeHandler{TCPSocket: &corev1.TCPSocketAction{Port: intstr.Parse("9000")}},
+				}},
+			},
+			"",
+		},
+		{
+			"add container without image",
+			"proxy",
+			[]string{"--env", "a=b"},
+			nil,
+			"container 'proxy' doesn't exist, an image is required to add it",
+		},
+		{
+			"combined with --containers",
+			"sidecar",
+			[]string{"--containers", "containers.yaml"},
+			nil,
+			"--containers can't be combined with --container-name",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			flags := &PodSpecFlags{}
+			testCmd := &cobra.Command{
+				Use: "test",
+				RunE: func(cmd *cobra.Command, args []string) error {
+					spec := podSpec()
+					err := flags.ResolveContainer(spec, tc.container, cmd.Flags(), tc.args)
+					if tc.expectedError != "" {
+						assert.ErrorContains(t, err, tc.expectedError)
+						return nil
+					}
+					assert.NilError(t, err)
+					assert.DeepEqual(t, tc.expected, spec.Containers)
+					if tc.container == "sidecar" {
+						assert.Equal(t, len(spec.Volumes), 1)
+					}
+					return nil
+				},
+			}
+			testCmd.SetArgs(tc.args)
+			flags.AddFlags(testCmd.Flags())
+			flags.AddUpdateFlags(testCmd.Flags())
+			assert.NilError(t, testCmd.Execute())
+		})
+	}
+}
+
+func TestPodSpecResolveContainerWithoutPort(t *testing.T) {
+	resources := corev1.ResourceRequirements{Limits: corev1.ResourceList{}, Requests: corev1.ResourceList{}}
+	testCases := []struct {
+		name          string
+		containers    []corev1.Container
+		args          []string
+		expected      []corev1.Container
+		expectedError string
+	}{
+		{
+			"default port",
+			[]corev1.Container{{Name: "app", Image: "app:v1"}},
+			[]string{"--image", "sidecar:v1"},
+			[]corev1.Container{
+				{Name: "app", Image: "app:v1", Ports: []corev1.ContainerPort{{ContainerPort: 8080}}},
+				{Name: "sidecar", Image: "sidecar:v1", Resources: resources},
+			},
+			"",
+		},
+		{
+			"given port",
+			[]corev1.Container{{Name: "app", Image: "app:v1"}},
+			[]string{"--image", "sidecar:v1", "--port", "h2c:9000"},
+			[]corev1.Container{
+				{Name: "app", Image: "app:v1", Ports: []corev1.ContainerPort{{Name: "h2c", ContainerPort: 9000}}},
+				{Name: "sidecar", Image: "sidecar:v1", Resources: resources},
+			},
+			"",
+		},
+		{
+			"multiple containers without port",
+			[]corev1.Container{{Name: "app", Image: "app:v1"}, {Name: "proxy", Image: "proxy:v1"}},
+			[]string{"--image", "sidecar:v1"},
+			nil,
+			"cannot add container 'sidecar': none of the existing containers declares a port",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			flags := &PodSpecFlags{}
+			testCmd := &cobra.Command{
+				Use: "test",
+				RunE: func(cmd *cobra.Command, args []string) error {
+					spec := &corev1.PodSpec{Containers: tc.containers}
+					err := flags.ResolveContainer(spec, "sidecar", cmd.Flags(), tc.args)
+					if tc.expectedError != "" {
+						assert.ErrorContains(t, err, tc.expectedError)
+						return nil
+					}
+					assert.NilError(t, err)
+					assert.DeepEqual(t, tc.expected, spec.Containers)
+					return nil
+				},
+			}
+			testCmd.SetArgs(tc.args)
+			flags.AddFlags(testCmd.Flags())
+			flags.AddUpdateFlags(testCmd.Flags())
+			assert.NilError(t, testCmd.Execute())
+		})
+	}
+}
+
+func TestPodSpecResolveInitContainers(t *testing.T) {
+	rawInput := `
+initContainers:
+- image: migrate:v1
+  name: migrate
+  command: ["/migrate"]`
+	fileName := filepath.Join(t.TempDir(), "init.yaml")
+	assert.NilError(t, os.WriteFile(fileName, []byte(rawInput), test.FileModeReadWrite))
+
+	inputArgs := []string{"--image", "app:v1", "--init-container-file", fileName,
+		"--init-container", "name=setup,image=busybox,pull-policy=always"}
+	flags := &PodSpecFlags{}
+	testCmd := &cobra.Command{
+		Use: "test",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			podSpec := &corev1.PodSpec{Containers: []corev1.Container{{}}}
+			err := flags.ResolvePodSpec(podSpec, cmd.Flags(), inputArgs)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, podSpec.InitContainers, []corev1.Container{
+				{Name: "migrate", Image: "migrate:v1", Command: []string{"/migrate"}},
+				{Name: "setup", Image: "busybox", ImagePullPolicy: corev1.PullAlways},
+			})
+			assert.Equal(t, podSpec.Containers[0].Image, "app:v1")
+			return nil
+		},
+	}
+	testCmd.SetArgs(inputArgs)
+	flags.AddFlags(testCmd.Flags())
+	flags.AddUpdateFlags(testCmd.Flags())
+	assert.NilError(t, testCmd.Execute())
+
+	flags = &PodSpecFlags{}
+	testCmd = &cobra.Command{
+		Use:          "test",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return flags.ResolvePodSpec(&corev1.PodSpec{Containers: []corev1.Container{{}}}, cmd.Flags(), args)
+		},
+	}
+	testCmd.SetArgs([]string{"--init-container", "image=busybox"})
+	flags.AddFlags(testCmd.Flags())
+	flags.AddUpdateFlags(testCmd.Flags())
+	err := testCmd.Execute()
+	assert.ErrorContains(t, err, "Invalid --init-container: 'image=busybox' doesn't contain the name of the init container")
+}
+
 func TestPodSpecResolveContainers(t *testing.T) {
 	rawInput := `
 containers:
@@ -0,0 +1,281 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+)
+
+// Archive is an image, or an index of images, read from the local filesystem
+type Archive struct {
+	// Image is set if the archive contains a single image
+	Image v1.Image
+	// Index is set if the archive contains an image index, e.g. of a multi-platform image
+	Index v1.ImageIndex
+	// Size is the number of bytes of all manifests, configs and layers of the archive
+	Size int64
+
+	tempDir string
+}
+
+// LoadArchive reads an image from an OCI image layout directory, a tarball of an OCI image
+// layout, or a tarball as written by 'docker save'. An OCI image layout with a single entry
+// is read as the image or index of this entry. The archive must be closed after use.
+func LoadArchive(file string) (*Archive, error) {
+	archive, err := loadArchive(file)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read image archive '%s': %w", file, err)
+	}
+	return archive, nil
+}
+
+func loadArchive(file string) (*Archive, error) {
+	info, err := os.Stat(file)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return archiveFromLayout(file)
+	}
+
+	isLayout, err := isLayoutTarball(file)
+	if err != nil {
+		return nil, err
+	}
+	if !isLayout {
+		img, err := tarball.ImageFromPath(file, nil)
+		if err != nil {
+			return nil, err
+		}
+		return newImageArchive(img)
+	}
+
+	// The blobs of a layout are read lazily from the files of the layout
+	dir, err := os.MkdirTemp("", "kn-image-")
+	if err != nil {
+		return nil, err
+	}
+	archive, err := func() (*Archive, error) {
+		if err := extractTarball(file, dir); err != nil {
+			return nil, err
+		}
+		return archiveFromLayout(dir)
+	}()
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	archive.tempDir = dir
+	return archive, nil
+}
+
+// Close removes any temporary files created for reading the archive
+func (a *Archive) Close() error {
+	if a.tempDir == "" {
+		return nil
+	}
+	return os.RemoveAll(a.tempDir)
+}
+
+// Digest returns the digest of the image or image index of the archive
+func (a *Archive) Digest() (v1.Hash, error) {
+	if a.Index != nil {
+		return a.Index.Digest()
+	}
+	return a.Image.Digest()
+}
+
+func (a *Archive) taggable() remote.Taggable {
+	if a.Index != nil {
+		return a.Index
+	}
+	return a.Image
+}
+
+func archiveFromLayout(dir string) (*Archive, error) {
+	index, err := layout.ImageIndexFromPath(dir)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+	if len(manifest.Manifests) == 0 {
+		return nil, errors.New("image layout doesn't contain any image")
+	}
+	if len(manifest.Manifests) > 1 {
+		return newIndexArchive(index)
+	}
+	descriptor := manifest.Manifests[0]
+	switch {
+	case descriptor.MediaType.IsImage():
+		img, err := index.Image(descriptor.Digest)
+		if err != nil {
+			return nil, err
+		}
+		return newImageArchive(img)
+	case descriptor.MediaType.IsIndex():
+		child, err := index.ImageIndex(descriptor.Digest)
+		if err != nil {
+			return nil, err
+		}
+		return newIndexArchive(child)
+	default:
+		return nil, fmt.Errorf("unsupported media type '%s' of image layout entry", descriptor.MediaType)
+	}
+}
+
+func newImageArchive(img v1.Image) (*Archive, error) {
+	size, err := imageSize(img, map[v1.Hash]bool{})
+	if err != nil {
+		return nil, err
+	}
+	return &Archive{Image: img, Size: size}, nil
+}
+
+func newIndexArchive(index v1.ImageIndex) (*Archive, error) {
+	size, err := indexSize(index, map[v1.Hash]bool{})
+	if err != nil {
+		return nil, err
+	}
+	return &Archive{Index: index, Size: size}, nil
+}
+
+// imageSize sums up the sizes of the blobs of an image, which haven't been seen yet
+func imageSize(img v1.Image, seen map[v1.Hash]bool) (int64, error) {
+	size, err := img.Size()
+	if err != nil {
+		return 0, err
+	}
+	manifest, err := img.Manifest()
+	if err != nil {
+		return 0, err
+	}
+	for _, blob := range append([]v1.Descriptor{manifest.Config}, manifest.Layers...) {
+		if !seen[blob.Digest] {
+			seen[blob.Digest] = true
+			size += blob.Size
+		}
+	}
+	return size, nil
+}
+
+func indexSize(index v1.ImageIndex, seen map[v1.Hash]bool) (int64, error) {
+	size, err := index.Size()
+	if err != nil {
+		return 0, err
+	}
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return 0, err
+	}
+	for _, descriptor := range manifest.Manifests {
+		var childSize int64
+		switch {
+		case descriptor.MediaType.IsImage():
+			img, err := index.Image(descriptor.Digest)
+			if err != nil {
+				return 0, err
+			}
+			childSize, err = imageSize(img, seen)
+			if err != nil {
+				return 0, err
+			}
+		case descriptor.MediaType.IsIndex():
+			child, err := index.ImageIndex(descriptor.Digest)
+			if err != nil {
+				return 0, err
+			}
+			childSize, err = indexSize(child, seen)
+			if err != nil {
+				return 0, err
+			}
+		}
+		size += childSize
+	}
+	return size, nil
+}
+
+// isLayoutTarball checks whether the tarball contains an OCI image layout
+func isLayoutTarball(file string) (bool, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	reader := tar.NewReader(f)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if path.Clean(header.Name) == "oci-layout" {
+			return true, nil
+		}
+	}
+}
+
+func extractTarball(file, dir string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	reader := tar.NewReader(f)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		// Cleaning the rooted name prevents files from being written outside of the directory
+		target := filepath.Join(dir, filepath.FromSlash(path.Clean("/"+header.Name)))
+		if err := os.MkdirAll(filepath.Dir(target), 0o700); err != nil {
+			return err
+		}
+		out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(out, reader)
+		closeErr := out.Close()
+		if err != nil {
+			return err
+		}
+		if closeErr != nil {
+			return closeErr
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"archive/tar"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"gotest.tools/v3/assert"
+)
+
+func TestLoadArchiveLayout(t *testing.T) {
+	dir := t.TempDir()
+	img, err := random.Image(100, 2)
+	assert.NilError(t, err)
+	path, err := layout.Write(dir, empty.Index)
+	assert.NilError(t, err)
+	assert.NilError(t, path.AppendImage(img))
+	digest, err := img.Digest()
+	assert.NilError(t, err)
+
+	archive, err := LoadArchive(dir)
+	assert.NilError(t, err)
+	defer archive.Close()
+	assert.Assert(t, archive.Image != nil && archive.Index == nil)
+	archiveDigest, err := archive.Digest()
+	assert.NilError(t, err)
+	assert.Equal(t, archiveDigest, digest)
+	manifestSize, err := img.Size()
+	assert.NilError(t, err)
+	rawConfig, err := img.RawConfigFile()
+	assert.NilError(t, err)
+	assert.Assert(t, archive.Size > manifestSize+int64(len(rawConfig))+200)
+
+	// A tarball of the layout
+	file := filepath.Join(t.TempDir(), "app.tar")
+	writeTarball(t, dir, file)
+	tarArchive, err := LoadArchive(file)
+	assert.NilError(t, err)
+	tarDigest, err := tarArchive.Digest()
+	assert.NilError(t, err)
+	assert.Equal(t, tarDigest, digest)
+	assert.Equal(t, tarArchive.Size, archive.Size)
+	tempDir := tarArchive.tempDir
+	assert.NilError(t, tarArchive.Close())
+	_, err = os.Stat(tempDir)
+	assert.Assert(t, os.IsNotExist(err))
+
+	// Multiple images are read as index
+	assert.NilError(t, path.AppendImage(img))
+	archive, err = LoadArchive(dir)
+	assert.NilError(t, err)
+	assert.Assert(t, archive.Image == nil && archive.Index != nil)
+}
+
+func TestLoadArchiveDockerTarball(t *testing.T) {
+	img, err := random.Image(100, 1)
+	assert.NilError(t, err)
+	file := filepath.Join(t.TempDir(), "docker.tar")
+	tag, err := name.NewTag("example.com/app:v1")
+	assert.NilError(t, err)
+	assert.NilError(t, tarball.WriteToFile(file, tag, img))
+
+	archive, err := LoadArchive(file)
+	assert.NilError(t, err)
+	defer archive.Close()
+	digest, err := img.Digest()
+	assert.NilError(t, err)
+	archiveDigest, err := archive.Digest()
+	assert.NilError(t, err)
+	assert.Equal(t, archiveDigest, digest)
+}
+
+func TestLoadArchiveErrors(t *testing.T) {
+	dir := t.TempDir()
+	_, err := LoadArchive(filepath.Join(dir, "missing.tar"))
+	assert.ErrorContains(t, err, "cannot read image archive")
+
+	_, err = layout.Write(dir, empty.Index)
+	assert.NilError(t, err)
+	_, err = LoadArchive(dir)
+	assert.ErrorContains(t, err, "image layout doesn't contain any image")
+
+	file := filepath.Join(dir, "invalid.tar")
+	assert.NilError(t, os.WriteFile(file, []byte("no tarball"), 0o600))
+	_, err = LoadArchive(file)
+	assert.ErrorContains(t, err, "cannot read image archive '"+file+"'")
+}
+
+// writeTarball writes all files of the directory to a tarball
+func writeTarball(t *testing.T, dir, file string) {
+	out, err := os.Create(file)
+	assert.NilError(t, err)
+	defer out.Close()
+	writer := tar.NewWriter(out)
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := writer.WriteHeader(&tar.Header{Name: filepath.ToSlash(rel), Mode: 0o600, Size: int64(len(content))}); err != nil {
+			return err
+		}
+		_, err = writer.Write(content)
+		return err
+	})
+	assert.NilError(t, err)
+	assert.NilError(t, writer.Close())
+}
@@ -0,0 +1,167 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Registry hosts which all refer to Docker Hub
+var dockerHubHosts = map[string]bool{
+	name.DefaultRegistry:   true,
+	"docker.io":            true,
+	"registry-1.docker.io": true,
+}
+
+// pullSecretEntry holds the credentials of a single registry entry of a pull secret,
+// which can be restricted to a repository path
+type pullSecretEntry struct {
+	host string
+	path string
+	auth authn.AuthConfig
+}
+
+// pullSecretKeychain provides the credentials stored in image pull secrets
+type pullSecretKeychain struct {
+	entries []pullSecretEntry
+}
+
+// dockerConfig is the content of a '.dockercfg' key, or the 'auths' of a '.dockerconfigjson' key
+type dockerConfig map[string]authn.AuthConfig
+
+// NewPullSecretKeychain creates a keychain from the given image pull secrets of type
+// 'kubernetes.io/dockerconfigjson' or 'kubernetes.io/dockercfg'
+func NewPullSecretKeychain(secrets []corev1.Secret) (authn.Keychain, error) {
+	keychain := &pullSecretKeychain{}
+	for _, secret := range secrets {
+		var config dockerConfig
+		if data, ok := secret.Data[corev1.DockerConfigJsonKey]; ok {
+			var configJSON struct {
+				Auths dockerConfig `json:"auths"`
+			}
+			if err := json.Unmarshal(data, &configJSON); err != nil {
+				return nil, fmt.Errorf("cannot parse pull secret '%s': %w", secret.Name, err)
+			}
+			config = configJSON.Auths
+		} else if data, ok := secret.Data[corev1.DockerConfigKey]; ok {
+			if err := json.Unmarshal(data, &config); err != nil {
+				return nil, fmt.Errorf("cannot parse pull secret '%s': %w", secret.Name, err)
+			}
+		}
+		for key, auth := range config {
+			host, path := parseRegistryKey(key)
+			keychain.entries = append(keychain.entries, pullSecretEntry{host: host, path: path, auth: auth})
+		}
+	}
+	return keychain, nil
+}
+
+// Resolve returns the credentials of the entry matching the registry and the longest
+// prefix of the repository, or anonymous access if no entry matches
+func (k *pullSecretKeychain) Resolve(target authn.Resource) (authn.Authenticator, error) {
+	registry := normalizeHost(target.RegistryStr())
+	repository := strings.TrimPrefix(strings.TrimPrefix(target.String(), target.RegistryStr()), "/")
+	var match *pullSecretEntry
+	for i := range k.entries {
+		entry := &k.entries[i]
+		if entry.host != registry {
+			continue
+		}
+		if entry.path != "" && repository != entry.path && !strings.HasPrefix(repository, entry.path+"/") {
+			continue
+		}
+		if match == nil || len(entry.path) > len(match.path) {
+			match = entry
+		}
+	}
+	if match == nil {
+		return authn.Anonymous, nil
+	}
+	return authn.FromConfig(match.auth), nil
+}
+
+// parseRegistryKey splits a key of a docker config, like 'https://index.docker.io/v1/' or
+// 'registry.example.com/team', into the registry host and a repository path
+func parseRegistryKey(key string) (string, string) {
+	if strings.Contains(key, "://") {
+		if u, err := url.Parse(key); err == nil {
+			key = u.Host + u.Path
+		}
+	}
+	host, path, _ := strings.Cut(strings.TrimSuffix(key, "/"), "/")
+	if dockerHubHosts[normalizeHost(host)] && path == "v1" {
+		// Legacy Docker Hub endpoint
+		path = ""
+	}
+	return normalizeHost(host), path
+}
+
+func normalizeHost(host string) string {
+	host = strings.ToLower(host)
+	if dockerHubHosts[host] {
+		return name.DefaultRegistry
+	}
+	return host
+}
+
+// NewNamespaceKeychain creates a keychain with the credentials which a revision running with
+// the given service account and pull secrets would use, followed by the local Docker
+// credentials. Pull secrets which can't be read are skipped, so that the local credentials are
+// used in this case.
+func NewNamespaceKeychain(ctx context.Context, client kubernetes.Interface, namespace, serviceAccount string, pullSecrets []corev1.LocalObjectReference) (authn.Keychain, error) {
+	if serviceAccount == "" {
+		serviceAccount = "default"
+	}
+	names := append([]corev1.LocalObjectReference{}, pullSecrets...)
+	account, err := client.CoreV1().ServiceAccounts(namespace).Get(ctx, serviceAccount, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		names = append(names, account.ImagePullSecrets...)
+	case !isIgnorableSecretError(err):
+		return nil, err
+	}
+
+	var secrets []corev1.Secret
+	for _, ref := range names {
+		secret, err := client.CoreV1().Secrets(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			if isIgnorableSecretError(err) {
+				continue
+			}
+			return nil, err
+		}
+		secrets = append(secrets, *secret)
+	}
+	keychain, err := NewPullSecretKeychain(secrets)
+	if err != nil {
+		return nil, err
+	}
+	return authn.NewMultiKeychain(keychain, authn.DefaultKeychain), nil
+}
+
+func isIgnorableSecretError(err error) bool {
+	return apierrors.IsNotFound(err) || apierrors.IsForbidden(err)
+}
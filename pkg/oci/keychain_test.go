@@ -0,0 +1,123 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestParseRegistryKey(t *testing.T) {
+	for _, tc := range []struct {
+		key  string
+		host string
+		path string
+	}{
+		{"https://index.docker.io/v1/", "index.docker.io", ""},
+		{"docker.io", "index.docker.io", ""},
+		{"registry.example.com", "registry.example.com", ""},
+		{"Registry.Example.com:5000/team/", "registry.example.com:5000", "team"},
+		{"https://registry.example.com/team/app", "registry.example.com", "team/app"},
+	} {
+		host, path := parseRegistryKey(tc.key)
+		assert.Equal(t, host, tc.host, tc.key)
+		assert.Equal(t, path, tc.path, tc.key)
+	}
+}
+
+func TestPullSecretKeychain(t *testing.T) {
+	secrets := append(pullSecrets("registry.example.com", "all", "pw"), pullSecrets("registry.example.com/team", "team", "pw")...)
+	secrets = append(secrets, corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "legacy"},
+		Type:       corev1.SecretTypeDockercfg,
+		Data: map[string][]byte{
+			corev1.DockerConfigKey: []byte(`{"https://index.docker.io/v1/":{"username":"hub","password":"pw"}}`),
+		},
+	})
+	keychain, err := NewPullSecretKeychain(secrets)
+	assert.NilError(t, err)
+
+	for _, tc := range []struct {
+		image    string
+		username string
+	}{
+		{"registry.example.com/app", "all"},
+		{"registry.example.com/team/app", "team"},
+		{"registry.example.com/teams/app", "all"},
+		{"nginx", "hub"},
+		{"other.example.com/app", ""},
+	} {
+		ref, err := name.ParseReference(tc.image)
+		assert.NilError(t, err)
+		auth, err := keychain.Resolve(ref.Context())
+		assert.NilError(t, err)
+		config, err := auth.Authorization()
+		assert.NilError(t, err)
+		assert.Equal(t, config.Username, tc.username, tc.image)
+	}
+
+	_, err = NewPullSecretKeychain([]corev1.Secret{{
+		ObjectMeta: metav1.ObjectMeta{Name: "broken"},
+		Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte("{")},
+	}})
+	assert.ErrorContains(t, err, "cannot parse pull secret 'broken'")
+}
+
+func TestNamespaceKeychain(t *testing.T) {
+	secrets := pullSecrets("registry.example.com", "user", "pw")
+	secrets[0].Namespace = "default"
+	client := fake.NewSimpleClientset(&secrets[0], &corev1.ServiceAccount{
+		ObjectMeta:       metav1.ObjectMeta{Name: "builder", Namespace: "default"},
+		ImagePullSecrets: []corev1.LocalObjectReference{{Name: secrets[0].Name}, {Name: "missing"}},
+	})
+	ref, err := name.ParseReference("registry.example.com/app")
+	assert.NilError(t, err)
+
+	keychain, err := NewNamespaceKeychain(context.Background(), client, "default", "builder", nil)
+	assert.NilError(t, err)
+	auth, err := keychain.Resolve(ref.Context())
+	assert.NilError(t, err)
+	config, err := auth.Authorization()
+	assert.NilError(t, err)
+	assert.Equal(t, config.Username, "user")
+
+	// No service account, but the pull secret is referenced directly
+	keychain, err = NewNamespaceKeychain(context.Background(), client, "default", "", []corev1.LocalObjectReference{{Name: secrets[0].Name}})
+	assert.NilError(t, err)
+	auth, err = keychain.Resolve(ref.Context())
+	assert.NilError(t, err)
+	config, err = auth.Authorization()
+	assert.NilError(t, err)
+	assert.Equal(t, config.Username, "user")
+}
+
+func pullSecrets(registry, username, password string) []corev1.Secret {
+	auth := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+	return []corev1.Secret{{
+		ObjectMeta: metav1.ObjectMeta{Name: "pull-" + username},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: []byte(fmt.Sprintf(`{"auths":{%q:{"auth":%q}}}`, registry, auth)),
+		},
+	}}
+}
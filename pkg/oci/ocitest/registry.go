@@ -0,0 +1,75 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ocitest provides an in-process container registry for tests
+package ocitest
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// NewTestRegistry starts an in-process registry for tests, which requires basic
+// authentication if a username is given. The registry is reachable via plain HTTP
+// under the host of the returned server's URL.
+func NewTestRegistry(username, password string) (server *httptest.Server, host string) {
+	handler := registry.New(registry.Logger(log.New(io.Discard, "", 0)))
+	if username != "" {
+		next := handler
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user, pass, ok := r.BasicAuth()
+			if !ok || user != username || pass != password {
+				w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+	server = httptest.NewServer(handler)
+	return server, strings.TrimPrefix(server.URL, "http://")
+}
+
+// PushTestImage pushes a random image to the given reference of a test registry and
+// returns the digest of the image
+func PushTestImage(image string, auth authn.Authenticator) (string, error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return "", err
+	}
+	img, err := random.Image(256, 1)
+	if err != nil {
+		return "", err
+	}
+	if auth == nil {
+		auth = authn.Anonymous
+	}
+	if err := remote.Write(ref, img, remote.WithAuth(auth)); err != nil {
+		return "", err
+	}
+	digest, err := img.Digest()
+	if err != nil {
+		return "", err
+	}
+	return digest.String(), nil
+}
@@ -0,0 +1,119 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// ProgressFunc is called with the number of bytes uploaded since its last call
+type ProgressFunc func(uploaded int64)
+
+// Pusher uploads image archives to registries
+type Pusher struct {
+	keychain authn.Keychain
+}
+
+// NewPusher creates a pusher which authenticates with the credentials of the given
+// keychain. The local Docker credentials are used if the keychain is nil.
+func NewPusher(keychain authn.Keychain) *Pusher {
+	if keychain == nil {
+		keychain = authn.DefaultKeychain
+	}
+	return &Pusher{keychain: keychain}
+}
+
+// Push uploads the archive to the repository of the given target and returns the reference
+// of the pushed image pinned to its digest, e.g. 'registry.example.com/app@sha256:...'.
+// The image is tagged only if the target contains a tag. The upload progress is reported
+// to the given function, if any.
+func (p *Pusher) Push(ctx context.Context, archive *Archive, target string, progress ProgressFunc) (string, error) {
+	digestRef, tag, err := pushReferences(archive, target)
+	if err != nil {
+		return "", err
+	}
+	var ref name.Reference = digestRef
+	if tag != nil {
+		ref = tag
+	}
+
+	options := []remote.Option{
+		remote.WithContext(ctx),
+		remote.WithAuthFromKeychain(p.keychain),
+	}
+	done := make(chan struct{})
+	if progress != nil {
+		updates := make(chan v1.Update)
+		options = append(options, remote.WithProgress(updates))
+		go func() {
+			defer close(done)
+			var complete int64
+			// The channel is closed when the push is finished
+			for update := range updates {
+				if update.Complete > complete {
+					progress(update.Complete - complete)
+					complete = update.Complete
+				}
+			}
+		}()
+	} else {
+		close(done)
+	}
+	err = remote.Push(ref, archive.taggable(), options...)
+	<-done
+	if err != nil {
+		return "", fmt.Errorf("cannot push image to '%s': %w", target, err)
+	}
+	return digestRef.String(), nil
+}
+
+// PushedReference returns the reference pinned to the digest, which the archive has after
+// it has been pushed to the given target
+func PushedReference(archive *Archive, target string) (string, error) {
+	digestRef, _, err := pushReferences(archive, target)
+	if err != nil {
+		return "", err
+	}
+	return digestRef.String(), nil
+}
+
+// pushReferences returns the digest reference of the archive in the repository of the target,
+// and the tag of the target if it contains one
+func pushReferences(archive *Archive, target string) (name.Digest, *name.Tag, error) {
+	if strings.Contains(target, "@") {
+		return name.Digest{}, nil, fmt.Errorf("cannot push image to '%s': a digest can't be given as target", target)
+	}
+	tag, err := name.NewTag(target)
+	if err != nil {
+		return name.Digest{}, nil, fmt.Errorf("cannot push image to '%s': %w", target, err)
+	}
+	digest, err := archive.Digest()
+	if err != nil {
+		return name.Digest{}, nil, err
+	}
+	digestRef := tag.Context().Digest(digest.String())
+	// Without an explicit tag, the image is pushed by digest only and not as 'latest'
+	if !strings.HasSuffix(target, ":"+tag.TagStr()) {
+		return digestRef, nil, nil
+	}
+	return digestRef, &tag, nil
+}
@@ -0,0 +1,82 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"gotest.tools/v3/assert"
+
+	"knative.dev/client/pkg/oci/ocitest"
+)
+
+func TestPush(t *testing.T) {
+	server, host := ocitest.NewTestRegistry("", "")
+	defer server.Close()
+	img, err := random.Image(1024, 3)
+	assert.NilError(t, err)
+	archive, err := newImageArchive(img)
+	assert.NilError(t, err)
+	digest, err := img.Digest()
+	assert.NilError(t, err)
+	pusher := NewPusher(authn.NewMultiKeychain())
+	resolver := NewResolver(authn.NewMultiKeychain())
+
+	// Without tag the image is pushed by digest only
+	var uploaded int64
+	ref, err := pusher.Push(context.Background(), archive, host+"/team/app", func(n int64) {
+		atomic.AddInt64(&uploaded, n)
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, ref, host+"/team/app@"+digest.String())
+	assert.Equal(t, uploaded, archive.Size)
+	resolved, err := resolver.Resolve(context.Background(), ref)
+	assert.NilError(t, err)
+	assert.Equal(t, resolved, ref)
+	_, err = resolver.Resolve(context.Background(), host+"/team/app:latest")
+	assert.ErrorContains(t, err, "cannot resolve digest")
+
+	ref, err = pusher.Push(context.Background(), archive, host+"/team/app:v1", nil)
+	assert.NilError(t, err)
+	assert.Equal(t, ref, host+"/team/app@"+digest.String())
+	resolved, err = resolver.Resolve(context.Background(), host+"/team/app:v1")
+	assert.NilError(t, err)
+	assert.Equal(t, resolved, ref)
+
+	pushed, err := PushedReference(archive, "example.com/app:v2")
+	assert.NilError(t, err)
+	assert.Equal(t, pushed, "example.com/app@"+digest.String())
+}
+
+func TestPushErrors(t *testing.T) {
+	server, host := ocitest.NewTestRegistry("user", "secret")
+	defer server.Close()
+	img, err := random.Image(1024, 1)
+	assert.NilError(t, err)
+	archive, err := newImageArchive(img)
+	assert.NilError(t, err)
+	pusher := NewPusher(authn.NewMultiKeychain())
+
+	_, err = pusher.Push(context.Background(), archive, host+"/app", func(int64) {})
+	assert.ErrorContains(t, err, "cannot push image to '"+host+"/app'")
+	_, err = pusher.Push(context.Background(), archive, host+"/app@sha256:abc", nil)
+	assert.ErrorContains(t, err, "a digest can't be given as target")
+	_, err = PushedReference(archive, "Invalid:Name:x")
+	assert.ErrorContains(t, err, "cannot push image to 'Invalid:Name:x'")
+}
@@ -0,0 +1,66 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// Resolver looks up the digests of image tags in their registries
+type Resolver struct {
+	keychain authn.Keychain
+}
+
+// NewResolver creates a resolver which authenticates with the credentials of the given
+// keychain. The local Docker credentials are used if the keychain is nil.
+func NewResolver(keychain authn.Keychain) *Resolver {
+	if keychain == nil {
+		keychain = authn.DefaultKeychain
+	}
+	return &Resolver{keychain: keychain}
+}
+
+// Resolve returns the image reference pinned to the digest its tag currently points to,
+// e.g. 'nginx@sha256:...' for 'nginx:1.27'. For multi-platform images this is the digest
+// of the image index. Images which already refer to a digest are returned unchanged.
+func (r *Resolver) Resolve(ctx context.Context, image string) (string, error) {
+	if strings.Contains(image, "@") {
+		return image, nil
+	}
+	tag, err := name.NewTag(image)
+	if err != nil {
+		return "", fmt.Errorf("cannot resolve digest of image '%s': %w", image, err)
+	}
+	options := []remote.Option{
+		remote.WithContext(ctx),
+		remote.WithAuthFromKeychain(r.keychain),
+	}
+	descriptor, err := remote.Head(tag, options...)
+	if err != nil {
+		// Not all registries support HEAD requests for manifests
+		full, getErr := remote.Get(tag, options...)
+		if getErr != nil {
+			return "", fmt.Errorf("cannot resolve digest of image '%s': %w", image, getErr)
+		}
+		descriptor = &full.Descriptor
+	}
+	return strings.TrimSuffix(image, ":"+tag.TagStr()) + "@" + descriptor.Digest.String(), nil
+}
@@ -0,0 +1,70 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oci
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"gotest.tools/v3/assert"
+
+	"knative.dev/client/pkg/oci/ocitest"
+)
+
+func TestResolve(t *testing.T) {
+	server, host := ocitest.NewTestRegistry("", "")
+	defer server.Close()
+	digest, err := ocitest.PushTestImage(host+"/foo/bar:v1", nil)
+	assert.NilError(t, err)
+
+	resolver := NewResolver(authn.NewMultiKeychain())
+	resolved, err := resolver.Resolve(context.Background(), host+"/foo/bar:v1")
+	assert.NilError(t, err)
+	assert.Equal(t, resolved, host+"/foo/bar@"+digest)
+
+	// Without tag, 'latest' is resolved
+	_, err = ocitest.PushTestImage(host+"/foo/bar", nil)
+	assert.NilError(t, err)
+	resolved, err = resolver.Resolve(context.Background(), host+"/foo/bar")
+	assert.NilError(t, err)
+	assert.Assert(t, resolved != host+"/foo/bar@"+digest)
+
+	// Digests are kept
+	resolved, err = resolver.Resolve(context.Background(), host+"/foo/bar@"+digest)
+	assert.NilError(t, err)
+	assert.Equal(t, resolved, host+"/foo/bar@"+digest)
+
+	_, err = resolver.Resolve(context.Background(), host+"/foo/bar:v2")
+	assert.ErrorContains(t, err, "cannot resolve digest of image '"+host+"/foo/bar:v2'")
+	_, err = resolver.Resolve(context.Background(), "Foo:bar:baz")
+	assert.ErrorContains(t, err, "cannot resolve digest of image 'Foo:bar:baz'")
+}
+
+func TestResolveWithCredentials(t *testing.T) {
+	server, host := ocitest.NewTestRegistry("user", "secret")
+	defer server.Close()
+	digest, err := ocitest.PushTestImage(host+"/foo/bar:v1", &authn.Basic{Username: "user", Password: "secret"})
+	assert.NilError(t, err)
+
+	_, err = NewResolver(authn.NewMultiKeychain()).Resolve(context.Background(), host+"/foo/bar:v1")
+	assert.ErrorContains(t, err, "401 Unauthorized")
+
+	keychain, err := NewPullSecretKeychain(pullSecrets(host, "user", "secret"))
+	assert.NilError(t, err)
+	resolved, err := NewResolver(keychain).Resolve(context.Background(), host+"/foo/bar:v1")
+	assert.NilError(t, err)
+	assert.Equal(t, resolved, host+"/foo/bar@"+digest)
+}
@@ -30,6 +30,12 @@ type Printer interface {
 	InputOutput
 }
 
+// NewPrinter returns a Printer writing to the given input and output, for
+// example a cobra.Command.
+func NewPrinter(io InputOutput) Printer {
+	return stdPrinter{io}
+}
+
 type stdPrinter struct {
 	InputOutput
 }
@@ -0,0 +1,235 @@
+/*
+ Copyright 2026 The Knative Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"go.uber.org/multierr"
+	"knative.dev/client/pkg/output"
+	"knative.dev/client/pkg/output/term"
+)
+
+// ItemStatus is the status of a single item of a StatusList.
+type ItemStatus int
+
+const (
+	// ItemPending is the status of an item which hasn't been started yet.
+	ItemPending ItemStatus = iota
+	// ItemInProgress is the status of an item which is being processed.
+	ItemInProgress
+	// ItemSucceeded is the status of an item which has been processed successfully.
+	ItemSucceeded
+	// ItemFailed is the status of an item which has failed.
+	ItemFailed
+)
+
+const (
+	succeededColor = lipgloss.Color("42")
+	failedColor    = lipgloss.Color("196")
+	pendingColor   = lipgloss.Color("#626262")
+)
+
+// StatusList displays the status of a number of items, which are processed in
+// parallel, with one line per item.
+type StatusList interface {
+	Runnable[StatusListControl]
+}
+
+// StatusListControl allows one to update the status of the items of a
+// StatusList. It is safe to be used from multiple goroutines.
+type StatusListControl interface {
+	UpdateStatus(item string, status ItemStatus, message string)
+}
+
+func (w *widgets) NewStatusList(items []string) StatusList {
+	return &BubbleStatusList{
+		InputOutput: output.PrinterFrom(w.ctx),
+		Items:       items,
+	}
+}
+
+type BubbleStatusList struct {
+	output.InputOutput
+	Items []string
+
+	entries  map[string]*statusEntry
+	spin     spinner.Model
+	done     bool
+	tea      *tea.Program
+	quitChan chan struct{}
+	teaErr   error
+}
+
+type statusEntry struct {
+	status  ItemStatus
+	message string
+	started time.Time
+	elapsed time.Duration
+}
+
+type statusChange struct {
+	item    string
+	status  ItemStatus
+	message string
+	at      time.Time
+}
+
+type statusListDone struct{}
+
+// With will start the status list and perform the long operation within the
+// provided fn. The status list will be shut down when the provided function
+// exits, leaving the final status of all items on the screen.
+func (b *BubbleStatusList) With(fn func(StatusListControl) error) error {
+	b.start()
+	err := func() error {
+		defer b.stop()
+		return fn(b)
+	}()
+	return multierr.Combine(err, b.teaErr)
+}
+
+func (b *BubbleStatusList) UpdateStatus(item string, status ItemStatus, message string) {
+	b.tea.Send(statusChange{item: item, status: status, message: message, at: time.Now()})
+}
+
+func (b *BubbleStatusList) Init() tea.Cmd {
+	return b.spin.Tick
+}
+
+func (b *BubbleStatusList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch event := msg.(type) {
+	case statusChange:
+		b.applyChange(event)
+		return b, nil
+	case statusListDone:
+		b.done = true
+		return b, tea.Quit
+	case tea.KeyMsg:
+		if event.Type == tea.KeyCtrlC {
+			return b, tea.Quit
+		}
+		return b, nil
+	default:
+		m, c := b.spin.Update(msg)
+		b.spin = m
+		return b, c
+	}
+}
+
+func (b *BubbleStatusList) applyChange(change statusChange) {
+	entry, ok := b.entries[change.item]
+	if !ok {
+		return
+	}
+	if change.status == ItemInProgress && entry.status == ItemPending {
+		entry.started = change.at
+	}
+	if change.status == ItemSucceeded || change.status == ItemFailed {
+		if !entry.started.IsZero() {
+			entry.elapsed = change.at.Sub(entry.started)
+		}
+	}
+	if change.message != "" || change.status != entry.status {
+		entry.message = change.message
+	}
+	entry.status = change.status
+}
+
+func (b *BubbleStatusList) View() string {
+	width := 0
+	for _, item := range b.Items {
+		if len(item) > width {
+			width = len(item)
+		}
+	}
+	lines := make([]string, 0, len(b.Items)+1)
+	for _, item := range b.Items {
+		lines = append(lines, b.line(item, width))
+	}
+	if !b.done {
+		lines = append(lines, helpStyle("Press Ctrl+C to cancel"))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func (b *BubbleStatusList) line(item string, width int) string {
+	entry := b.entries[item]
+	var icon, message string
+	switch entry.status {
+	case ItemPending:
+		icon = lipgloss.NewStyle().Foreground(pendingColor).Render("•")
+		message = helpStyle("pending")
+	case ItemInProgress:
+		icon = b.spin.View()
+		message = fmt.Sprintf("%s %s", formatElapsed(time.Since(entry.started)), entry.message)
+	case ItemSucceeded:
+		icon = lipgloss.NewStyle().Foreground(succeededColor).Render("✔")
+		message = fmt.Sprintf("%s %s", formatElapsed(entry.elapsed), entry.message)
+	case ItemFailed:
+		icon = lipgloss.NewStyle().Foreground(failedColor).Render("✘")
+		message = fmt.Sprintf("%s %s", formatElapsed(entry.elapsed), entry.message)
+	}
+	return strings.TrimRight(fmt.Sprintf("%s %-*s  %s", icon, width, item, message), " ")
+}
+
+func formatElapsed(d time.Duration) string {
+	return fmt.Sprintf("%6.1fs", d.Seconds())
+}
+
+func (b *BubbleStatusList) start() {
+	b.entries = make(map[string]*statusEntry, len(b.Items))
+	for _, item := range b.Items {
+		b.entries[item] = &statusEntry{}
+	}
+	b.done = false
+	b.spin = spinner.New(
+		spinner.WithSpinner(spinner.Dot),
+		spinner.WithStyle(spinnerStyle()),
+	)
+	b.tea = tea.NewProgram(b, ioProgramOptions(b.InputOutput)...)
+	b.quitChan = make(chan struct{})
+	go func() {
+		t := b.tea
+		if _, err := t.Run(); err != nil {
+			b.teaErr = err
+		}
+		close(b.quitChan)
+	}()
+}
+
+func (b *BubbleStatusList) stop() {
+	if b.tea == nil {
+		return
+	}
+
+	// Render the final status of all items before quitting
+	b.tea.Send(statusListDone{})
+	<-b.quitChan
+
+	if term.IsWriterTerminal(b.OutOrStdout()) && b.teaErr == nil {
+		b.teaErr = b.tea.ReleaseTerminal()
+	}
+
+	b.tea = nil
+	b.quitChan = nil
+}
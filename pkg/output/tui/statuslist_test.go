@@ -0,0 +1,75 @@
+/*
+ Copyright 2026 The Knative Authors
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package tui_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"knative.dev/client/pkg/context"
+	"knative.dev/client/pkg/output"
+	"knative.dev/client/pkg/output/tui"
+)
+
+// TestStatusList verifies that the StatusList widget accepts updates from
+// multiple goroutines and leaves the final status of all items on the screen.
+func TestStatusList(t *testing.T) {
+	t.Parallel()
+	ctx := context.TestContext(t)
+	prt := output.NewTestPrinter()
+	ctx = output.WithContext(ctx, prt)
+	w := tui.NewWidgets(ctx)
+	sl := w.NewStatusList([]string{"first", "second", "third"})
+
+	if sl == nil {
+		t.Fatal("want status list, got nil")
+	}
+	if err := sl.With(func(slc tui.StatusListControl) error {
+		var wg sync.WaitGroup
+		for _, item := range []string{"first", "second"} {
+			wg.Add(1)
+			go func(item string) {
+				defer wg.Done()
+				slc.UpdateStatus(item, tui.ItemInProgress, "")
+				time.Sleep(50 * time.Millisecond)
+				slc.UpdateStatus(item, tui.ItemInProgress, item+" in progress")
+				time.Sleep(50 * time.Millisecond)
+			}(item)
+		}
+		wg.Wait()
+		slc.UpdateStatus("first", tui.ItemSucceeded, "ready")
+		slc.UpdateStatus("second", tui.ItemFailed, "timeout")
+		return nil
+	}); err != nil {
+		t.Errorf("want nil, got %v", err)
+	}
+	got := prt.Outputs().Out.String()
+	expectedMsgs := []string{
+		"first in progress", "second in progress",
+		"✔", "first ", "ready",
+		"✘", "second", "timeout",
+		"third", "pending",
+	}
+	for _, expected := range expectedMsgs {
+		if !strings.Contains(got, expected) {
+			t.Errorf("Expected to contain %#v within:\n%#v",
+				expected, got)
+		}
+	}
+}
@@ -41,6 +41,11 @@ type Widgets interface {
 	// and stopped when the function returns. The progress bar can be updated
 	// with the Write method.
 	NewProgress(totalSize int, message Message) Progress
+	// NewStatusList returns a new status list showing one line per item. The
+	// status list will be started when the With method is called and stopped
+	// when the function returns. The status of the items can be updated with
+	// the UpdateStatus method.
+	NewStatusList(items []string) StatusList
 }
 
 func NewWidgets(ctx context.Context) Widgets {
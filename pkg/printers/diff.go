@@ -0,0 +1,91 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printers
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// ANSI escape sequences used for coloring diff output
+const (
+	colorReset = "\033[0m"
+	colorRed   = "\033[31m"
+	colorGreen = "\033[32m"
+	colorCyan  = "\033[36m"
+)
+
+// UnifiedDiff describes two texts which should be compared in a unified diff
+type UnifiedDiff struct {
+	// Content and label of the original text
+	From, FromLabel string
+	// Content and label of the changed text
+	To, ToLabel string
+	// Number of context lines to print around a change
+	Context int
+	// Whether to color added and removed lines
+	Color bool
+}
+
+// PrintUnifiedDiff writes the differences as unified diff to the given writer and
+// returns true if any differences have been found
+func PrintUnifiedDiff(out io.Writer, diff UnifiedDiff) (bool, error) {
+	text, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(diff.From),
+		B:        difflib.SplitLines(diff.To),
+		FromFile: diff.FromLabel,
+		ToFile:   diff.ToLabel,
+		Context:  diff.Context,
+	})
+	if err != nil {
+		return false, err
+	}
+	if text == "" {
+		return false, nil
+	}
+	for _, line := range strings.SplitAfter(text, "\n") {
+		if line == "" {
+			continue
+		}
+		if !strings.HasSuffix(line, "\n") {
+			line += "\n"
+		}
+		if diff.Color {
+			line = colorizeDiffLine(line)
+		}
+		if _, err := fmt.Fprint(out, line); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+func colorizeDiffLine(line string) string {
+	content := strings.TrimSuffix(line, "\n")
+	switch {
+	case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+		return line
+	case strings.HasPrefix(line, "@@"):
+		return colorCyan + content + colorReset + "\n"
+	case strings.HasPrefix(line, "+"):
+		return colorGreen + content + colorReset + "\n"
+	case strings.HasPrefix(line, "-"):
+		return colorRed + content + colorReset + "\n"
+	}
+	return line
+}
@@ -0,0 +1,60 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printers
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestPrintUnifiedDiff(t *testing.T) {
+	buf := &bytes.Buffer{}
+	changed, err := PrintUnifiedDiff(buf, UnifiedDiff{
+		From:      "a: 1\nb: 2\nc: 3\n",
+		FromLabel: "live",
+		To:        "a: 1\nb: 4\nc: 3\n",
+		ToLabel:   "merged",
+		Context:   1,
+	})
+	assert.NilError(t, err)
+	assert.Assert(t, changed)
+	expected := "--- live\n+++ merged\n@@ -1,3 +1,3 @@\n a: 1\n-b: 2\n+b: 4\n c: 3\n"
+	assert.Equal(t, buf.String(), expected)
+}
+
+func TestPrintUnifiedDiffColored(t *testing.T) {
+	buf := &bytes.Buffer{}
+	changed, err := PrintUnifiedDiff(buf, UnifiedDiff{
+		From:  "a: 1\n",
+		To:    "a: 2\n",
+		Color: true,
+	})
+	assert.NilError(t, err)
+	assert.Assert(t, changed)
+	expected := colorCyan + "@@ -1 +1 @@" + colorReset + "\n" +
+		colorRed + "-a: 1" + colorReset + "\n" +
+		colorGreen + "+a: 2" + colorReset + "\n"
+	assert.Equal(t, buf.String(), expected)
+}
+
+func TestPrintUnifiedDiffNoChanges(t *testing.T) {
+	buf := &bytes.Buffer{}
+	changed, err := PrintUnifiedDiff(buf, UnifiedDiff{From: "a: 1\n", To: "a: 1\n"})
+	assert.NilError(t, err)
+	assert.Assert(t, !changed)
+	assert.Equal(t, buf.String(), "")
+}
@@ -0,0 +1,32 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printers
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// RawObject wraps a value which isn't a Kubernetes resource, like a traffic plan, so that it can
+// be printed with the printers of the generic print flags. The value is printed as it is, without
+// an apiVersion and kind.
+func RawObject(value interface{}) (runtime.Object, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.Unknown{Raw: raw, ContentType: runtime.ContentTypeJSON}, nil
+}
@@ -0,0 +1,52 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printers
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+func TestRawObject(t *testing.T) {
+	value := struct {
+		Name  string   `json:"name"`
+		Items []string `json:"items"`
+	}{Name: "foo", Items: []string{"a", "b"}}
+	obj, err := RawObject(value)
+	assert.NilError(t, err)
+
+	for _, tc := range []struct {
+		format   string
+		expected string
+	}{
+		{"yaml", "items:\n- a\n- b\nname: foo\n"},
+		{"json", "{\n    \"name\": \"foo\",\n    \"items\": [\n        \"a\",\n        \"b\"\n    ]\n}\n"},
+		{"jsonpath={.items[1]}", "b"},
+	} {
+		printFlags := genericclioptions.NewPrintFlags("")
+		printFlags.OutputFormat = &tc.format
+		printer, err := printFlags.ToPrinter()
+		assert.NilError(t, err)
+		out := &bytes.Buffer{}
+		assert.NilError(t, printer.PrintObj(obj, out))
+		assert.Equal(t, out.String(), tc.expected)
+	}
+
+	_, err = RawObject(func() {})
+	assert.ErrorContains(t, err, "unsupported type")
+}
@@ -22,7 +22,6 @@ import (
 	"strings"
 	"time"
 
-	"knative.dev/client/pkg/flags"
 	"knative.dev/pkg/ptr"
 	"knative.dev/serving/pkg/apis/autoscaling"
 	servingconfig "knative.dev/serving/pkg/apis/config"
@@ -42,7 +41,16 @@ const (
 var (
 	UserImageAnnotationKey       = "client.knative.dev/user-image"
 	UpdateTimestampAnnotationKey = "client.knative.dev/updateTimestamp"
-	APITooOldError               = errors.New("the service is using too old of an API format for the operation")
+	// RevisionRetentionAnnotationKey holds the retention policy of a service for pruning its revisions
+	RevisionRetentionAnnotationKey = "client.knative.dev/revision-retention"
+	APITooOldError                 = errors.New("the service is using too old of an API format for the operation")
+)
+
+const (
+	// RevisionTrafficAnnotationKey holds the traffic percentage of a revision when listing revisions
+	RevisionTrafficAnnotationKey = "client.knative.dev/traffic"
+	// RevisionTagsAnnotationKey holds the traffic tags of a revision when listing revisions
+	RevisionTagsAnnotationKey = "client.knative.dev/tags"
 )
 
 func (vt VolumeSourceType) String() string {
@@ -150,7 +158,8 @@ func PinImageToDigest(currentRevisionTemplate *servingv1.RevisionTemplateSpec, b
 
 	containerStatus := ContainerStatus(baseRevision)
 	if containerStatus != nil && containerStatus.ImageDigest != "" {
-		return flags.UpdateImage(&currentRevisionTemplate.Spec.PodSpec, containerStatus.ImageDigest)
+		// The main container isn't necessarily the first one
+		ContainerOfRevisionSpec(&currentRevisionTemplate.Spec).Image = containerStatus.ImageDigest
 	}
 	return nil
 }
@@ -0,0 +1,147 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"sort"
+	"strings"
+
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// DisableAnnotationKey is the service annotation holding a comma separated list of
+// rules which are not checked for this service
+const DisableAnnotationKey = "client.knative.dev/lint-disable"
+
+// Severity is the severity of a finding
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// Options are settings which rules need to know about the target cluster
+type Options struct {
+	// MaxTimeoutSeconds is the maximum request timeout allowed for a revision
+	// ('max-revision-timeout-seconds' in the 'config-defaults' ConfigMap)
+	MaxTimeoutSeconds int64
+}
+
+// DefaultOptions correspond to the defaults of Knative Serving
+var DefaultOptions = Options{
+	MaxTimeoutSeconds: 600,
+}
+
+// Rule checks a single aspect of a service
+type Rule struct {
+	// ID identifies the rule, e.g. for disabling it
+	ID string
+	// Description explains what the rule checks for
+	Description string
+	// Severity of the findings of this rule
+	Severity Severity
+	// Check returns a message for every problem found in the service
+	Check func(service *servingv1.Service, options Options) []string
+}
+
+// Finding is a problem found by a rule
+type Finding struct {
+	Service  string   `json:"service"`
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+}
+
+// Linter checks services against a set of rules
+type Linter struct {
+	rules    []Rule
+	options  Options
+	disabled map[string]bool
+}
+
+// NewLinter creates a linter with the given options and the default rules, except
+// for the disabled ones
+func NewLinter(options Options, disabled ...string) *Linter {
+	linter := &Linter{options: options, disabled: map[string]bool{}}
+	for _, id := range disabled {
+		linter.disabled[strings.TrimSpace(id)] = true
+	}
+	for _, rule := range DefaultRules() {
+		linter.AddRule(rule)
+	}
+	return linter
+}
+
+// AddRule adds a rule to the linter. A rule with the same ID is replaced.
+func (l *Linter) AddRule(rule Rule) {
+	for i := range l.rules {
+		if l.rules[i].ID == rule.ID {
+			l.rules[i] = rule
+			return
+		}
+	}
+	l.rules = append(l.rules, rule)
+}
+
+// Rules returns the rules of the linter which are not disabled globally, sorted by ID
+func (l *Linter) Rules() []Rule {
+	var rules []Rule
+	for _, rule := range l.rules {
+		if !l.disabled[rule.ID] {
+			rules = append(rules, rule)
+		}
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].ID < rules[j].ID
+	})
+	return rules
+}
+
+// Lint checks the service against all rules which are not disabled, either globally
+// or for this service by the DisableAnnotationKey annotation
+func (l *Linter) Lint(service *servingv1.Service) []Finding {
+	disabledForService := map[string]bool{}
+	for _, id := range strings.Split(service.Annotations[DisableAnnotationKey], ",") {
+		disabledForService[strings.TrimSpace(id)] = true
+	}
+	var findings []Finding
+	for _, rule := range l.Rules() {
+		if disabledForService[rule.ID] {
+			continue
+		}
+		for _, message := range rule.Check(service, l.options) {
+			findings = append(findings, Finding{
+				Service:  service.Name,
+				Rule:     rule.ID,
+				Severity: rule.Severity,
+				Message:  message,
+			})
+		}
+	}
+	return findings
+}
+
+// CountErrors returns the number of findings with error severity
+func CountErrors(findings []Finding) int {
+	count := 0
+	for _, finding := range findings {
+		if finding.Severity == SeverityError {
+			count++
+		}
+	}
+	return count
+}
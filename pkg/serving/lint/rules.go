@@ -0,0 +1,228 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	clientserving "knative.dev/client/pkg/serving"
+)
+
+// IDs of the default rules
+const (
+	RuleLatestImage       = "latest-image"
+	RuleReadinessProbe    = "readiness-probe"
+	RuleResourceRequests  = "resource-requests"
+	RuleConcurrencyTarget = "concurrency-target"
+	RuleTimeout           = "timeout"
+	RuleScaleBounds       = "scale-bounds"
+	RuleSecretEnv         = "secret-env"
+)
+
+var (
+	secretNamePattern = regexp.MustCompile(`(?i)(passw(or)?d|secret|token|api[-_]?key|private[-_]?key|access[-_]?key|credentials?)`)
+
+	secretValuePatterns = []*regexp.Regexp{
+		// AWS access key ID
+		regexp.MustCompile(`^(AKIA|ASIA)[0-9A-Z]{16}$`),
+		// GitHub tokens
+		regexp.MustCompile(`^gh[pousr]_[A-Za-z0-9]{36,}$`),
+		// Slack tokens
+		regexp.MustCompile(`^xox[abprs]-[A-Za-z0-9-]+$`),
+		// Stripe live keys
+		regexp.MustCompile(`^[rs]k_live_[A-Za-z0-9]+$`),
+		// PEM encoded private keys
+		regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`),
+	}
+)
+
+// DefaultRules returns the rules which are checked by default
+func DefaultRules() []Rule {
+	return []Rule{
+		{
+			ID:          RuleLatestImage,
+			Description: "Images should be pinned to a digest or a tag other than 'latest'",
+			Severity:    SeverityWarning,
+			Check:       checkLatestImage,
+		},
+		{
+			ID:          RuleReadinessProbe,
+			Description: "The serving container should have a readiness probe",
+			Severity:    SeverityWarning,
+			Check:       checkReadinessProbe,
+		},
+		{
+			ID:          RuleResourceRequests,
+			Description: "Containers should request CPU and memory",
+			Severity:    SeverityWarning,
+			Check:       checkResourceRequests,
+		},
+		{
+			ID:          RuleConcurrencyTarget,
+			Description: "The scale target should not exceed the concurrency limit",
+			Severity:    SeverityWarning,
+			Check:       checkConcurrencyTarget,
+		},
+		{
+			ID:          RuleTimeout,
+			Description: "The request timeout must not exceed the maximum timeout of the cluster",
+			Severity:    SeverityError,
+			Check:       checkTimeout,
+		},
+		{
+			ID:          RuleScaleBounds,
+			Description: "The minimum scale must not exceed the maximum scale",
+			Severity:    SeverityError,
+			Check:       checkScaleBounds,
+		},
+		{
+			ID:          RuleSecretEnv,
+			Description: "Secrets should be referenced from a Secret instead of being set as literal environment values",
+			Severity:    SeverityWarning,
+			Check:       checkSecretEnv,
+		},
+	}
+}
+
+func checkLatestImage(service *servingv1.Service, _ Options) []string {
+	var messages []string
+	for _, container := range service.Spec.Template.Spec.Containers {
+		if strings.Contains(container.Image, "@") {
+			continue
+		}
+		if tag := imageTag(container.Image); tag == "" || tag == "latest" {
+			messages = append(messages, fmt.Sprintf("%s uses image '%s' which is neither pinned to a digest nor to a version tag",
+				containerDesc(container), container.Image))
+		}
+	}
+	return messages
+}
+
+func checkReadinessProbe(service *servingv1.Service, _ Options) []string {
+	spec := &service.Spec.Template.Spec
+	idx := clientserving.ContainerIndexOfRevisionSpec(spec)
+	if idx == -1 || spec.Containers[idx].ReadinessProbe != nil {
+		return nil
+	}
+	return []string{fmt.Sprintf("%s has no readiness probe, it receives traffic as soon as its port is open", containerDesc(spec.Containers[idx]))}
+}
+
+func checkResourceRequests(service *servingv1.Service, _ Options) []string {
+	var messages []string
+	for _, container := range service.Spec.Template.Spec.Containers {
+		var missing []string
+		for _, resource := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			if _, ok := container.Resources.Requests[resource]; !ok {
+				missing = append(missing, string(resource))
+			}
+		}
+		if len(missing) > 0 {
+			messages = append(messages, fmt.Sprintf("%s doesn't request %s", containerDesc(container), strings.Join(missing, " and ")))
+		}
+	}
+	return messages
+}
+
+func checkConcurrencyTarget(service *servingv1.Service, _ Options) []string {
+	limit := service.Spec.Template.Spec.ContainerConcurrency
+	if limit == nil || *limit == 0 {
+		return nil
+	}
+	annotations := service.Spec.Template.Annotations
+	if metric := autoscaling.MetricAnnotation.Value(annotations); metric != "" && metric != autoscaling.Concurrency {
+		return nil
+	}
+	value := autoscaling.TargetAnnotation.Value(annotations)
+	if value == "" {
+		return nil
+	}
+	target, err := strconv.ParseFloat(value, 64)
+	if err != nil || target <= float64(*limit) {
+		return nil
+	}
+	return []string{fmt.Sprintf("scale target %s exceeds the concurrency limit %d, so that the target is never reached", value, *limit)}
+}
+
+func checkTimeout(service *servingv1.Service, options Options) []string {
+	timeout := service.Spec.Template.Spec.TimeoutSeconds
+	if timeout == nil || options.MaxTimeoutSeconds <= 0 || *timeout <= options.MaxTimeoutSeconds {
+		return nil
+	}
+	return []string{fmt.Sprintf("timeout of %d seconds exceeds the maximum of %d seconds", *timeout, options.MaxTimeoutSeconds)}
+}
+
+func checkScaleBounds(service *servingv1.Service, _ Options) []string {
+	annotations := service.Spec.Template.Annotations
+	minScale, err := strconv.Atoi(autoscaling.MinScaleAnnotation.Value(annotations))
+	if err != nil {
+		return nil
+	}
+	maxScale, err := strconv.Atoi(autoscaling.MaxScaleAnnotation.Value(annotations))
+	// A maximum scale of 0 means unlimited
+	if err != nil || maxScale == 0 || minScale <= maxScale {
+		return nil
+	}
+	return []string{fmt.Sprintf("minimum scale %d exceeds the maximum scale %d", minScale, maxScale)}
+}
+
+func checkSecretEnv(service *servingv1.Service, _ Options) []string {
+	var messages []string
+	for _, container := range service.Spec.Template.Spec.Containers {
+		for _, env := range container.Env {
+			if env.ValueFrom != nil || env.Value == "" {
+				continue
+			}
+			if secretNamePattern.MatchString(env.Name) || looksLikeSecret(env.Value) {
+				messages = append(messages, fmt.Sprintf("%s sets environment variable '%s' to a literal value which looks like a secret",
+					containerDesc(container), env.Name))
+			}
+		}
+	}
+	return messages
+}
+
+func looksLikeSecret(value string) bool {
+	for _, pattern := range secretValuePatterns {
+		if pattern.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// imageTag returns the tag of an image reference without digest, or an empty string
+// if the image has no tag
+func imageTag(image string) string {
+	idx := strings.LastIndex(image, ":")
+	if idx == -1 || strings.Contains(image[idx+1:], "/") {
+		// No tag, but a registry with port
+		return ""
+	}
+	return image[idx+1:]
+}
+
+func containerDesc(container corev1.Container) string {
+	if container.Name == "" {
+		return "container"
+	}
+	return fmt.Sprintf("container '%s'", container.Name)
+}
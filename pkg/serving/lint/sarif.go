@@ -0,0 +1,135 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+// Minimal subset of the Static Analysis Results Interchange Format (SARIF) 2.1.0,
+// as understood by code scanning tools
+// See https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+// SARIFLog is the top level object of a SARIF file
+type SARIFLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []SARIFRun `json:"runs"`
+}
+
+type SARIFRun struct {
+	Tool    SARIFTool     `json:"tool"`
+	Results []SARIFResult `json:"results"`
+}
+
+type SARIFTool struct {
+	Driver SARIFDriver `json:"driver"`
+}
+
+type SARIFDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []SARIFRule `json:"rules"`
+}
+
+type SARIFRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     SARIFMessage       `json:"shortDescription"`
+	DefaultConfiguration SARIFConfiguration `json:"defaultConfiguration"`
+}
+
+type SARIFConfiguration struct {
+	Level string `json:"level"`
+}
+
+type SARIFMessage struct {
+	Text string `json:"text"`
+}
+
+type SARIFResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   SARIFMessage    `json:"message"`
+	Locations []SARIFLocation `json:"locations,omitempty"`
+}
+
+type SARIFLocation struct {
+	PhysicalLocation *SARIFPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []SARIFLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type SARIFPhysicalLocation struct {
+	ArtifactLocation SARIFArtifactLocation `json:"artifactLocation"`
+}
+
+type SARIFArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type SARIFLogicalLocation struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+}
+
+// ToSARIF converts findings to a SARIF log with a single run. The findings are located
+// in the given file, if not empty, and in the service they have been found in.
+func ToSARIF(findings []Finding, rules []Rule, file string) SARIFLog {
+	driver := SARIFDriver{
+		Name:           "kn service lint",
+		InformationURI: "https://github.com/knative/client",
+		Rules:          []SARIFRule{},
+	}
+	ruleIndex := map[string]int{}
+	for i, rule := range rules {
+		ruleIndex[rule.ID] = i
+		driver.Rules = append(driver.Rules, SARIFRule{
+			ID:                   rule.ID,
+			ShortDescription:     SARIFMessage{Text: rule.Description},
+			DefaultConfiguration: SARIFConfiguration{Level: sarifLevel(rule.Severity)},
+		})
+	}
+
+	results := []SARIFResult{}
+	for _, finding := range findings {
+		location := SARIFLocation{
+			LogicalLocations: []SARIFLogicalLocation{{Name: finding.Service, Kind: "service"}},
+		}
+		if file != "" {
+			location.PhysicalLocation = &SARIFPhysicalLocation{ArtifactLocation: SARIFArtifactLocation{URI: file}}
+		}
+		results = append(results, SARIFResult{
+			RuleID:    finding.Rule,
+			RuleIndex: ruleIndex[finding.Rule],
+			Level:     sarifLevel(finding.Severity),
+			Message:   SARIFMessage{Text: finding.Message},
+			Locations: []SARIFLocation{location},
+		})
+	}
+	return SARIFLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []SARIFRun{{Tool: SARIFTool{Driver: driver}, Results: results}},
+	}
+}
+
+// sarifLevel maps a severity to a SARIF level, which are 'error', 'warning' and 'note'
+func sarifLevel(severity Severity) string {
+	if severity == SeverityInfo {
+		return "note"
+	}
+	return string(severity)
+}
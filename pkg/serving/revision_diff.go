@@ -0,0 +1,326 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// Kinds of changes between two revisions
+const (
+	RevisionChangeAdded   = "added"
+	RevisionChangeRemoved = "removed"
+	RevisionChangeChanged = "changed"
+)
+
+// ignoredRevisionAnnotations are annotations which are set by the system or by kn and
+// differ between all revisions
+var ignoredRevisionAnnotations = []string{
+	serving.CreatorAnnotation,
+	serving.UpdaterAnnotation,
+	serving.RoutesAnnotationKey,
+	serving.RoutingStateModifiedAnnotationKey,
+	"serving.knative.dev/lastPinned",
+	UpdateTimestampAnnotationKey,
+	UserImageAnnotationKey,
+}
+
+// RevisionChange is a single difference between two revisions
+type RevisionChange struct {
+	Section string `json:"section"`
+	Field   string `json:"field"`
+	Change  string `json:"change"`
+	From    string `json:"from,omitempty"`
+	To      string `json:"to,omitempty"`
+}
+
+// revisionDiffer collects the changes between two revisions
+type revisionDiffer struct {
+	changes []RevisionChange
+}
+
+// DiffRevisionTemplates computes the field-wise differences between two revisions, given as
+// revision templates. The changes are ordered by section and field.
+func DiffRevisionTemplates(from, to *servingv1.RevisionTemplateSpec) []RevisionChange {
+	d := &revisionDiffer{}
+	d.compareContainers("Container", from.Spec.Containers, to.Spec.Containers)
+	d.compareContainers("Init container", from.Spec.InitContainers, to.Spec.InitContainers)
+	d.compareMaps("Volumes", "", volumesOf(&from.Spec), volumesOf(&to.Spec))
+
+	fromAutoscaling, fromAnnotations := splitAnnotations(from.Annotations)
+	toAutoscaling, toAnnotations := splitAnnotations(to.Annotations)
+	d.compare("Autoscaling", "containerConcurrency", int64PtrToString(from.Spec.ContainerConcurrency), int64PtrToString(to.Spec.ContainerConcurrency))
+	d.compareMaps("Autoscaling", "", fromAutoscaling, toAutoscaling)
+
+	d.compare("Revision", "serviceAccountName", from.Spec.ServiceAccountName, to.Spec.ServiceAccountName)
+	d.compare("Revision", "timeoutSeconds", int64PtrToString(from.Spec.TimeoutSeconds), int64PtrToString(to.Spec.TimeoutSeconds))
+	d.compare("Revision", "imagePullSecrets", pullSecretsOf(&from.Spec), pullSecretsOf(&to.Spec))
+	d.compareMaps("Annotations", "", fromAnnotations, toAnnotations)
+	return d.changes
+}
+
+// RevisionTemplateOf returns the template from which the given revision has been created
+func RevisionTemplateOf(revision *servingv1.Revision) *servingv1.RevisionTemplateSpec {
+	return &servingv1.RevisionTemplateSpec{ObjectMeta: revision.ObjectMeta, Spec: revision.Spec}
+}
+
+// compare records a change if the given values differ. An empty value means that the field isn't set.
+func (d *revisionDiffer) compare(section, field, from, to string) {
+	change := RevisionChange{Section: section, Field: field, From: from, To: to}
+	switch {
+	case from == to:
+		return
+	case from == "":
+		change.Change = RevisionChangeAdded
+	case to == "":
+		change.Change = RevisionChangeRemoved
+	default:
+		change.Change = RevisionChangeChanged
+	}
+	d.changes = append(d.changes, change)
+}
+
+// compareMaps compares the values of all keys of the given maps, in the order of the keys
+func (d *revisionDiffer) compareMaps(section, prefix string, from, to map[string]string) {
+	keys := make([]string, 0, len(from)+len(to))
+	for key := range from {
+		keys = append(keys, key)
+	}
+	for key := range to {
+		if _, ok := from[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		d.compare(section, prefix+key, from[key], to[key])
+	}
+}
+
+// compareContainers compares the containers of the given kind ('Container' or 'Init container')
+// with the same name. If both revisions have a single container of this kind, they are compared
+// independent of their names.
+func (d *revisionDiffer) compareContainers(kind string, from, to []corev1.Container) {
+	if len(from) == 1 && len(to) == 1 {
+		d.compareContainer(kind, &from[0], &to[0])
+		return
+	}
+	section := kind + "s"
+	for i := range from {
+		toContainer := findContainer(to, from[i].Name)
+		if toContainer == nil {
+			d.compare(section, from[i].Name, from[i].Image, "")
+			continue
+		}
+		d.compareContainer(kind, &from[i], toContainer)
+	}
+	for i := range to {
+		if findContainer(from, to[i].Name) == nil {
+			d.compare(section, to[i].Name, "", to[i].Image)
+		}
+	}
+}
+
+func (d *revisionDiffer) compareContainer(kind string, from, to *corev1.Container) {
+	section := fmt.Sprintf("%s '%s'", kind, to.Name)
+	if to.Name == "" {
+		section = kind
+	}
+	d.compare(section, "image", from.Image, to.Image)
+	d.compare(section, "imagePullPolicy", string(from.ImagePullPolicy), string(to.ImagePullPolicy))
+	d.compare(section, "command", strings.Join(from.Command, " "), strings.Join(to.Command, " "))
+	d.compare(section, "args", strings.Join(from.Args, " "), strings.Join(to.Args, " "))
+	d.compare(section, "ports", portsOf(from), portsOf(to))
+	d.compareMaps(section, "env ", envOf(from), envOf(to))
+	d.compare(section, "envFrom", envFromOf(from), envFromOf(to))
+	d.compareMaps(section, "mount ", mountsOf(from), mountsOf(to))
+	d.compareMaps(section, "", resourcesOf(from), resourcesOf(to))
+	d.compare(section, "readinessProbe", toJSON(from.ReadinessProbe), toJSON(to.ReadinessProbe))
+	d.compare(section, "livenessProbe", toJSON(from.LivenessProbe), toJSON(to.LivenessProbe))
+	d.compare(section, "startupProbe", toJSON(from.StartupProbe), toJSON(to.StartupProbe))
+	d.compare(section, "securityContext", toJSON(from.SecurityContext), toJSON(to.SecurityContext))
+}
+
+// ===============================================================
+// Helper for stringifying fields of revisions
+
+func findContainer(containers []corev1.Container, name string) *corev1.Container {
+	for i := range containers {
+		if containers[i].Name == name {
+			return &containers[i]
+		}
+	}
+	return nil
+}
+
+// splitAnnotations returns the autoscaling annotations and all other annotations,
+// without those set by the system
+func splitAnnotations(annotations map[string]string) (map[string]string, map[string]string) {
+	autoscalingAnnotations := map[string]string{}
+	otherAnnotations := map[string]string{}
+	for key, value := range annotations {
+		switch {
+		case strings.HasPrefix(key, autoscaling.GroupName+"/"):
+			autoscalingAnnotations[key] = value
+		case !isIgnoredAnnotation(key):
+			otherAnnotations[key] = value
+		}
+	}
+	return autoscalingAnnotations, otherAnnotations
+}
+
+func isIgnoredAnnotation(key string) bool {
+	for _, ignored := range ignoredRevisionAnnotations {
+		if key == ignored {
+			return true
+		}
+	}
+	return false
+}
+
+func envOf(container *corev1.Container) map[string]string {
+	env := make(map[string]string, len(container.Env))
+	for _, envVar := range container.Env {
+		value := envVar.Value
+		if source := envVar.ValueFrom; source != nil {
+			switch {
+			case source.ConfigMapKeyRef != nil:
+				value = fmt.Sprintf("config-map:%s:%s", source.ConfigMapKeyRef.Name, source.ConfigMapKeyRef.Key)
+			case source.SecretKeyRef != nil:
+				value = fmt.Sprintf("secret:%s:%s", source.SecretKeyRef.Name, source.SecretKeyRef.Key)
+			case source.FieldRef != nil:
+				value = "field:" + source.FieldRef.FieldPath
+			case source.ResourceFieldRef != nil:
+				value = "resource:" + source.ResourceFieldRef.Resource
+			default:
+				value = toJSON(source)
+			}
+		}
+		// Keep variables with an empty value distinguishable from missing ones
+		if value == "" {
+			value = `""`
+		}
+		env[envVar.Name] = value
+	}
+	return env
+}
+
+func envFromOf(container *corev1.Container) string {
+	var sources []string
+	for _, source := range container.EnvFrom {
+		if source.ConfigMapRef != nil {
+			sources = append(sources, "config-map:"+source.ConfigMapRef.Name)
+		}
+		if source.SecretRef != nil {
+			sources = append(sources, "secret:"+source.SecretRef.Name)
+		}
+	}
+	return strings.Join(sources, ", ")
+}
+
+func mountsOf(container *corev1.Container) map[string]string {
+	mounts := make(map[string]string, len(container.VolumeMounts))
+	for _, mount := range container.VolumeMounts {
+		value := mount.Name
+		if mount.SubPath != "" {
+			value += "/" + mount.SubPath
+		}
+		if mount.ReadOnly {
+			value += " (read-only)"
+		}
+		mounts[mount.MountPath] = value
+	}
+	return mounts
+}
+
+func resourcesOf(container *corev1.Container) map[string]string {
+	resources := map[string]string{}
+	for name, quantity := range container.Resources.Requests {
+		resources["requests."+string(name)] = quantity.String()
+	}
+	for name, quantity := range container.Resources.Limits {
+		resources["limits."+string(name)] = quantity.String()
+	}
+	return resources
+}
+
+func portsOf(container *corev1.Container) string {
+	ports := make([]string, 0, len(container.Ports))
+	for _, port := range container.Ports {
+		value := strconv.Itoa(int(port.ContainerPort))
+		if port.Name != "" {
+			value = port.Name + ":" + value
+		}
+		ports = append(ports, value)
+	}
+	return strings.Join(ports, ", ")
+}
+
+func volumesOf(spec *servingv1.RevisionSpec) map[string]string {
+	volumes := make(map[string]string, len(spec.Volumes))
+	for _, volume := range spec.Volumes {
+		var value string
+		switch {
+		case volume.ConfigMap != nil:
+			value = "config-map:" + volume.ConfigMap.Name
+		case volume.Secret != nil:
+			value = "secret:" + volume.Secret.SecretName
+		case volume.PersistentVolumeClaim != nil:
+			value = "pvc:" + volume.PersistentVolumeClaim.ClaimName
+		case volume.EmptyDir != nil:
+			value = "emptyDir"
+			if volume.EmptyDir.SizeLimit != nil {
+				value += ":" + volume.EmptyDir.SizeLimit.String()
+			}
+		default:
+			value = toJSON(volume.VolumeSource)
+		}
+		volumes[volume.Name] = value
+	}
+	return volumes
+}
+
+func pullSecretsOf(spec *servingv1.RevisionSpec) string {
+	secrets := make([]string, 0, len(spec.ImagePullSecrets))
+	for _, secret := range spec.ImagePullSecrets {
+		secrets = append(secrets, secret.Name)
+	}
+	return strings.Join(secrets, ", ")
+}
+
+func int64PtrToString(value *int64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatInt(*value, 10)
+}
+
+// toJSON returns the compact JSON representation of a value or an empty string if it is nil
+func toJSON(value interface{}) string {
+	b, err := json.Marshal(value)
+	if err != nil || string(b) == "null" {
+		return ""
+	}
+	return string(b)
+}
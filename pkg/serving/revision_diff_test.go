@@ -0,0 +1,123 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/serving/pkg/apis/serving"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestDiffRevisionTemplates(t *testing.T) {
+	from := &servingv1.RevisionTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			serving.CreatorAnnotation: "alice",
+			UserImageAnnotationKey:    "gcr.io/foo/bar:v1",
+		}},
+	}
+	from.Spec.Containers = []corev1.Container{{
+		Image: "gcr.io/foo/bar:v1",
+		Env:   []corev1.EnvVar{{Name: "EMPTY"}},
+	}}
+	to := from.DeepCopy()
+	to.Annotations[serving.CreatorAnnotation] = "bob"
+	to.Annotations[UserImageAnnotationKey] = "gcr.io/foo/bar:v2"
+	to.Annotations["autoscaling.knative.dev/max-scale"] = "5"
+	container := &to.Spec.Containers[0]
+	container.Image = "gcr.io/foo/bar:v2"
+	container.Env = []corev1.EnvVar{
+		{Name: "CONFIG", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "app"}, Key: "level"}}},
+		{Name: "POD", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+	}
+	container.EnvFrom = []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "creds"}}}}
+	to.Spec.Volumes = []corev1.Volume{{Name: "config", VolumeSource: corev1.VolumeSource{
+		ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "app"}}}}}
+
+	assert.DeepEqual(t, DiffRevisionTemplates(from, to), []RevisionChange{
+		{Section: "Container", Field: "image", Change: RevisionChangeChanged, From: "gcr.io/foo/bar:v1", To: "gcr.io/foo/bar:v2"},
+		{Section: "Container", Field: "env CONFIG", Change: RevisionChangeAdded, To: "config-map:app:level"},
+		{Section: "Container", Field: "env EMPTY", Change: RevisionChangeRemoved, From: `""`},
+		{Section: "Container", Field: "env POD", Change: RevisionChangeAdded, To: "field:metadata.name"},
+		{Section: "Container", Field: "envFrom", Change: RevisionChangeAdded, To: "secret:creds"},
+		{Section: "Volumes", Field: "config", Change: RevisionChangeAdded, To: "config-map:app"},
+		{Section: "Autoscaling", Field: "autoscaling.knative.dev/max-scale", Change: RevisionChangeAdded, To: "5"},
+	})
+	assert.Assert(t, DiffRevisionTemplates(from, from) == nil)
+}
+
+func TestDiffRevisionTemplatesMultipleContainers(t *testing.T) {
+	from := &servingv1.RevisionTemplateSpec{}
+	from.Spec.Containers = []corev1.Container{
+		{Name: "user", Image: "gcr.io/foo/bar:v1"},
+		{Name: "sidecar", Image: "gcr.io/foo/sidecar"},
+	}
+	to := &servingv1.RevisionTemplateSpec{}
+	to.Spec.Containers = []corev1.Container{
+		{Name: "user", Image: "gcr.io/foo/bar:v2"},
+		{Name: "proxy", Image: "gcr.io/foo/proxy"},
+	}
+
+	assert.DeepEqual(t, DiffRevisionTemplates(from, to), []RevisionChange{
+		{Section: "Container 'user'", Field: "image", Change: RevisionChangeChanged, From: "gcr.io/foo/bar:v1", To: "gcr.io/foo/bar:v2"},
+		{Section: "Containers", Field: "sidecar", Change: RevisionChangeRemoved, From: "gcr.io/foo/sidecar"},
+		{Section: "Containers", Field: "proxy", Change: RevisionChangeAdded, To: "gcr.io/foo/proxy"},
+	})
+}
+
+func TestDiffRevisionTemplatesInitContainers(t *testing.T) {
+	from := &servingv1.RevisionTemplateSpec{}
+	from.Spec.Containers = []corev1.Container{{Image: "gcr.io/foo/bar"}}
+	from.Spec.InitContainers = []corev1.Container{
+		{Name: "migrate", Image: "gcr.io/foo/migrate:v1"},
+		{Name: "warmup", Image: "gcr.io/foo/warmup"},
+	}
+	to := from.DeepCopy()
+	to.Spec.InitContainers = []corev1.Container{
+		{Name: "migrate", Image: "gcr.io/foo/migrate:v2"},
+		{Name: "fetch", Image: "gcr.io/foo/fetch"},
+	}
+
+	assert.DeepEqual(t, DiffRevisionTemplates(from, to), []RevisionChange{
+		{Section: "Init container 'migrate'", Field: "image", Change: RevisionChangeChanged, From: "gcr.io/foo/migrate:v1", To: "gcr.io/foo/migrate:v2"},
+		{Section: "Init containers", Field: "warmup", Change: RevisionChangeRemoved, From: "gcr.io/foo/warmup"},
+		{Section: "Init containers", Field: "fetch", Change: RevisionChangeAdded, To: "gcr.io/foo/fetch"},
+	})
+}
+
+func TestDiffRevisionTemplatesProbes(t *testing.T) {
+	from := &servingv1.RevisionTemplateSpec{}
+	from.Spec.Containers = []corev1.Container{{
+		Image: "gcr.io/foo/bar",
+		StartupProbe: &corev1.Probe{
+			ProbeHandler:     corev1.ProbeHandler{TCPSocket: &corev1.TCPSocketAction{}},
+			FailureThreshold: 10,
+		},
+	}}
+	to := from.DeepCopy()
+	to.Spec.Containers[0].StartupProbe.FailureThreshold = 30
+	to.Spec.Containers[0].ReadinessProbe = &corev1.Probe{PeriodSeconds: 5}
+
+	assert.DeepEqual(t, DiffRevisionTemplates(from, to), []RevisionChange{
+		{Section: "Container", Field: "readinessProbe", Change: RevisionChangeAdded, To: `{"periodSeconds":5}`},
+		{Section: "Container", Field: "startupProbe", Change: RevisionChangeChanged,
+			From: `{"tcpSocket":{"port":0},"failureThreshold":10}`, To: `{"tcpSocket":{"port":0},"failureThreshold":30}`},
+	})
+}
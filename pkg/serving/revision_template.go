@@ -36,7 +36,7 @@ func ContainerOfRevisionSpec(revisionSpec *servingv1.RevisionSpec) *corev1.Conta
 	if idx == -1 {
 		return nil
 	}
-	return &revisionSpec.Containers[0]
+	return &revisionSpec.Containers[idx]
 }
 
 // ContainerIndexOfRevisionSpec returns the index of the "main" container if
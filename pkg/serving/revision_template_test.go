@@ -122,6 +122,12 @@ func TestContainerIndexOfRevisionSpec(t *testing.T) {
 			if got := ContainerIndexOfRevisionSpec(tt.revSpec); got != tt.want {
 				t.Errorf("ContainerIndexOfRevisionSpec() = %v, want %v", got, tt.want)
 			}
+			container := ContainerOfRevisionSpec(tt.revSpec)
+			if tt.want == -1 {
+				assert.Assert(t, container == nil)
+			} else {
+				assert.Equal(t, container, &tt.revSpec.Containers[tt.want])
+			}
 		})
 	}
 }
@@ -72,3 +72,20 @@ func GenerateRevisionName(nameTempl string, service *servingv1.Service) (string,
 	}
 	return res, nil
 }
+
+// RoutedRevisions returns the names of the revisions which are referenced by name in the traffic
+// of the service. Revisions which are only referenced by a tag without getting any traffic are
+// included if withTags is true.
+func RoutedRevisions(service *servingv1.Service, withTags bool) map[string]bool {
+	revisions := make(map[string]bool)
+	for _, traffic := range service.Spec.RouteSpec.Traffic {
+		if traffic.RevisionName == "" {
+			continue
+		}
+		if !withTags && traffic.Tag != "" && (traffic.Percent == nil || *traffic.Percent == 0) {
+			continue
+		}
+		revisions[traffic.RevisionName] = true
+	}
+	return revisions
+}
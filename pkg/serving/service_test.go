@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"gotest.tools/v3/assert"
+	"knative.dev/pkg/ptr"
 
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 )
@@ -53,3 +54,15 @@ func TestGenerateName(t *testing.T) {
 		}
 	}
 }
+
+func TestRoutedRevisions(t *testing.T) {
+	service := &servingv1.Service{}
+	service.Spec.Traffic = []servingv1.TrafficTarget{
+		{RevisionName: "foo-00001", Percent: ptr.Int64(50)},
+		{RevisionName: "foo-00002", Percent: ptr.Int64(0), Tag: "candidate"},
+		{RevisionName: "foo-00003", Percent: ptr.Int64(50), Tag: "current"},
+		{LatestRevision: ptr.Bool(true), Percent: ptr.Int64(0), Tag: "latest"},
+	}
+	assert.DeepEqual(t, RoutedRevisions(service, true), map[string]bool{"foo-00001": true, "foo-00002": true, "foo-00003": true})
+	assert.DeepEqual(t, RoutedRevisions(service, false), map[string]bool{"foo-00001": true, "foo-00003": true})
+}
@@ -2,9 +2,11 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 	"time"
 
+	jsonpatch "gopkg.in/evanphx/json-patch.v4"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -59,6 +61,55 @@ func (cl *knServingClient) patch(ctx context.Context, modifiedService *servingv1
 	return hasChanged, err
 }
 
+// PreviewApply computes the result of applying modifiedService on top of currentService
+// without changing anything on the cluster. It performs the same three-way merge as
+// ApplyService() and returns the current and the merged configuration, both cleaned up
+// from status and other server side information so that they can be compared directly.
+// currentService can be nil if the service does not exist yet, in which case the returned
+// current configuration is nil, too.
+func PreviewApply(currentService *servingv1.Service, modifiedService *servingv1.Service) (*servingv1.Service, *servingv1.Service, error) {
+	modified := modifiedService.DeepCopy()
+	if currentService == nil {
+		err := updateLastAppliedAnnotation(modified)
+		if err != nil {
+			return nil, nil, err
+		}
+		uMerged, err := encodeService(modified)
+		if err != nil {
+			return nil, nil, err
+		}
+		merged, err := decodeService(uMerged)
+		return nil, merged, err
+	}
+
+	uModifiedService, err := getModifiedConfiguration(modified, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	uCurrentService, err := encodeService(currentService.DeepCopy())
+	if err != nil {
+		return nil, nil, err
+	}
+	patch, err := jsonmergepatch.CreateThreeWayJSONMergePatch(getOriginalConfiguration(currentService), uModifiedService, uCurrentService)
+	if err != nil {
+		return nil, nil, err
+	}
+	uMergedService, err := jsonpatch.MergePatch(uCurrentService, patch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	current, err := decodeService(uCurrentService)
+	if err != nil {
+		return nil, nil, err
+	}
+	merged, err := decodeService(uMergedService)
+	if err != nil {
+		return nil, nil, err
+	}
+	return current, merged, nil
+}
+
 func (cl *knServingClient) patchSimple(ctx context.Context, currentService *servingv1.Service, uModifiedService []byte, uOriginalService []byte) (bool, error) {
 	// Serialize the current configuration of the object from the server.
 	uCurrentService, err := encodeService(currentService)
@@ -185,6 +236,15 @@ func encodeService(service *servingv1.Service) ([]byte, error) {
 	return runtime.Encode(encoder, serviceUnstructured)
 }
 
+func decodeService(uService []byte) (*servingv1.Service, error) {
+	service := &servingv1.Service{}
+	err := json.Unmarshal(uService, service)
+	if err != nil {
+		return nil, err
+	}
+	return service, nil
+}
+
 func cleanupServiceUnstructured(uService *unstructured.Unstructured) {
 	clearCreationTimestamps(uService.Object)
 	removeStatus(uService.Object)
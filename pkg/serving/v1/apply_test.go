@@ -285,3 +285,34 @@ spec:
 		})
 	}
 }
+
+func TestPreviewApplyCreate(t *testing.T) {
+	current, merged, err := PreviewApply(nil, newServiceWithImage("foo", "test/image"))
+	assert.NilError(t, err)
+	assert.Assert(t, current == nil)
+	assert.Equal(t, merged.Spec.Template.Spec.Containers[0].Image, "test/image")
+	assert.Assert(t, merged.Annotations[corev1.LastAppliedConfigAnnotation] != "")
+}
+
+func TestPreviewApplyUpdate(t *testing.T) {
+	// Service as created by a previous apply and with some server side changes
+	_, live, err := PreviewApply(nil, newServiceWithImage("foo", "test/image"))
+	assert.NilError(t, err)
+	live.Labels = map[string]string{"server": "side"}
+	live.Generation = 1
+	live.Status.ObservedGeneration = 1
+
+	current, merged, err := PreviewApply(live, newServiceWithImage("foo", "test/new-image"))
+	assert.NilError(t, err)
+	assert.Equal(t, current.Spec.Template.Spec.Containers[0].Image, "test/image")
+	assert.Equal(t, merged.Spec.Template.Spec.Containers[0].Image, "test/new-image")
+	// Server side changes are preserved, status is stripped
+	assert.Equal(t, merged.Labels["server"], "side")
+	assert.Equal(t, merged.Status.ObservedGeneration, int64(0))
+	assert.Equal(t, current.Status.ObservedGeneration, int64(0))
+
+	// Applying the same configuration again does not change anything
+	current, merged, err = PreviewApply(live, newServiceWithImage("foo", "test/image"))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, current, merged)
+}
@@ -92,6 +92,12 @@ type KnServingClient interface {
 	// Delete a service by name
 	DeleteService(ctx context.Context, name string, timeout time.Duration) error
 
+	// Watch a service by name, starting at the given resource version
+	WatchServiceWithVersion(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error)
+
+	// Watch the services matching the given filters, starting at the resource version of a service list
+	WatchServices(ctx context.Context, initialVersion string, opts ...ListConfig) (watch.Interface, error)
+
 	// Wait for a service to become ready, but not longer than provided timeout.
 	// Return error and how long has been waited
 	WaitForService(ctx context.Context, name string, wconfig WaitConfig, msgCallback wait.MessageCallback) (error, time.Duration)
@@ -115,6 +121,9 @@ type KnServingClient interface {
 	// List revisions
 	ListRevisions(ctx context.Context, opts ...ListConfig) (*servingv1.RevisionList, error)
 
+	// Watch the revisions matching the given filters, starting at the resource version of a revision list
+	WatchRevisions(ctx context.Context, initialVersion string, opts ...ListConfig) (watch.Interface, error)
+
 	// Delete a revision
 	DeleteRevision(ctx context.Context, name string, timeout time.Duration) error
 
@@ -210,6 +219,38 @@ func (cl *knServingClient) WatchServiceWithVersion(ctx context.Context, name str
 	return wait.NewWatcherWithVersion(ctx, cl.client.Services(cl.namespace).Watch, cl.client.RESTClient(), cl.namespace, "services", name, initialVersion, timeout)
 }
 
+func (cl *knServingClient) WatchServices(ctx context.Context, initialVersion string, config ...ListConfig) (watch.Interface, error) {
+	opts := ListConfigs(config).toListOptions()
+	opts.ResourceVersion = initialVersion
+	watcher, err := wait.NewListWatcher(ctx, cl.client.Services(cl.namespace).Watch, "services", opts, wait.DefaultWatchOptions)
+	if err != nil {
+		return nil, clienterrors.GetError(err)
+	}
+	return withServingGvk(watcher), nil
+}
+
+func (cl *knServingClient) WatchRevisions(ctx context.Context, initialVersion string, config ...ListConfig) (watch.Interface, error) {
+	opts := ListConfigs(config).toListOptions()
+	opts.ResourceVersion = initialVersion
+	watcher, err := wait.NewListWatcher(ctx, cl.client.Revisions(cl.namespace).Watch, "revisions", opts, wait.DefaultWatchOptions)
+	if err != nil {
+		return nil, clienterrors.GetError(err)
+	}
+	return withServingGvk(watcher), nil
+}
+
+// withServingGvk sets the GroupVersionKind of the objects delivered by a watch, which
+// the API server omits
+func withServingGvk(watcher watch.Interface) watch.Interface {
+	return watch.Filter(watcher, func(event watch.Event) (watch.Event, bool) {
+		if event.Object != nil && event.Type != watch.Error {
+			// Objects of unexpected types are passed on unchanged
+			_ = updateServingGvk(event.Object)
+		}
+		return event, true
+	})
+}
+
 func (cl *knServingClient) WatchRevisionWithVersion(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
 	return wait.NewWatcherWithVersion(ctx, cl.client.Revisions(cl.namespace).Watch, cl.client.RESTClient(), cl.namespace, "revision", name, initialVersion, timeout)
 }
@@ -251,7 +292,7 @@ func (cl *knServingClient) CreateService(ctx context.Context, service *servingv1
 func (cl *knServingClient) UpdateService(ctx context.Context, service *servingv1.Service) (bool, error) {
 	updated, err := cl.client.Services(cl.namespace).Update(ctx, service, v1.UpdateOptions{})
 	if err != nil {
-		return false, err
+		return false, clienterrors.GetError(err)
 	}
 	changed := service.ObjectMeta.Generation != updated.ObjectMeta.Generation
 	return changed, updateServingGvk(service)
@@ -357,7 +398,7 @@ func (cl *knServingClient) deleteService(ctx context.Context, serviceName string
 
 // Wait for a service to become ready, but not longer than provided timeout
 func (cl *knServingClient) WaitForService(ctx context.Context, name string, wconfig WaitConfig, msgCallback wait.MessageCallback) (error, time.Duration) {
-	waitForReady := wait.NewWaitForReady("service", cl.WatchServiceWithVersion, serviceConditionExtractor)
+	waitForReady := wait.NewWaitForReady("service", cl.WatchServiceWithVersion, ServiceConditionExtractor)
 
 	service, err := cl.GetService(ctx, name)
 	if err != nil {
@@ -576,7 +617,8 @@ func updateServingGvk(obj runtime.Object) error {
 	return util.UpdateGroupVersionKindWithScheme(obj, servingv1.SchemeGroupVersion, scheme.Scheme)
 }
 
-func serviceConditionExtractor(obj runtime.Object) (apis.Conditions, error) {
+// ServiceConditionExtractor extracts the status conditions of a service
+func ServiceConditionExtractor(obj runtime.Object) (apis.Conditions, error) {
 	service, ok := obj.(*servingv1.Service)
 	if !ok {
 		return nil, fmt.Errorf("%v is not a service", obj)
@@ -22,6 +22,7 @@ import (
 	"gotest.tools/v3/assert"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"knative.dev/client/pkg/util/mock"
@@ -155,6 +156,17 @@ func (c *MockKnServingClient) ListRevisions(ctx context.Context, opts ...ListCon
 	return call.Result[0].(*servingv1.RevisionList), mock.ErrorOrNil(call.Result[1])
 }
 
+// Watch revisions
+func (sr *ServingRecorder) WatchRevisions(initialVersion interface{}, opts interface{}, watcher watch.Interface, err error) {
+	sr.r.Add("WatchRevisions", []interface{}{initialVersion, opts}, []interface{}{watcher, err})
+}
+
+func (c *MockKnServingClient) WatchRevisions(ctx context.Context, initialVersion string, opts ...ListConfig) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchRevisions", initialVersion, opts)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
 // Delete a revision
 func (sr *ServingRecorder) DeleteRevision(name, timeout interface{}, err error) {
 	sr.r.Add("DeleteRevision", []interface{}{name, timeout}, []interface{}{err})
@@ -165,6 +177,28 @@ func (c *MockKnServingClient) DeleteRevision(ctx context.Context, name string, t
 	return mock.ErrorOrNil(call.Result[0])
 }
 
+// Watch a service
+func (sr *ServingRecorder) WatchServiceWithVersion(name interface{}, initialVersion interface{}, watcher watch.Interface, err error) {
+	sr.r.Add("WatchServiceWithVersion", []interface{}{name, initialVersion}, []interface{}{watcher, err})
+}
+
+func (c *MockKnServingClient) WatchServiceWithVersion(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchServiceWithVersion", name, initialVersion)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
+// Watch services
+func (sr *ServingRecorder) WatchServices(initialVersion interface{}, opts interface{}, watcher watch.Interface, err error) {
+	sr.r.Add("WatchServices", []interface{}{initialVersion, opts}, []interface{}{watcher, err})
+}
+
+func (c *MockKnServingClient) WatchServices(ctx context.Context, initialVersion string, opts ...ListConfig) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchServices", initialVersion, opts)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
 // Wait for a revision to become ready, but not longer than provided timeout
 func (sr *ServingRecorder) WaitForRevision(name interface{}, timeout interface{}, callback interface{}, err error, duration time.Duration) {
 	sr.r.Add("WaitForRevision", []interface{}{name, timeout, callback}, []interface{}{err, duration})
@@ -596,6 +596,42 @@ func TestListRevisions(t *testing.T) {
 	})
 }
 
+func TestWatchServicesAndRevisions(t *testing.T) {
+	serving, client := setup()
+
+	var restrictions []clienttesting.WatchRestrictions
+	serving.AddWatchReactor("*",
+		func(a clienttesting.Action) (bool, watch.Interface, error) {
+			restrictions = append(restrictions, a.(clienttesting.WatchAction).GetWatchRestrictions())
+			var obj runtime.Object = newService("test-service")
+			if a.GetResource().Resource == "revisions" {
+				obj = newRevision("test-revision")
+			}
+			w := wait.NewFakeWatch([]watch.Event{{Type: watch.Added, Object: obj}})
+			w.Start()
+			return true, w, nil
+		})
+
+	watcher, err := client.WatchServices(context.Background(), "10", WithName("test-service"))
+	assert.NilError(t, err)
+	event := <-watcher.ResultChan()
+	watcher.Stop()
+	validateGroupVersionKind(t, event.Object)
+	assert.Equal(t, event.Object.(*servingv1.Service).Name, "test-service")
+
+	watcher, err = client.WatchRevisions(context.Background(), "20", WithService("test-service"))
+	assert.NilError(t, err)
+	event = <-watcher.ResultChan()
+	watcher.Stop()
+	validateGroupVersionKind(t, event.Object)
+
+	assert.Equal(t, len(restrictions), 2)
+	assert.Equal(t, restrictions[0].ResourceVersion, "10")
+	assert.Equal(t, restrictions[0].Fields.String(), "metadata.name=test-service")
+	assert.Equal(t, restrictions[1].ResourceVersion, "20")
+	assert.Equal(t, restrictions[1].Labels.String(), "serving.knative.dev/service=test-service")
+}
+
 func TestListRevisionsError(t *testing.T) {
 	serving, client := setup()
 
@@ -26,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	"knative.dev/client/pkg/wait"
@@ -198,6 +199,16 @@ func (cl *knServingGitOpsClient) DeleteService(ctx context.Context, serviceName
 	return os.Remove(cl.getKsvcFilePath(serviceName))
 }
 
+// WatchServiceWithVersion is not supported for this client
+func (cl *knServingGitOpsClient) WatchServiceWithVersion(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
+	return nil, fmt.Errorf("watching service '%s' is not supported in the local directory '%s'", name, cl.dir)
+}
+
+// WatchServices is not supported for this client
+func (cl *knServingGitOpsClient) WatchServices(ctx context.Context, initialVersion string, opts ...ListConfig) (watch.Interface, error) {
+	return nil, fmt.Errorf("watching services is not supported in the local directory '%s'", cl.dir)
+}
+
 // WaitForService always returns success for this client
 func (cl *knServingGitOpsClient) WaitForService(ctx context.Context, name string, wconfig WaitConfig, msgCallback wait.MessageCallback) (error, time.Duration) {
 	return nil, 1 * time.Second
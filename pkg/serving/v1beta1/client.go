@@ -17,17 +17,21 @@ package v1beta1
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"knative.dev/client/pkg/config"
 
 	"k8s.io/client-go/util/retry"
 
+	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	knerrors "knative.dev/client/pkg/errors"
 	"knative.dev/client/pkg/util"
+	"knative.dev/client/pkg/wait"
 	servingv1beta1 "knative.dev/serving/pkg/apis/serving/v1beta1"
 	"knative.dev/serving/pkg/client/clientset/versioned/scheme"
 	clientv1beta1 "knative.dev/serving/pkg/client/clientset/versioned/typed/serving/v1beta1"
@@ -57,6 +61,9 @@ type KnServingClient interface {
 
 	// ListDomainMappings
 	ListDomainMappings(ctx context.Context) (*servingv1beta1.DomainMappingList, error)
+
+	// WatchDomainMapping
+	WatchDomainMapping(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error)
 }
 
 type knServingClient struct {
@@ -169,6 +176,20 @@ func (cl *knServingClient) ListDomainMappings(ctx context.Context) (*servingv1be
 	return dmListNew, nil
 }
 
+// WatchDomainMapping watches the DomainMapping with the given name
+func (cl *knServingClient) WatchDomainMapping(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
+	return wait.NewWatcherWithVersion(ctx, cl.client.DomainMappings(cl.namespace).Watch, cl.client.RESTClient(), cl.namespace, "domainmappings", name, initialVersion, timeout)
+}
+
+// DomainMappingConditionExtractor extracts the status conditions of a DomainMapping
+func DomainMappingConditionExtractor(obj runtime.Object) (apis.Conditions, error) {
+	domainMapping, ok := obj.(*servingv1beta1.DomainMapping)
+	if !ok {
+		return nil, fmt.Errorf("%v is not a domain mapping", obj)
+	}
+	return apis.Conditions(domainMapping.Status.Conditions), nil
+}
+
 func updateServingGvk(obj runtime.Object) error {
 	return util.UpdateGroupVersionKindWithScheme(obj, servingv1beta1.SchemeGroupVersion, scheme.Scheme)
 }
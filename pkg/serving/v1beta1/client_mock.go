@@ -17,7 +17,9 @@ package v1beta1
 import (
 	"context"
 	"testing"
+	"time"
 
+	"k8s.io/apimachinery/pkg/watch"
 	"knative.dev/client/pkg/util/mock"
 	servingv1beta1 "knative.dev/serving/pkg/apis/serving/v1beta1"
 )
@@ -118,3 +120,15 @@ func (c *MockKnServingClient) ListDomainMappings(ctx context.Context) (*servingv
 	call := c.recorder.r.VerifyCall("ListDomainMappings")
 	return call.Result[0].(*servingv1beta1.DomainMappingList), mock.ErrorOrNil(call.Result[1])
 }
+
+// WatchDomainMapping recorder function
+func (sr *ServingRecorder) WatchDomainMapping(name, initialVersion interface{}, watcher watch.Interface, err error) {
+	sr.r.Add("WatchDomainMapping", []interface{}{name, initialVersion}, []interface{}{watcher, err})
+}
+
+// WatchDomainMapping mock function
+func (c *MockKnServingClient) WatchDomainMapping(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchDomainMapping", name, initialVersion)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
@@ -17,6 +17,7 @@ package v1beta1
 import (
 	"context"
 	"testing"
+	"time"
 
 	"knative.dev/serving/pkg/apis/serving/v1beta1"
 )
@@ -36,6 +37,7 @@ func TestMockKnClient(t *testing.T) {
 	recorder.GetDomainMapping("hello.foo.bar", &v1beta1.DomainMapping{}, nil)
 	recorder.UpdateDomainMapping(&v1beta1.DomainMapping{}, nil)
 	recorder.ListDomainMappings(&v1beta1.DomainMappingList{}, nil)
+	recorder.WatchDomainMapping("hello.foo.bar", "1", nil, nil)
 
 	// Call all services
 	ctx := context.Background()
@@ -47,6 +49,7 @@ func TestMockKnClient(t *testing.T) {
 		return origDomain, nil
 	}, 10)
 	client.ListDomainMappings(ctx)
+	client.WatchDomainMapping(ctx, "hello.foo.bar", "1", 10*time.Second)
 
 	// Validate
 	recorder.Validate()
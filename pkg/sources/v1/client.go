@@ -52,7 +52,7 @@ func NewKnSourcesClient(client clientv1.SourcesV1Interface, namespace string) Kn
 
 // Get the client for dealing with Ping sources
 func (c *sourcesClient) PingSourcesClient() KnPingSourcesClient {
-	return newKnPingSourcesClient(c.client.PingSources(c.namespace), c.namespace)
+	return newKnPingSourcesClient(c.client.PingSources(c.namespace), c.client.RESTClient(), c.namespace)
 }
 
 // ApiServerSourcesClient for dealing with ApiServer sources
@@ -17,24 +17,38 @@ package v1
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"knative.dev/client/pkg/config"
 
 	"k8s.io/client-go/util/retry"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
 	"knative.dev/client/pkg/util"
 	"knative.dev/eventing/pkg/client/clientset/versioned/scheme"
 
 	knerrors "knative.dev/client/pkg/errors"
+	"knative.dev/client/pkg/wait"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 
 	clientv1 "knative.dev/eventing/pkg/client/clientset/versioned/typed/sources/v1"
+	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
+// PingSourceConditionExtractor extracts the status conditions of a Ping source
+func PingSourceConditionExtractor(obj runtime.Object) (apis.Conditions, error) {
+	source, ok := obj.(*sourcesv1.PingSource)
+	if !ok {
+		return nil, fmt.Errorf("%v is not a Ping source", obj)
+	}
+	return apis.Conditions(source.Status.Conditions), nil
+}
+
 type PingSourceUpdateFunc func(origSource *sourcesv1.PingSource) (*sourcesv1.PingSource, error)
 
 // Interface for interacting with a Ping source
@@ -55,6 +69,9 @@ type KnPingSourcesClient interface {
 	// DeletePingSource deletes a Ping source
 	DeletePingSource(ctx context.Context, name string) error
 
+	// WatchPingSource watches a Ping source by its name, starting at the given resource version
+	WatchPingSource(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error)
+
 	// ListPingSource lists all Ping sources
 	// TODO: Support list configs like in service list
 	ListPingSource(ctx context.Context) (*sourcesv1.PingSourceList, error)
@@ -67,15 +84,17 @@ type KnPingSourcesClient interface {
 // Temporarily help to add sources dependencies
 // May be changed when adding real sources features
 type pingSourcesClient struct {
-	client    clientv1.PingSourceInterface
-	namespace string
+	client     clientv1.PingSourceInterface
+	restClient rest.Interface
+	namespace  string
 }
 
 // NewKnSourcesClient is to invoke Eventing Sources Client API to create object
-func newKnPingSourcesClient(client clientv1.PingSourceInterface, namespace string) KnPingSourcesClient {
+func newKnPingSourcesClient(client clientv1.PingSourceInterface, restClient rest.Interface, namespace string) KnPingSourcesClient {
 	return &pingSourcesClient{
-		client:    client,
-		namespace: namespace,
+		client:     client,
+		restClient: restClient,
+		namespace:  namespace,
 	}
 }
 
@@ -140,6 +159,11 @@ func (c *pingSourcesClient) DeletePingSource(ctx context.Context, name string) e
 	return nil
 }
 
+// WatchPingSource is used to create a watcher object
+func (c *pingSourcesClient) WatchPingSource(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
+	return wait.NewWatcherWithVersion(ctx, c.client.Watch, c.restClient, c.namespace, "pingsources", name, initialVersion, timeout)
+}
+
 func (c *pingSourcesClient) GetPingSource(ctx context.Context, name string) (*sourcesv1.PingSource, error) {
 	source, err := c.client.Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
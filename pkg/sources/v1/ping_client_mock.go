@@ -17,7 +17,9 @@ package v1
 import (
 	"context"
 	"testing"
+	"time"
 
+	"k8s.io/apimachinery/pkg/watch"
 	"knative.dev/client/pkg/util/mock"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 )
@@ -105,6 +107,18 @@ func (c *MockKnPingSourceClient) DeletePingSource(ctx context.Context, name stri
 	return mock.ErrorOrNil(call.Result[0])
 }
 
+// WatchPingSource records a call for WatchPingSource with the expected watch or error
+func (sr *PingSourcesRecorder) WatchPingSource(name, initialVersion interface{}, watcher watch.Interface, err error) {
+	sr.r.Add("WatchPingSource", []interface{}{name, initialVersion}, []interface{}{watcher, err})
+}
+
+// WatchPingSource performs a previously recorded action, failing if non has been registered
+func (c *MockKnPingSourceClient) WatchPingSource(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
+	call := c.recorder.r.VerifyCall("WatchPingSource", name, initialVersion)
+	watcher, _ := call.Result[0].(watch.Interface)
+	return watcher, mock.ErrorOrNil(call.Result[1])
+}
+
 // ListPingSource records a call for ListPingSource with the expected error (nil if none)
 func (sr *PingSourcesRecorder) ListPingSource(pingSourceList *sourcesv1.PingSourceList, err error) {
 	sr.r.Add("ListPingSource", []interface{}{}, []interface{}{pingSourceList, err})
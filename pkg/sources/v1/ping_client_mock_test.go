@@ -17,6 +17,7 @@ package v1
 import (
 	"context"
 	"testing"
+	"time"
 
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 )
@@ -36,6 +37,7 @@ func TestMockKnPingSourceClient(t *testing.T) {
 	recorder.UpdatePingSource(&sourcesv1.PingSource{}, nil)
 
 	recorder.DeletePingSource("hello", nil)
+	recorder.WatchPingSource("hello", "1", nil, nil)
 
 	// Call all service
 	ctx := context.Background()
@@ -46,6 +48,7 @@ func TestMockKnPingSourceClient(t *testing.T) {
 		return origSource, nil
 	}, 10)
 	client.DeletePingSource(ctx, "hello")
+	client.WatchPingSource(ctx, "hello", "1", 10*time.Second)
 
 	// Validate
 	recorder.Validate()
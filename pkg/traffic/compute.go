@@ -20,11 +20,11 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
-	"knative.dev/client/pkg/commands/revision"
 	"knative.dev/pkg/ptr"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"knative.dev/client/pkg/commands/flags"
+	clientserving "knative.dev/client/pkg/serving"
 )
 
 var latestRevisionRef = "@latest"
@@ -391,7 +391,7 @@ func verifyLatestTag(trafficFlags *flags.Traffic) error {
 
 func checkRevisionPresent(refMap map[string]int, rev servingv1.Revision) bool {
 	_, nameExists := refMap[rev.Name]
-	_, tagExists := refMap[rev.Annotations[revision.RevisionTagsAnnotation]]
+	_, tagExists := refMap[rev.Annotations[clientserving.RevisionTagsAnnotationKey]]
 	return tagExists || nameExists
 }
 
@@ -400,6 +400,26 @@ func checkRevisionPresent(refMap map[string]int, rev servingv1.Revision) bool {
 // traffic. Param 'mutation' is set to true if a new revision will be created on service update
 func Compute(cmd *cobra.Command, svc *servingv1.Service,
 	trafficFlags *flags.Traffic, allRevisions []servingv1.Revision, mutation bool) ([]servingv1.TrafficTarget, error) {
+	resetPercentages := cmd.Flags().Changed("traffic") || (cmd.Name() == "create" && len(trafficFlags.RevisionsTags) > 0)
+	return compute(svc, trafficFlags, allRevisions, mutation, resetPercentages)
+}
+
+// ComputePercentages takes service object and computes the new traffic for the given traffic split
+// (format: revisionRef=percent) in the same way as Compute does for the --traffic flag
+func ComputePercentages(svc *servingv1.Service, revisionsPercentages []string, allRevisions []servingv1.Revision) ([]servingv1.TrafficTarget, error) {
+	trafficFlags := &flags.Traffic{RevisionsPercentages: revisionsPercentages}
+	return compute(svc, trafficFlags, allRevisions, false, true)
+}
+
+// ComputeTags takes service object and computes the new traffic after applying the given tags
+// (format: revisionRef=tag) and removing the given tags in the same way as Compute does for the
+// --tag and --untag flags. The traffic percentages are not changed.
+func ComputeTags(svc *servingv1.Service, revisionsTags []string, untagRevisions []string) ([]servingv1.TrafficTarget, error) {
+	trafficFlags := &flags.Traffic{RevisionsTags: revisionsTags, UntagRevisions: untagRevisions}
+	return compute(svc, trafficFlags, nil, false, false)
+}
+
+func compute(svc *servingv1.Service, trafficFlags *flags.Traffic, allRevisions []servingv1.Revision, mutation bool, resetPercentages bool) ([]servingv1.TrafficTarget, error) {
 	targets := svc.Spec.Traffic
 	serviceName := svc.Name
 	revisions := svc.Status.Traffic
@@ -465,7 +485,7 @@ func Compute(cmd *cobra.Command, svc *servingv1.Service,
 		traffic = traffic.TagRevision(tag, revision)
 	}
 
-	if cmd.Flags().Changed("traffic") || (cmd.Name() == "create" && len(trafficFlags.RevisionsTags) > 0) {
+	if resetPercentages {
 		// reset existing traffic portions as what's on CLI is desired state of traffic split portions
 		traffic.ResetAllTargetPercent()
 
@@ -18,7 +18,7 @@ import (
 	"gotest.tools/v3/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"knative.dev/client/pkg/commands/revision"
+	clientserving "knative.dev/client/pkg/serving"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	"testing"
@@ -563,7 +563,7 @@ func TestComputeErrMsg(t *testing.T) {
 						"serving.knative.dev/service": "serviceName",
 					},
 					Annotations: map[string]string{
-						revision.RevisionTagsAnnotation: "rev-00003",
+						clientserving.RevisionTagsAnnotationKey: "rev-00003",
 					},
 				},
 			}, {
@@ -586,3 +586,52 @@ func TestComputeErrMsg(t *testing.T) {
 		})
 	}
 }
+
+func TestComputePercentages(t *testing.T) {
+	existingTraffic := append(newServiceTraffic([]servingv1.TrafficTarget{}), newTarget("", "", 100, true), newTarget("stable", "echo-v1", 0, false))
+	svc := getService("serviceName", "echo-v2", existingTraffic)
+
+	targets, err := ComputePercentages(svc, []string{"echo-v2=25", "echo-v1=75"}, nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, targets, []servingv1.TrafficTarget{
+		newTarget("stable", "echo-v1", 75, false),
+		newTarget("", "echo-v2", 25, false),
+	})
+
+	targets, err = ComputePercentages(svc, []string{"echo-v2=100", "echo-v1=0"}, nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, targets, []servingv1.TrafficTarget{
+		newTarget("stable", "echo-v1", 0, false),
+		newTarget("", "echo-v2", 100, false),
+	})
+
+	_, err = ComputePercentages(svc, []string{"echo-v2=60", "echo-v1=60"}, nil)
+	assert.ErrorContains(t, err, "sum to 120")
+}
+
+func TestComputeTags(t *testing.T) {
+	newTestService := func() *servingv1.Service {
+		existingTraffic := append(newServiceTraffic([]servingv1.TrafficTarget{}), newTarget("", "", 100, true), newTarget("stable", "echo-v1", 0, false))
+		return getService("serviceName", "echo-v2", existingTraffic)
+	}
+
+	targets, err := ComputeTags(newTestService(), []string{"echo-v2=candidate"}, nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, targets, []servingv1.TrafficTarget{
+		newTarget("", "", 100, true),
+		newTarget("stable", "echo-v1", 0, false),
+		newTarget("candidate", "echo-v2", 0, false),
+	})
+
+	targets, err = ComputeTags(newTestService(), nil, []string{"stable"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, targets, []servingv1.TrafficTarget{
+		newTarget("", "", 100, true),
+	})
+
+	_, err = ComputeTags(newTestService(), []string{"echo-v2=stable"}, nil)
+	assert.ErrorContains(t, err, "refusing to overwrite existing tag")
+
+	_, err = ComputeTags(newTestService(), nil, []string{"unknown"})
+	assert.ErrorContains(t, err, "tag(s) unknown not present")
+}
@@ -0,0 +1,156 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traffic
+
+import (
+	"errors"
+	"fmt"
+
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/client/pkg/commands/flags"
+)
+
+// Plan describes the desired traffic of a service. Traffic targets which are
+// not part of the plan are removed when the plan is applied.
+type Plan struct {
+	// Service is the name of the service the plan is meant for
+	Service string `json:"service,omitempty"`
+	// Targets of the traffic
+	Targets []PlanTarget `json:"targets"`
+}
+
+// PlanTarget is a traffic target of a plan. It refers to either a revision, the latest
+// ready revision with '@latest', or the revision which currently has the given tag.
+type PlanTarget struct {
+	// Revision is the name of the revision or '@latest'
+	Revision string `json:"revision,omitempty"`
+	// Tag refers to the revision which currently has this tag. The tag is kept.
+	Tag string `json:"tag,omitempty"`
+	// Percent of the traffic routed to the revision
+	Percent int64 `json:"percent"`
+	// Tags assigned to the revision
+	Tags []string `json:"tags,omitempty"`
+}
+
+// ParsePlan parses a traffic plan given as YAML or JSON
+func ParsePlan(data []byte) (*Plan, error) {
+	plan := &Plan{}
+	if err := yaml.UnmarshalStrict(data, plan); err != nil {
+		return nil, fmt.Errorf("cannot parse traffic plan: %w", err)
+	}
+	if len(plan.Targets) == 0 {
+		return nil, errors.New("traffic plan has no targets")
+	}
+	return plan, nil
+}
+
+// PlanFromService creates a plan which describes the current traffic of a service.
+// Targets of the same revision are merged into a single target of the plan.
+func PlanFromService(svc *servingv1.Service) *Plan {
+	plan := &Plan{Service: svc.Name, Targets: []PlanTarget{}}
+	index := make(map[string]int)
+	for _, target := range svc.Spec.Traffic {
+		revision := target.RevisionName
+		if target.LatestRevision != nil && *target.LatestRevision {
+			revision = latestRevisionRef
+		}
+		i, ok := index[revision]
+		if !ok {
+			i = len(plan.Targets)
+			index[revision] = i
+			plan.Targets = append(plan.Targets, PlanTarget{Revision: revision})
+		}
+		if target.Percent != nil {
+			plan.Targets[i].Percent += *target.Percent
+		}
+		if target.Tag != "" {
+			plan.Targets[i].Tags = append(plan.Targets[i].Tags, target.Tag)
+		}
+	}
+	return plan
+}
+
+// ComputePlan takes service object and computes the traffic described by the plan. The plan is validated
+// with the same rules as the --traffic and --tag flags, but the percentages have to sum to 100.
+func ComputePlan(svc *servingv1.Service, plan *Plan) ([]servingv1.TrafficTarget, error) {
+	trafficFlags := &flags.Traffic{}
+	revisions := make(map[string]bool)
+	tags := make(map[string]bool)
+	for i, target := range plan.Targets {
+		revision, err := planTargetRevision(svc, target)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target %d of traffic plan: %w", i+1, err)
+		}
+		if revisions[revision] {
+			return nil, fmt.Errorf("revision '%s' is referenced by more than one target of the traffic plan, "+
+				"assign all its tags in a single target", revision)
+		}
+		revisions[revision] = true
+
+		targetTags := target.Tags
+		if target.Tag != "" {
+			targetTags = append([]string{target.Tag}, targetTags...)
+		}
+		for _, tag := range targetTags {
+			if tags[tag] {
+				return nil, fmt.Errorf("tag '%s' is used more than once in the traffic plan", tag)
+			}
+			tags[tag] = true
+			trafficFlags.RevisionsTags = append(trafficFlags.RevisionsTags, revision+"="+tag)
+		}
+		trafficFlags.RevisionsPercentages = append(trafficFlags.RevisionsPercentages, fmt.Sprintf("%s=%d", revision, target.Percent))
+	}
+
+	if err := verifyLatestTag(trafficFlags); err != nil {
+		return nil, err
+	}
+	_, sum, err := verifyRevisionSumAndReferences(trafficFlags)
+	if err != nil {
+		return nil, err
+	}
+	if sum != 100 {
+		return nil, fmt.Errorf("traffic plan percents sum to %d, want 100", sum)
+	}
+
+	// The plan describes the complete traffic, so start with an empty traffic block
+	planned := svc.DeepCopy()
+	planned.Spec.Traffic = nil
+	return compute(planned, trafficFlags, nil, false, true)
+}
+
+// planTargetRevision returns the revision reference of a target of the plan
+func planTargetRevision(svc *servingv1.Service, target PlanTarget) (string, error) {
+	switch {
+	case target.Revision != "" && target.Tag != "":
+		return "", fmt.Errorf("either 'revision' or 'tag' can be given, but not both")
+	case target.Revision != "":
+		return target.Revision, nil
+	case target.Tag != "":
+		for _, existing := range svc.Spec.Traffic {
+			if existing.Tag != target.Tag {
+				continue
+			}
+			if existing.LatestRevision != nil && *existing.LatestRevision {
+				return latestRevisionRef, nil
+			}
+			return existing.RevisionName, nil
+		}
+		return "", fmt.Errorf("tag '%s' not found in the traffic of service '%s'", target.Tag, svc.Name)
+	default:
+		return "", errors.New("either 'revision' or 'tag' is required")
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traffic
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestParsePlan(t *testing.T) {
+	plan, err := ParsePlan([]byte(`
+service: echo
+targets:
+- revision: "@latest"
+  percent: 80
+  tags: [current]
+- revision: echo-v1
+  percent: 20
+- tag: candidate
+`))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, plan, &Plan{
+		Service: "echo",
+		Targets: []PlanTarget{
+			{Revision: "@latest", Percent: 80, Tags: []string{"current"}},
+			{Revision: "echo-v1", Percent: 20},
+			{Tag: "candidate"},
+		},
+	})
+
+	_, err = ParsePlan([]byte("targets:\n- revision: echo-v1\n  percentage: 100\n"))
+	assert.ErrorContains(t, err, "cannot parse traffic plan")
+	assert.ErrorContains(t, err, "percentage")
+
+	_, err = ParsePlan([]byte("service: echo\n"))
+	assert.ErrorContains(t, err, "traffic plan has no targets")
+}
+
+func TestComputePlan(t *testing.T) {
+	existingTraffic := append(newServiceTraffic([]servingv1.TrafficTarget{}),
+		newTarget("", "", 100, true),
+		newTarget("stable", "echo-v1", 0, false),
+		newTarget("candidate", "echo-v3", 0, false))
+	svc := getService("echo", "echo-v3", existingTraffic)
+
+	targets, err := ComputePlan(svc, &Plan{Targets: []PlanTarget{
+		{Revision: "@latest", Percent: 60, Tags: []string{"current"}},
+		{Revision: "echo-v1", Percent: 30, Tags: []string{"stable", "v1"}},
+		{Tag: "candidate", Percent: 10},
+		{Revision: "echo-v2"},
+	}})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, targets, []servingv1.TrafficTarget{
+		newTarget("current", "", 60, true),
+		newTarget("stable", "echo-v1", 30, false),
+		newTarget("v1", "echo-v1", 0, false),
+		newTarget("candidate", "echo-v3", 10, false),
+	})
+	// The service is not modified
+	assert.DeepEqual(t, svc.Spec.Traffic, []servingv1.TrafficTarget(existingTraffic))
+
+	for _, tc := range []struct {
+		name    string
+		targets []PlanTarget
+		err     string
+	}{
+		{"sum lower than 100", []PlanTarget{{Revision: "echo-v1", Percent: 50}}, "percents sum to 50, want 100"},
+		{"sum greater than 100", []PlanTarget{{Revision: "echo-v1", Percent: 70}, {Revision: "@latest", Percent: 70}}, "sum to 140"},
+		{"negative percent", []PlanTarget{{Revision: "echo-v1", Percent: -10}, {Revision: "@latest", Percent: 110}}, "expected 0 <= percent <= 100"},
+		{"duplicate revision", []PlanTarget{{Revision: "echo-v1", Percent: 50}, {Tag: "stable", Percent: 50}}, "revision 'echo-v1' is referenced by more than one target"},
+		{"duplicate tag", []PlanTarget{{Revision: "echo-v1", Percent: 50, Tags: []string{"a"}}, {Revision: "@latest", Percent: 50, Tags: []string{"a"}}}, "tag 'a' is used more than once"},
+		{"multiple tags for @latest", []PlanTarget{{Revision: "@latest", Percent: 100, Tags: []string{"a", "b"}}}, "repetition of identifier @latest"},
+		{"unknown tag", []PlanTarget{{Tag: "unknown", Percent: 100}}, "invalid target 1 of traffic plan: tag 'unknown' not found"},
+		{"revision and tag", []PlanTarget{{Revision: "echo-v1", Tag: "stable", Percent: 100}}, "either 'revision' or 'tag' can be given"},
+		{"no reference", []PlanTarget{{Percent: 100}}, "either 'revision' or 'tag' is required"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ComputePlan(svc, &Plan{Targets: tc.targets})
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestPlanFromService(t *testing.T) {
+	existingTraffic := append(newServiceTraffic([]servingv1.TrafficTarget{}),
+		newTarget("current", "", 60, true),
+		newTarget("stable", "echo-v1", 40, false),
+		newTarget("v1", "echo-v1", 0, false))
+	svc := getService("echo", "echo-v2", existingTraffic)
+
+	plan := PlanFromService(svc)
+	assert.DeepEqual(t, plan, &Plan{
+		Service: "echo",
+		Targets: []PlanTarget{
+			{Revision: "@latest", Percent: 60, Tags: []string{"current"}},
+			{Revision: "echo-v1", Percent: 40, Tags: []string{"stable", "v1"}},
+		},
+	})
+
+	// A plan created from a service reproduces its traffic
+	targets, err := ComputePlan(svc, plan)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, targets, []servingv1.TrafficTarget(existingTraffic))
+}
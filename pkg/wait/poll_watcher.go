@@ -16,6 +16,7 @@ package wait
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -49,6 +50,9 @@ type pollingWatcher struct {
 	pollInterval PollInterval
 	// mock hook for testing.
 	poll func() (runtime.Object, error)
+	// backoff and maximum number of consecutive failed polls
+	options  WatchOptions
+	stopOnce sync.Once
 }
 
 type watchF func(context.Context, v1.ListOptions) (watch.Interface, error)
@@ -70,15 +74,36 @@ func newTickerPollInterval(d time.Duration) *tickerPollInterval {
 }
 
 // NewWatcherWithVersion makes a watch.Interface on the given resource in the client,
-// falling back to polling if the server does not support Watch.
+// falling back to polling if the server does not support Watch. The watch is
+// re-established with the DefaultWatchOptions when it ends prematurely.
 func NewWatcherWithVersion(ctx context.Context, watchFunc watchF, c rest.Interface, ns string, resource string, name string, initialResourceVersion string, timeout time.Duration) (watch.Interface, error) {
+	return NewWatcherWithOptions(ctx, watchFunc, c, ns, resource, name, initialResourceVersion, timeout, DefaultWatchOptions)
+}
+
+// NewWatcherWithOptions is like NewWatcherWithVersion, with the given options for
+// re-establishing the watch or for retrying failed polls
+func NewWatcherWithOptions(ctx context.Context, watchFunc watchF, c rest.Interface, ns string, resource string, name string, initialResourceVersion string, timeout time.Duration, options WatchOptions) (watch.Interface, error) {
+	var poll func() (runtime.Object, error)
+	if c != nil {
+		poll = nativePoll(ctx, c, ns, resource, name)
+	}
 	native, err := nativeWatchWithVersion(ctx, watchFunc, name, initialResourceVersion, timeout)
 	if err == nil {
-		return native, nil
+		return newReconnectingWatcher(ctx, watchFunc, poll, name, initialResourceVersion, timeout, options, native), nil
 	}
 	polling := &pollingWatcher{
-		c, ns, resource, name, timeout, make(chan bool), make(chan watch.Event), &sync.WaitGroup{},
-		newTickerPollInterval(time.Second), nativePoll(ctx, c, ns, resource, name)}
+		c:            c,
+		ns:           ns,
+		resource:     resource,
+		name:         name,
+		timeout:      timeout,
+		done:         make(chan bool),
+		result:       make(chan watch.Event),
+		wg:           &sync.WaitGroup{},
+		pollInterval: newTickerPollInterval(pollInterval),
+		poll:         poll,
+		options:      options,
+	}
 	polling.start()
 	return polling, nil
 }
@@ -91,74 +116,90 @@ func (w *pollingWatcher) start() {
 		defer w.pollInterval.Stop()
 		var err error
 		var old, new runtime.Object
-		done := false
-		for !done {
+		// consecutive failed polls and the time before which no new poll is made
+		failures := 0
+		var retryAt time.Time
+		for {
 			old = new
 
+			var tick time.Time
 			select {
-			case <-w.pollInterval.PollChan():
-				new, err = w.poll()
-				newObj, ok1 := new.(v1.Object)
-				oldObj, ok2 := old.(v1.Object)
-
-				if err != nil && api_errors.IsNotFound(err) {
-					if old != nil {
-						// Deleted
-						w.result <- watch.Event{
-							Type:   watch.Deleted,
-							Object: old,
-						}
-					}
-					//... Otherwise maybe just doesn't exist.
-				} else if err != nil {
-					// Just an error
-					w.result <- watch.Event{
-						Type: watch.Error,
-					}
-				} else if old == nil && new != nil {
-					// Added
-					w.result <- watch.Event{
-						Type:   watch.Added,
-						Object: new,
-					}
-				} else if !(ok1 && ok2) {
-					// Error wrong types
-					w.result <- watch.Event{
-						Type: watch.Error,
-					}
-				} else if newObj.GetUID() != oldObj.GetUID() {
-					// Deleted and readded.
-					w.result <- watch.Event{
-						Type:   watch.Deleted,
-						Object: old,
-					}
-					w.result <- watch.Event{
-						Type:   watch.Added,
-						Object: new,
-					}
-				} else if newObj.GetResourceVersion() != oldObj.GetResourceVersion() {
-					// Modified.
-					w.result <- watch.Event{
-						Type:   watch.Modified,
-						Object: new,
-					}
+			case tick = <-w.pollInterval.PollChan():
+			case <-w.done:
+				return
+			}
+			if tick.Before(retryAt) {
+				continue
+			}
+
+			new, err = w.poll()
+			newObj, ok1 := new.(v1.Object)
+			oldObj, ok2 := old.(v1.Object)
+
+			var events []watch.Event
+			if err != nil && api_errors.IsNotFound(err) {
+				failures = 0
+				if old != nil {
+					// Deleted
+					events = append(events, watch.Event{Type: watch.Deleted, Object: old})
+				}
+				//... Otherwise maybe just doesn't exist.
+			} else if err != nil {
+				// Keep the last known state and retry with a backoff, giving up after too many failures
+				new = old
+				failures++
+				if failures > w.options.MaxReconnects {
+					w.send(watch.Event{Type: watch.Error, Object: errorStatus(
+						fmt.Errorf("giving up polling '%s' after %d failures: %w", w.name, failures, err))})
+					return
+				}
+				retryAt = tick.Add(w.options.backoff(failures - 1))
+				continue
+			} else if old == nil && new != nil {
+				// Added
+				events = append(events, watch.Event{Type: watch.Added, Object: new})
+			} else if !(ok1 && ok2) {
+				// Error wrong types
+				events = append(events, watch.Event{Type: watch.Error})
+			} else if newObj.GetUID() != oldObj.GetUID() {
+				// Deleted and readded.
+				events = append(events, watch.Event{Type: watch.Deleted, Object: old}, watch.Event{Type: watch.Added, Object: new})
+			} else if newObj.GetResourceVersion() != oldObj.GetResourceVersion() {
+				// Modified.
+				events = append(events, watch.Event{Type: watch.Modified, Object: new})
+			}
+			if err == nil {
+				failures = 0
+			}
+			for _, event := range events {
+				if !w.send(event) {
+					return
 				}
-			case done = <-w.done:
-				break
 			}
 		}
 	}()
 }
 
+// send delivers an event unless the watcher has been stopped
+func (w *pollingWatcher) send(event watch.Event) bool {
+	select {
+	case w.result <- event:
+		return true
+	case <-w.done:
+		return false
+	}
+}
+
 func (w *pollingWatcher) ResultChan() <-chan watch.Event {
 	return w.result
 }
 
 func (w *pollingWatcher) Stop() {
-	w.done <- true
-	w.wg.Wait()
-	close(w.result)
-	close(w.done)
+	w.stopOnce.Do(func() {
+		close(w.done)
+		w.wg.Wait()
+		close(w.result)
+	})
 }
 
 func nativeWatchWithVersion(ctx context.Context, watchFunc watchF, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
@@ -61,8 +61,19 @@ func newWatcherForTest(pollResults []runtime.Object) watch.Interface {
 		}
 		return pollResults[i], nil
 	}
-	ret := &pollingWatcher{nil, "", "", "", time.Minute, make(chan bool), make(chan watch.Event), &sync.WaitGroup{},
-		newFakePollInterval(len(pollResults)), poll}
+	return newPollingWatcherForTest(poll, len(pollResults), DefaultWatchOptions)
+}
+
+func newPollingWatcherForTest(poll func() (runtime.Object, error), ticks int, options WatchOptions) *pollingWatcher {
+	ret := &pollingWatcher{
+		timeout:      time.Minute,
+		done:         make(chan bool),
+		result:       make(chan watch.Event),
+		wg:           &sync.WaitGroup{},
+		pollInterval: newFakePollInterval(ticks),
+		poll:         poll,
+		options:      options,
+	}
 	ret.start()
 	return ret
 }
@@ -124,3 +135,43 @@ func TestPollWatcher(t *testing.T) {
 		w.Stop()
 	}
 }
+
+func TestPollWatcherRetriesFailedPolls(t *testing.T) {
+	// Ticks are one second apart, so the tick after a failure is skipped
+	options := WatchOptions{MaxReconnects: 2, InitialBackoff: 1500 * time.Millisecond, MaxBackoff: time.Minute}
+	results := []runtime.Object{a, nil, b}
+	var polls int
+	poll := func() (runtime.Object, error) {
+		defer func() { polls++ }()
+		if results[polls] == nil {
+			return nil, fmt.Errorf("connection refused")
+		}
+		return results[polls], nil
+	}
+	w := newPollingWatcherForTest(poll, 4, options)
+	defer w.Stop()
+
+	event := <-w.ResultChan()
+	assert.Equal(t, event.Type, watch.Added)
+	// The failed poll neither emits an event nor forgets the last known state
+	event = <-w.ResultChan()
+	assert.Equal(t, event.Type, watch.Modified)
+	assert.Equal(t, event.Object.(metav1.Object).GetResourceVersion(), "b")
+	assert.Equal(t, polls, 3)
+}
+
+func TestPollWatcherGivesUp(t *testing.T) {
+	options := WatchOptions{MaxReconnects: 2}
+	poll := func() (runtime.Object, error) {
+		return nil, fmt.Errorf("connection refused")
+	}
+	w := newPollingWatcherForTest(poll, 5, options)
+	defer w.Stop()
+
+	event := <-w.ResultChan()
+	assert.Equal(t, event.Type, watch.Error)
+	status, ok := event.Object.(*metav1.Status)
+	assert.Assert(t, ok)
+	assert.ErrorContains(t, api_errors.FromObject(status), "giving up polling")
+	assert.ErrorContains(t, api_errors.FromObject(status), "connection refused")
+}
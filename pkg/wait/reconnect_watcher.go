@@ -0,0 +1,314 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8swait "k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// WatchOptions configure how a watch is re-established after it has been closed by the
+// API server, after the server returned an error or after a network failure
+type WatchOptions struct {
+	// MaxReconnects is the maximum number of reconnects after which the watch gives up
+	// and sends a final watch.Error event
+	MaxReconnects int
+	// InitialBackoff is the delay before the first reconnect, it is doubled for every
+	// subsequent failed attempt
+	InitialBackoff time.Duration
+	// MaxBackoff is the upper limit of the delay between two reconnects
+	MaxBackoff time.Duration
+	// Jitter is the maximum factor by which a delay is randomly increased, so that
+	// multiple clients don't reconnect at the same time
+	Jitter float64
+}
+
+// DefaultWatchOptions are the options used by NewWatcherWithVersion
+var DefaultWatchOptions = WatchOptions{
+	MaxReconnects:  10,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     30 * time.Second,
+	Jitter:         0.5,
+}
+
+// backoff returns the delay before the given attempt, starting with 0
+func (o WatchOptions) backoff(attempt int) time.Duration {
+	delay := o.InitialBackoff
+	for i := 0; i < attempt && delay < o.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if o.MaxBackoff > 0 && delay > o.MaxBackoff {
+		delay = o.MaxBackoff
+	}
+	if o.Jitter <= 0 {
+		return delay
+	}
+	return k8swait.Jitter(delay, o.Jitter)
+}
+
+// reconnectingWatcher wraps a native watch and re-establishes it when it ends. It keeps
+// track of the resource version of the last event so that no event gets lost, and
+// re-lists the resource if the server has compacted this version away (410 Gone).
+type reconnectingWatcher struct {
+	ctx       context.Context
+	watchFunc watchF
+	// name of the watched resource, or of the resource type for list watches
+	name string
+	// selectors of the watched resources
+	listOptions v1.ListOptions
+	timeout     time.Duration
+	options     WatchOptions
+	// relist retrieves the current state of the resource, nil if not supported
+	relist func() (runtime.Object, error)
+
+	resourceVersion string
+	lastObject      runtime.Object
+	reconnects      int
+
+	result   chan watch.Event
+	done     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+func newReconnectingWatcher(ctx context.Context, watchFunc watchF, relist func() (runtime.Object, error), name string, initialVersion string,
+	timeout time.Duration, options WatchOptions, initial watch.Interface) *reconnectingWatcher {
+	w := &reconnectingWatcher{
+		ctx:             ctx,
+		watchFunc:       watchFunc,
+		name:            name,
+		listOptions:     v1.ListOptions{FieldSelector: fields.OneTermEqualSelector("metadata.name", name).String()},
+		timeout:         timeout,
+		options:         options,
+		relist:          relist,
+		resourceVersion: initialVersion,
+		result:          make(chan watch.Event),
+		done:            make(chan struct{}),
+	}
+	w.start(initial)
+	return w
+}
+
+// NewListWatcher watches all resources selected by the given list options, starting at
+// their resource version. The watch is re-established when it ends. If the resource
+// version has expired in the meantime, the watch restarts with synthetic Added events for
+// the current state of all resources, so that consumers have to treat Added as an update.
+// The name of the resource type is used in messages only.
+func NewListWatcher(ctx context.Context, watchFunc watchF, name string, listOptions v1.ListOptions, options WatchOptions) (watch.Interface, error) {
+	w := &reconnectingWatcher{
+		ctx:             ctx,
+		watchFunc:       watchFunc,
+		name:            name,
+		listOptions:     listOptions,
+		options:         options,
+		resourceVersion: listOptions.ResourceVersion,
+		result:          make(chan watch.Event),
+		done:            make(chan struct{}),
+	}
+	initial, err := w.watch()
+	if err != nil {
+		return nil, err
+	}
+	w.start(initial)
+	return w, nil
+}
+
+func (w *reconnectingWatcher) start(initial watch.Interface) {
+	w.wg.Add(1)
+	go w.run(initial)
+}
+
+// watch creates a new watch which starts at the last seen resource version
+func (w *reconnectingWatcher) watch() (watch.Interface, error) {
+	opts := *w.listOptions.DeepCopy()
+	opts.ResourceVersion = w.resourceVersion
+	opts.Watch = true
+	opts.AllowWatchBookmarks = true
+	addWatchTimeout(&opts, w.timeout)
+	return w.watchFunc(w.ctx, opts)
+}
+
+func (w *reconnectingWatcher) ResultChan() <-chan watch.Event {
+	return w.result
+}
+
+func (w *reconnectingWatcher) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.done)
+	})
+	w.wg.Wait()
+}
+
+func (w *reconnectingWatcher) run(watcher watch.Interface) {
+	defer w.wg.Done()
+	defer close(w.result)
+	for {
+		relist, stopped := w.forward(watcher)
+		if stopped {
+			return
+		}
+		watcher = w.reconnect(relist)
+		if watcher == nil {
+			return
+		}
+	}
+}
+
+// forward sends the events of the given watch to the result channel until the watch ends.
+// It returns whether the resource has to be re-listed before watching again and whether
+// the watcher has been stopped in the meantime.
+func (w *reconnectingWatcher) forward(watcher watch.Interface) (relist bool, stopped bool) {
+	defer watcher.Stop()
+	for {
+		select {
+		case <-w.done:
+			return false, true
+		case <-w.ctx.Done():
+			return false, true
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				// Closed by the server, e.g. because of its own timeout
+				return false, false
+			}
+			if event.Type == watch.Error {
+				err := api_errors.FromObject(event.Object)
+				return isResourceVersionExpired(err), false
+			}
+			// The connection works, so only consecutive reconnects count
+			w.reconnects = 0
+			w.remember(event)
+			if event.Type == watch.Bookmark {
+				continue
+			}
+			if !w.send(event) {
+				return false, true
+			}
+		}
+	}
+}
+
+// reconnect establishes a new watch, with a backoff between attempts. It gives up
+// after the maximum number of reconnects and returns nil in this case.
+func (w *reconnectingWatcher) reconnect(relist bool) watch.Interface {
+	for attempt := 0; ; attempt++ {
+		if w.reconnects >= w.options.MaxReconnects {
+			w.send(watch.Event{Type: watch.Error, Object: errorStatus(
+				fmt.Errorf("giving up watching '%s' after %d reconnects", w.name, w.reconnects))})
+			return nil
+		}
+		w.reconnects++
+		select {
+		case <-w.done:
+			return nil
+		case <-w.ctx.Done():
+			return nil
+		case <-time.After(w.options.backoff(attempt)):
+		}
+
+		if relist {
+			if err := w.relistResource(); err != nil {
+				continue
+			}
+			relist = false
+		}
+		watcher, err := w.watch()
+		if err == nil {
+			return watcher
+		}
+		relist = isResourceVersionExpired(err)
+	}
+}
+
+// relistResource fetches the current state of the resource, emits an event if it has changed
+// since the last event and continues with its resource version
+func (w *reconnectingWatcher) relistResource() error {
+	if w.relist == nil {
+		// Watch from the current state, the server sends a synthetic Added event for it
+		w.resourceVersion = ""
+		return nil
+	}
+	obj, err := w.relist()
+	if api_errors.IsNotFound(err) {
+		if w.lastObject != nil {
+			w.send(watch.Event{Type: watch.Deleted, Object: w.lastObject})
+			w.lastObject = nil
+		}
+		w.resourceVersion = ""
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	if accessor.GetResourceVersion() != w.resourceVersion {
+		event := watch.Event{Type: watch.Modified, Object: obj}
+		w.remember(event)
+		w.send(event)
+	}
+	return nil
+}
+
+// remember records the resource version of an event so that a new watch can continue there
+func (w *reconnectingWatcher) remember(event watch.Event) {
+	if event.Object == nil {
+		return
+	}
+	if accessor, err := meta.Accessor(event.Object); err == nil && accessor.GetResourceVersion() != "" {
+		w.resourceVersion = accessor.GetResourceVersion()
+	}
+	switch event.Type {
+	case watch.Added, watch.Modified:
+		w.lastObject = event.Object
+	case watch.Deleted:
+		w.lastObject = nil
+	}
+}
+
+func (w *reconnectingWatcher) send(event watch.Event) bool {
+	select {
+	case w.result <- event:
+		return true
+	case <-w.done:
+		return false
+	case <-w.ctx.Done():
+		return false
+	}
+}
+
+func isResourceVersionExpired(err error) bool {
+	return api_errors.IsGone(err) || api_errors.IsResourceExpired(err)
+}
+
+// errorStatus converts an error to the status object of a watch.Error event
+func errorStatus(err error) *v1.Status {
+	if status, ok := err.(api_errors.APIStatus); ok {
+		s := status.Status()
+		return &s
+	}
+	return &api_errors.NewInternalError(err).ErrStatus
+}
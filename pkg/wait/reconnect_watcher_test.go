@@ -0,0 +1,225 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+var testWatchOptions = WatchOptions{MaxReconnects: 3, InitialBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+
+// fakeWatchFunc hands out the given watches one after the other and records the
+// resource versions the watches are started with
+type fakeWatchFunc struct {
+	watches   []watch.Interface
+	errors    []error
+	versions  []string
+	selectors []string
+}
+
+func (f *fakeWatchFunc) watch(_ context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	i := len(f.versions)
+	f.versions = append(f.versions, opts.ResourceVersion)
+	f.selectors = append(f.selectors, opts.LabelSelector+";"+opts.FieldSelector)
+	if i < len(f.errors) && f.errors[i] != nil {
+		return nil, f.errors[i]
+	}
+	if i >= len(f.watches) {
+		return nil, fmt.Errorf("connection refused")
+	}
+	return f.watches[i], nil
+}
+
+func newReconnectingWatcherForTest(f *fakeWatchFunc, relist func() (runtime.Object, error), initial watch.Interface) *reconnectingWatcher {
+	return newReconnectingWatcher(context.Background(), f.watch, relist, "foo", "start", time.Minute, testWatchOptions, initial)
+}
+
+func assertEvent(t *testing.T, w watch.Interface, eventType watch.EventType, resourceVersion string) {
+	t.Helper()
+	select {
+	case event, ok := <-w.ResultChan():
+		assert.Assert(t, ok, "result channel closed")
+		assert.Equal(t, event.Type, eventType)
+		assert.Equal(t, event.Object.(metav1.Object).GetResourceVersion(), resourceVersion)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no %s event received", eventType)
+	}
+}
+
+func TestReconnectAfterClose(t *testing.T) {
+	initial := NewFakeWatch([]watch.Event{
+		{Type: watch.Added, Object: a},
+		{Type: watch.Bookmark, Object: &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "a2"}}},
+	})
+	initial.StartAndClose()
+	second := NewFakeWatch([]watch.Event{{Type: watch.Modified, Object: b}})
+	second.StartAndClose()
+	third := NewFakeWatch([]watch.Event{{Type: watch.Modified, Object: c}})
+	third.Start()
+	f := &fakeWatchFunc{
+		watches: []watch.Interface{nil, second, third},
+		errors:  []error{fmt.Errorf("connection reset")},
+	}
+	w := newReconnectingWatcherForTest(f, nil, initial)
+	defer w.Stop()
+
+	// The bookmark is not forwarded
+	assertEvent(t, w, watch.Added, "a")
+	assertEvent(t, w, watch.Modified, "b")
+	assertEvent(t, w, watch.Modified, "c")
+	// Every watch continues at the last seen version, also after a failed attempt
+	assert.DeepEqual(t, f.versions, []string{"a2", "a2", "b"})
+	assert.Equal(t, initial.StopCalled, 1)
+	assert.Equal(t, second.StopCalled, 1)
+}
+
+func TestReconnectRelistOnGone(t *testing.T) {
+	gone := api_errors.NewResourceExpired("too old resource version")
+	initial := NewFakeWatch([]watch.Event{
+		{Type: watch.Added, Object: a},
+		{Type: watch.Error, Object: &gone.ErrStatus},
+	})
+	initial.Start()
+	second := NewFakeWatch([]watch.Event{{Type: watch.Modified, Object: z}})
+	second.Start()
+	f := &fakeWatchFunc{watches: []watch.Interface{second}}
+	w := newReconnectingWatcherForTest(f, func() (runtime.Object, error) { return c, nil }, initial)
+	defer w.Stop()
+
+	assertEvent(t, w, watch.Added, "a")
+	// The change missed while the watch was down is delivered from the re-list
+	assertEvent(t, w, watch.Modified, "c")
+	assertEvent(t, w, watch.Modified, "z")
+	assert.DeepEqual(t, f.versions, []string{"c"})
+}
+
+func TestReconnectRelistOnGoneWhenWatching(t *testing.T) {
+	initial := NewFakeWatch([]watch.Event{{Type: watch.Added, Object: a}})
+	initial.StartAndClose()
+	second := NewFakeWatch([]watch.Event{{Type: watch.Added, Object: z}})
+	second.Start()
+	f := &fakeWatchFunc{
+		watches: []watch.Interface{nil, second},
+		errors:  []error{api_errors.NewGone("too old resource version")},
+	}
+	relist := func() (runtime.Object, error) {
+		return nil, api_errors.NewNotFound(schema.GroupResource{Group: "serving.knative.dev", Resource: "services"}, "foo")
+	}
+	w := newReconnectingWatcherForTest(f, relist, initial)
+	defer w.Stop()
+
+	assertEvent(t, w, watch.Added, "a")
+	// The resource has been deleted in the meantime
+	assertEvent(t, w, watch.Deleted, "a")
+	// and re-created, which the new watch reports from the current state
+	assertEvent(t, w, watch.Added, "z")
+	assert.DeepEqual(t, f.versions, []string{"a", ""})
+}
+
+func TestReconnectGivesUp(t *testing.T) {
+	initial := NewFakeWatch([]watch.Event{})
+	initial.StartAndClose()
+	f := &fakeWatchFunc{}
+	w := newReconnectingWatcherForTest(f, nil, initial)
+	defer w.Stop()
+
+	event := <-w.ResultChan()
+	assert.Equal(t, event.Type, watch.Error)
+	assert.ErrorContains(t, api_errors.FromObject(event.Object), "giving up watching 'foo' after 3 reconnects")
+	_, ok := <-w.ResultChan()
+	assert.Assert(t, !ok)
+	assert.Equal(t, len(f.versions), 3)
+}
+
+func TestReconnectCountsConsecutiveReconnects(t *testing.T) {
+	initial := NewFakeWatch([]watch.Event{{Type: watch.Added, Object: a}})
+	initial.StartAndClose()
+	var watches []watch.Interface
+	for _, obj := range []runtime.Object{b, bb, c, cc} {
+		w := NewFakeWatch([]watch.Event{{Type: watch.Modified, Object: obj}})
+		w.StartAndClose()
+		watches = append(watches, w)
+	}
+	f := &fakeWatchFunc{watches: watches}
+	w := newReconnectingWatcherForTest(f, nil, initial)
+	defer w.Stop()
+
+	// Every watch delivers an event before it ends, so the watcher reconnects more
+	// often than the maximum number of reconnects
+	assertEvent(t, w, watch.Added, "a")
+	assertEvent(t, w, watch.Modified, "b")
+	assertEvent(t, w, watch.Modified, "b")
+	assertEvent(t, w, watch.Modified, "c")
+	assertEvent(t, w, watch.Modified, "c")
+
+	event := <-w.ResultChan()
+	assert.Equal(t, event.Type, watch.Error)
+	assert.ErrorContains(t, api_errors.FromObject(event.Object), "giving up watching 'foo' after 3 reconnects")
+	assert.Equal(t, len(f.versions), 7)
+}
+
+func TestReconnectStop(t *testing.T) {
+	initial := NewFakeWatch([]watch.Event{{Type: watch.Added, Object: a}})
+	initial.Start()
+	w := newReconnectingWatcherForTest(&fakeWatchFunc{}, nil, initial)
+	// Stopping doesn't block although the event is never received
+	w.Stop()
+	w.Stop()
+	assert.Equal(t, initial.StopCalled, 1)
+}
+
+func TestListWatcher(t *testing.T) {
+	first := NewFakeWatch([]watch.Event{{Type: watch.Added, Object: a}})
+	first.StartAndClose()
+	second := NewFakeWatch([]watch.Event{{Type: watch.Modified, Object: b}})
+	second.Start()
+	f := &fakeWatchFunc{watches: []watch.Interface{first, second}}
+	w, err := NewListWatcher(context.Background(), f.watch, "services", metav1.ListOptions{LabelSelector: "app=web", ResourceVersion: "10"}, testWatchOptions)
+	assert.NilError(t, err)
+	defer w.Stop()
+
+	assertEvent(t, w, watch.Added, "a")
+	assertEvent(t, w, watch.Modified, "b")
+	assert.DeepEqual(t, f.versions, []string{"10", "a"})
+	assert.DeepEqual(t, f.selectors, []string{"app=web;", "app=web;"})
+
+	_, err = NewListWatcher(context.Background(), (&fakeWatchFunc{}).watch, "services", metav1.ListOptions{}, testWatchOptions)
+	assert.ErrorContains(t, err, "connection refused")
+}
+
+func TestWatchOptionsBackoff(t *testing.T) {
+	options := WatchOptions{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	assert.Equal(t, options.backoff(0), 100*time.Millisecond)
+	assert.Equal(t, options.backoff(1), 200*time.Millisecond)
+	assert.Equal(t, options.backoff(3), 800*time.Millisecond)
+	assert.Equal(t, options.backoff(4), time.Second)
+	assert.Equal(t, options.backoff(100), time.Second)
+
+	options.Jitter = 0.5
+	for i := 0; i < 20; i++ {
+		delay := options.backoff(1)
+		assert.Assert(t, delay >= 200*time.Millisecond && delay <= 300*time.Millisecond, "delay %s out of bounds", delay)
+	}
+}
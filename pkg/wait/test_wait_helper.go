@@ -50,6 +50,15 @@ func (f *FakeWatch) Start() {
 	go f.fireEvents()
 }
 
+// StartAndClose fires the events and closes the channel afterwards, like
+// the API server does when it ends a watch
+func (f *FakeWatch) StartAndClose() {
+	go func() {
+		f.fireEvents()
+		close(f.eventChan)
+	}()
+}
+
 // Channel for getting the events
 func (f *FakeWatch) ResultChan() <-chan watch.Event {
 	return f.eventChan
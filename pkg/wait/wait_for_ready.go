@@ -21,6 +21,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	"knative.dev/pkg/apis"
@@ -31,6 +32,7 @@ type waitForReadyConfig struct {
 	watchMaker          WatchMaker
 	conditionsExtractor ConditionsExtractor
 	kind                string
+	conditionType       apis.ConditionType
 }
 
 // Callbacks and configuration used while waiting for event
@@ -38,6 +40,8 @@ type waitForEvent struct {
 	watchMaker WatchMaker
 	eventDone  EventDone
 	kind       string
+	// state which is reached when waiting ends, used in the timeout message
+	state string
 }
 
 // EventDone is a marker to stop actual waiting on given event state
@@ -73,20 +77,51 @@ type MessageCallback func(durationSinceState time.Duration, message string)
 
 // NewWaitForReady waits until the condition is set to Ready == True
 func NewWaitForReady(kind string, watchMaker WatchMaker, extractor ConditionsExtractor) Wait {
+	return NewWaitForCondition(kind, watchMaker, extractor, apis.ConditionReady)
+}
+
+// NewWaitForCondition waits until the condition of the given type is set to True
+func NewWaitForCondition(kind string, watchMaker WatchMaker, extractor ConditionsExtractor, conditionType apis.ConditionType) Wait {
 	return &waitForReadyConfig{
 		kind:                kind,
 		watchMaker:          watchMaker,
 		conditionsExtractor: extractor,
+		conditionType:       conditionType,
 	}
 }
 
 // NewWaitForEvent creates a Wait object which waits until a specific event (i.e. when
 // the EventDone function returns true)
 func NewWaitForEvent(kind string, watchMaker WatchMaker, eventDone EventDone) Wait {
+	return NewWaitForEventWithState(kind, watchMaker, eventDone, "ready")
+}
+
+// NewWaitForEventWithState creates a Wait object which waits until a specific event, like
+// NewWaitForEvent. The state which the event stands for, e.g. "deleted", is used in the
+// timeout message.
+func NewWaitForEventWithState(kind string, watchMaker WatchMaker, eventDone EventDone, state string) Wait {
 	return &waitForEvent{
 		kind:       kind,
 		watchMaker: watchMaker,
 		eventDone:  eventDone,
+		state:      state,
+	}
+}
+
+// NewWaitForGeneration creates a Wait object which waits until the controller has
+// observed the latest generation of a resource
+func NewWaitForGeneration(kind string, watchMaker WatchMaker) Wait {
+	return &waitForEvent{
+		kind:       kind,
+		watchMaker: watchMaker,
+		eventDone: func(ev *watch.Event) bool {
+			if ev.Type != watch.Added && ev.Type != watch.Modified {
+				return false
+			}
+			observed, err := GenerationObserved(ev.Object)
+			return err == nil && observed
+		},
+		state: "reconciled",
 	}
 }
 
@@ -125,6 +160,9 @@ func (w *waitForReadyConfig) Wait(ctx context.Context, name string, initialVersi
 			return err, time.Since(start)
 		}
 		if timeoutReached {
+			if w.conditionType != apis.ConditionReady {
+				return fmt.Errorf("timeout: condition '%s' of %s '%s' not true after %d seconds", w.conditionType, w.kind, name, int(timeout/time.Second)), time.Since(start)
+			}
 			return fmt.Errorf("timeout: %s '%s' not ready after %d seconds", w.kind, name, int(timeout/time.Second)), time.Since(start)
 		}
 
@@ -137,8 +175,8 @@ func (w *waitForReadyConfig) Wait(ctx context.Context, name string, initialVersi
 	}
 }
 
-// waitForReadyCondition waits until the status condition "Ready" is set to true (good path) or return an error
-// when the "Ready" condition is set to false. An error is also returned when the given timeout is reached (plus the
+// waitForReadyCondition waits until the status condition "Ready" (or the configured condition type) is set to
+// true (good path) or return an error when the condition is set to false. An error is also returned when the given timeout is reached (plus the
 // return value of timeoutReached is set to true in this case).
 // An errorWindow can be specified which takes into account of intermediate "false" ready conditions. So before returning
 // an error, this methods waits for the errorWindow duration and if an "True" or "Unknown" event arrives in the meantime
@@ -176,6 +214,10 @@ func (w *waitForReadyConfig) waitForReadyCondition(ctx context.Context, name str
 			// meantime. So the error status is considered to be final.
 			return false, false, err
 		case event, ok := <-watcher.ResultChan():
+			if ok && event.Type == watch.Error && event.Object != nil {
+				// The watch has given up, e.g. after too many reconnects
+				return false, false, watchError(event)
+			}
 			if !ok || event.Object == nil {
 				return true, false, nil
 			}
@@ -211,7 +253,7 @@ func (w *waitForReadyConfig) waitForReadyCondition(ctx context.Context, name str
 				return false, false, err
 			}
 			for _, cond := range conditions {
-				if cond.Type == apis.ConditionReady {
+				if cond.Type == w.conditionType {
 					switch cond.Status {
 					case corev1.ConditionTrue:
 						// Any error timer running will be cancelled by the defer method that has been set above
@@ -251,7 +293,7 @@ func (w *waitForEvent) Wait(ctx context.Context, name string, initialVersion str
 	if err != nil {
 		return err, 0
 	}
-	defer watcher.Stop()
+	defer func() { watcher.Stop() }()
 
 	timeout := options.timeoutWithDefault()
 	start := time.Now()
@@ -263,8 +305,20 @@ func (w *waitForEvent) Wait(ctx context.Context, name string, initialVersion str
 		case <-ctx.Done():
 			return ctx.Err(), time.Since(start)
 		case <-timer.C:
-			return fmt.Errorf("timeout: %s '%s' not ready after %d seconds", w.kind, name, int(timeout/time.Second)), time.Since(start)
-		case event := <-watcher.ResultChan():
+			return fmt.Errorf("timeout: %s '%s' not %s after %d seconds", w.kind, name, w.state, int(timeout/time.Second)), time.Since(start)
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				// The watch has ended prematurely, sleep to prevent CPU pegging and watch again
+				watcher.Stop()
+				time.Sleep(pollInterval)
+				if watcher, err = w.watchMaker(ctx, name, initialVersion, timeout); err != nil {
+					return err, time.Since(start)
+				}
+				continue
+			}
+			if event.Type == watch.Error && event.Object != nil {
+				return watchError(event), time.Since(start)
+			}
 			if w.eventDone(&event) {
 				return nil, time.Since(start)
 			}
@@ -272,6 +326,17 @@ func (w *waitForEvent) Wait(ctx context.Context, name string, initialVersion str
 	}
 }
 
+// watchError extracts the error of a watch.Error event
+func watchError(event watch.Event) error {
+	return fmt.Errorf("watching failed: %w", api_errors.FromObject(event.Object))
+}
+
+// GenerationObserved checks whether the controller has observed the latest generation
+// of the given resource, i.e. whether its status is up to date
+func GenerationObserved(object runtime.Object) (bool, error) {
+	return generationCheck(object)
+}
+
 func generationCheck(object runtime.Object) (bool, error) {
 	unstructured, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
 	if err != nil {
@@ -427,3 +427,111 @@ func conditionsFor(obj runtime.Object) (apis.Conditions, error) {
 	}
 	return apis.Conditions(obj.(duckv1.KRShaped).GetStatus().Conditions), nil
 }
+
+func TestWaitForCondition(t *testing.T) {
+	timeout := time.Second * 3
+	fakeWatchApi := NewFakeWatch([]watch.Event{
+		{Type: watch.Modified, Object: CreateTestServiceWithConditions("foobar", corev1.ConditionUnknown, corev1.ConditionUnknown, "", "", 1, 1)},
+		{Type: watch.Modified, Object: CreateTestServiceWithConditions("foobar", corev1.ConditionUnknown, corev1.ConditionTrue, "", "", 2, 1)},
+		{Type: watch.Modified, Object: CreateTestServiceWithConditions("foobar", corev1.ConditionUnknown, corev1.ConditionTrue, "", "", 2, 2)},
+	})
+	fakeWatchApi.Start()
+	wfc := NewWaitForCondition("blub",
+		func(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
+			return fakeWatchApi, nil
+		},
+		conditionsFor, "RoutesReady")
+	err, _ := wfc.Wait(context.Background(), "foobar", "", Options{Timeout: &timeout}, NoopMessageCallback())
+	assert.NilError(t, err)
+	assert.Equal(t, fakeWatchApi.StopCalled, 1)
+
+	fakeWatchApi = NewFakeWatch([]watch.Event{
+		{Type: watch.Modified, Object: CreateTestServiceWithConditions("foobar", corev1.ConditionTrue, corev1.ConditionUnknown, "", "")},
+	})
+	fakeWatchApi.Start()
+	timeout = time.Second
+	wfc = NewWaitForCondition("blub",
+		func(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
+			return fakeWatchApi, nil
+		},
+		conditionsFor, "ConfigurationsReady")
+	err, _ = wfc.Wait(context.Background(), "foobar", "", Options{Timeout: &timeout}, NoopMessageCallback())
+	assert.ErrorContains(t, err, "condition 'ConfigurationsReady' of blub 'foobar' not true")
+}
+
+func TestWaitForGeneration(t *testing.T) {
+	timeout := time.Second * 3
+	fakeWatchApi := NewFakeWatch([]watch.Event{
+		{Type: watch.Modified, Object: CreateTestServiceWithConditions("foobar", corev1.ConditionFalse, corev1.ConditionFalse, "", "", 2, 1)},
+		{Type: watch.Modified, Object: CreateTestServiceWithConditions("foobar", corev1.ConditionFalse, corev1.ConditionFalse, "", "", 2, 2)},
+	})
+	fakeWatchApi.Start()
+	wfg := NewWaitForGeneration("blub",
+		func(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
+			return fakeWatchApi, nil
+		})
+	err, _ := wfg.Wait(context.Background(), "foobar", "", Options{Timeout: &timeout}, NoopMessageCallback())
+	assert.NilError(t, err)
+
+	fakeWatchApi = NewFakeWatch([]watch.Event{
+		{Type: watch.Modified, Object: CreateTestServiceWithConditions("foobar", corev1.ConditionTrue, corev1.ConditionTrue, "", "", 3, 2)},
+	})
+	timeout = time.Second
+	wfg = NewWaitForGeneration("blub",
+		func(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
+			return fakeWatchApi, nil
+		})
+	err, _ = wfg.Wait(context.Background(), "foobar", "", Options{Timeout: &timeout}, NoopMessageCallback())
+	assert.ErrorContains(t, err, "not reconciled")
+}
+
+func TestWaitForEventWithChannelClose(t *testing.T) {
+	timeout := time.Second * 3
+	closed := NewFakeWatch([]watch.Event{})
+	closed.StartAndClose()
+	fakeWatchApi := NewFakeWatch([]watch.Event{{Type: watch.Deleted, Object: CreateTestServiceWithConditions("foobar", corev1.ConditionTrue, corev1.ConditionTrue, "", "")}})
+	fakeWatchApi.Start()
+	watches := []watch.Interface{closed, fakeWatchApi}
+	wfe := NewWaitForEvent("blub",
+		func(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
+			next := watches[0]
+			watches = watches[1:]
+			return next, nil
+		},
+		func(e *watch.Event) bool { return e.Type == watch.Deleted })
+	err, _ := wfe.Wait(context.Background(), "foobar", "", Options{Timeout: &timeout}, NoopMessageCallback())
+	assert.NilError(t, err)
+	assert.Equal(t, closed.StopCalled, 1)
+	assert.Equal(t, fakeWatchApi.StopCalled, 1)
+}
+
+func TestWaitForEventWithStateTimeout(t *testing.T) {
+	timeout := time.Second
+	fakeWatchApi := NewFakeWatch([]watch.Event{})
+	fakeWatchApi.Start()
+	wfe := NewWaitForEventWithState("blub",
+		func(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
+			return fakeWatchApi, nil
+		},
+		func(e *watch.Event) bool { return e.Type == watch.Deleted }, "deleted")
+	err, _ := wfe.Wait(context.Background(), "foobar", "", Options{Timeout: &timeout}, NoopMessageCallback())
+	assert.ErrorContains(t, err, "timeout: blub 'foobar' not deleted after 1 seconds")
+}
+
+func TestWaitWatchGivesUp(t *testing.T) {
+	timeout := time.Second * 3
+	status := errorStatus(fmt.Errorf("giving up watching 'foobar' after 10 reconnects"))
+	watchMaker := func(ctx context.Context, name string, initialVersion string, timeout time.Duration) (watch.Interface, error) {
+		fakeWatchApi := NewFakeWatch([]watch.Event{{Type: watch.Error, Object: status}})
+		fakeWatchApi.Start()
+		return fakeWatchApi, nil
+	}
+
+	wfr := NewWaitForReady("blub", watchMaker, conditionsFor)
+	err, _ := wfr.Wait(context.Background(), "foobar", "", Options{Timeout: &timeout}, NoopMessageCallback())
+	assert.ErrorContains(t, err, "giving up watching 'foobar'")
+
+	wfe := NewWaitForEvent("blub", watchMaker, func(e *watch.Event) bool { return false })
+	err, _ = wfe.Wait(context.Background(), "foobar", "", Options{Timeout: &timeout}, NoopMessageCallback())
+	assert.ErrorContains(t, err, "giving up watching 'foobar'")
+}
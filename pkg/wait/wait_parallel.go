@@ -0,0 +1,102 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// TaskState is the state of a single task of WaitInParallel
+type TaskState int
+
+const (
+	// TaskPending is the state of a task waiting for a free slot in the pool
+	TaskPending TaskState = iota
+	// TaskWaiting is the state of a running task
+	TaskWaiting
+	// TaskSucceeded is the state of a task which has finished successfully
+	TaskSucceeded
+	// TaskFailed is the state of a task which has finished with an error
+	TaskFailed
+)
+
+// Task is a single wait run by WaitInParallel
+type Task struct {
+	// Name of the resource waited for
+	Name string
+	// Wait blocks until the resource is in the requested state. Intermediate
+	// messages are reported to the given callback.
+	Wait func(ctx context.Context, msgCallback MessageCallback) error
+}
+
+// TaskResult is the outcome of a single task of WaitInParallel
+type TaskResult struct {
+	Name    string
+	Err     error
+	Elapsed time.Duration
+}
+
+// ProgressCallback is called whenever a task of WaitInParallel changes its state or
+// reports a message. It is called concurrently from multiple goroutines.
+type ProgressCallback func(name string, state TaskState, message string)
+
+// WaitInParallel runs the given tasks with at most concurrency tasks running at the
+// same time. It returns after all tasks have finished, with the results in the order
+// of the given tasks.
+func WaitInParallel(ctx context.Context, tasks []Task, concurrency int, progress ProgressCallback) []TaskResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	if progress == nil {
+		progress = func(string, TaskState, string) {}
+	}
+	for _, task := range tasks {
+		progress(task.Name, TaskPending, "")
+	}
+
+	results := make([]TaskResult, len(tasks))
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, task := range tasks {
+		wg.Add(1)
+		go func(i int, task Task) {
+			defer wg.Done()
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+				results[i] = TaskResult{Name: task.Name, Err: ctx.Err()}
+				progress(task.Name, TaskFailed, ctx.Err().Error())
+				return
+			}
+
+			start := time.Now()
+			progress(task.Name, TaskWaiting, "")
+			err := task.Wait(ctx, func(_ time.Duration, message string) {
+				progress(task.Name, TaskWaiting, message)
+			})
+			results[i] = TaskResult{Name: task.Name, Err: err, Elapsed: time.Since(start)}
+			if err != nil {
+				progress(task.Name, TaskFailed, err.Error())
+			} else {
+				progress(task.Name, TaskSucceeded, "")
+			}
+		}(i, task)
+	}
+	wg.Wait()
+	return results
+}
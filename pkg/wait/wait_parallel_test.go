@@ -0,0 +1,88 @@
+// Copyright © 2026 The Knative Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestWaitInParallel(t *testing.T) {
+	var running, maxRunning int32
+	var tasks []Task
+	for i := 0; i < 10; i++ {
+		name := fmt.Sprintf("task-%d", i)
+		tasks = append(tasks, Task{
+			Name: name,
+			Wait: func(ctx context.Context, msgCallback MessageCallback) error {
+				current := atomic.AddInt32(&running, 1)
+				defer atomic.AddInt32(&running, -1)
+				for {
+					observed := atomic.LoadInt32(&maxRunning)
+					if current <= observed || atomic.CompareAndSwapInt32(&maxRunning, observed, current) {
+						break
+					}
+				}
+				msgCallback(0, "working")
+				time.Sleep(10 * time.Millisecond)
+				if name == "task-3" {
+					return errors.New("boom")
+				}
+				return nil
+			},
+		})
+	}
+
+	var mutex sync.Mutex
+	states := map[string][]TaskState{}
+	results := WaitInParallel(context.Background(), tasks, 3, func(name string, state TaskState, message string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		states[name] = append(states[name], state)
+	})
+
+	assert.Assert(t, maxRunning <= 3)
+	assert.Equal(t, len(results), 10)
+	for i, result := range results {
+		assert.Equal(t, result.Name, fmt.Sprintf("task-%d", i))
+		if i == 3 {
+			assert.ErrorContains(t, result.Err, "boom")
+		} else {
+			assert.NilError(t, result.Err)
+		}
+		assert.Assert(t, result.Elapsed >= 10*time.Millisecond)
+	}
+	assert.DeepEqual(t, states["task-0"], []TaskState{TaskPending, TaskWaiting, TaskWaiting, TaskSucceeded})
+	assert.DeepEqual(t, states["task-3"], []TaskState{TaskPending, TaskWaiting, TaskWaiting, TaskFailed})
+}
+
+func TestWaitInParallelCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results := WaitInParallel(ctx, []Task{
+		{Name: "a", Wait: func(ctx context.Context, _ MessageCallback) error { return ctx.Err() }},
+		{Name: "b", Wait: func(ctx context.Context, _ MessageCallback) error { return ctx.Err() }},
+	}, 1, nil)
+	for _, result := range results {
+		assert.ErrorIs(t, result.Err, context.Canceled)
+	}
+}